@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/go-openapi/strfmt"
+)
+
+// atrackerV2 is a client of the v2 Activity Tracker event routing API, the version with Logging and Event
+// Streams targets, account settings and route rules with locations.
+type atrackerV2 struct {
+	Service *core.BaseService
+}
+
+const (
+	atrackerV2TargetsPath  = "/api/v2/targets"
+	atrackerV2RoutesPath   = "/api/v2/routes"
+	atrackerV2SettingsPath = "/api/v2/settings"
+
+	atrackerTargetTypeCloudObjectStorage = "cloud_object_storage"
+	atrackerTargetTypeLogDNA             = "logdna"
+	atrackerTargetTypeEventStreams       = "event_streams"
+)
+
+type atrackerV2CosEndpoint struct {
+	Endpoint                *string `json:"endpoint"`
+	TargetCRN               *string `json:"target_crn"`
+	Bucket                  *string `json:"bucket"`
+	APIKey                  *string `json:"api_key,omitempty"`
+	ServiceToServiceEnabled *bool   `json:"service_to_service_enabled,omitempty"`
+}
+
+type atrackerV2LogdnaEndpoint struct {
+	TargetCRN    *string `json:"target_crn"`
+	IngestionKey *string `json:"ingestion_key,omitempty"`
+}
+
+type atrackerV2EventstreamsEndpoint struct {
+	TargetCRN *string  `json:"target_crn"`
+	Brokers   []string `json:"brokers"`
+	Topic     *string  `json:"topic"`
+	APIKey    *string  `json:"api_key,omitempty"`
+}
+
+type atrackerV2WriteStatus struct {
+	Status               *string          `json:"status,omitempty"`
+	LastFailure          *strfmt.DateTime `json:"last_failure,omitempty"`
+	ReasonForLastFailure *string          `json:"reason_for_last_failure,omitempty"`
+}
+
+type atrackerV2Target struct {
+	ID                   *string                         `json:"id,omitempty"`
+	Name                 *string                         `json:"name,omitempty"`
+	CRN                  *string                         `json:"crn,omitempty"`
+	TargetType           *string                         `json:"target_type,omitempty"`
+	Region               *string                         `json:"region,omitempty"`
+	CosEndpoint          *atrackerV2CosEndpoint          `json:"cos_endpoint,omitempty"`
+	LogdnaEndpoint       *atrackerV2LogdnaEndpoint       `json:"logdna_endpoint,omitempty"`
+	EventstreamsEndpoint *atrackerV2EventstreamsEndpoint `json:"eventstreams_endpoint,omitempty"`
+	WriteStatus          *atrackerV2WriteStatus          `json:"write_status,omitempty"`
+	CreatedAt            *strfmt.DateTime                `json:"created_at,omitempty"`
+	UpdatedAt            *strfmt.DateTime                `json:"updated_at,omitempty"`
+	Message              *string                         `json:"message,omitempty"`
+	APIVersion           *int64                          `json:"api_version,omitempty"`
+}
+
+type atrackerV2Rule struct {
+	TargetIds []string `json:"target_ids"`
+	Locations []string `json:"locations,omitempty"`
+}
+
+type atrackerV2Route struct {
+	ID         *string          `json:"id,omitempty"`
+	Name       *string          `json:"name,omitempty"`
+	CRN        *string          `json:"crn,omitempty"`
+	Version    *int64           `json:"version,omitempty"`
+	Rules      []atrackerV2Rule `json:"rules"`
+	CreatedAt  *strfmt.DateTime `json:"created_at,omitempty"`
+	UpdatedAt  *strfmt.DateTime `json:"updated_at,omitempty"`
+	Message    *string          `json:"message,omitempty"`
+	APIVersion *int64           `json:"api_version,omitempty"`
+}
+
+type atrackerV2TargetList struct {
+	Targets []atrackerV2Target `json:"targets"`
+}
+
+type atrackerV2RouteList struct {
+	Routes []atrackerV2Route `json:"routes"`
+}
+
+type atrackerV2Settings struct {
+	DefaultTargets         []string `json:"default_targets"`
+	PermittedTargetRegions []string `json:"permitted_target_regions"`
+	MetadataRegionPrimary  *string  `json:"metadata_region_primary"`
+	MetadataRegionBackup   *string  `json:"metadata_region_backup,omitempty"`
+	PrivateAPIEndpointOnly *bool    `json:"private_api_endpoint_only"`
+	APIVersion             *int64   `json:"api_version,omitempty"`
+	Message                *string  `json:"message,omitempty"`
+}
+
+func newAtrackerV2(serviceURL string, authenticator core.Authenticator) (*atrackerV2, error) {
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           serviceURL,
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &atrackerV2{Service: service}, nil
+}
+
+// request sends a request to the Activity Tracker API, result is decoded from the JSON response when it is not nil
+func (atracker *atrackerV2) request(ctx context.Context, method, path string, body, result interface{}) (*core.DetailedResponse, error) {
+	return sendCoreRequest(ctx, atracker.Service, coreRequest{Method: method, Path: path, Body: body}, result)
+}
+
+func (atracker *atrackerV2) CreateTarget(ctx context.Context, target *atrackerV2Target) (*atrackerV2Target, *core.DetailedResponse, error) {
+	result := &atrackerV2Target{}
+	response, err := atracker.request(ctx, core.POST, atrackerV2TargetsPath, target, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func (atracker *atrackerV2) ListTargets(ctx context.Context) (*atrackerV2TargetList, *core.DetailedResponse, error) {
+	result := &atrackerV2TargetList{}
+	response, err := atracker.request(ctx, core.GET, atrackerV2TargetsPath, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func (atracker *atrackerV2) GetTarget(ctx context.Context, id string) (*atrackerV2Target, *core.DetailedResponse, error) {
+	result := &atrackerV2Target{}
+	response, err := atracker.request(ctx, core.GET, atrackerV2TargetsPath+"/"+url.PathEscape(id), nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func (atracker *atrackerV2) UpdateTarget(ctx context.Context, id string, target *atrackerV2Target) (*atrackerV2Target, *core.DetailedResponse, error) {
+	result := &atrackerV2Target{}
+	response, err := atracker.request(ctx, core.PATCH, atrackerV2TargetsPath+"/"+url.PathEscape(id), target, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func (atracker *atrackerV2) DeleteTarget(ctx context.Context, id string) (*core.DetailedResponse, error) {
+	return atracker.request(ctx, core.DELETE, atrackerV2TargetsPath+"/"+url.PathEscape(id), nil, nil)
+}
+
+// ValidateTarget tests the write access to the target, the result is in the write status of the target
+func (atracker *atrackerV2) ValidateTarget(ctx context.Context, id string) (*atrackerV2Target, *core.DetailedResponse, error) {
+	result := &atrackerV2Target{}
+	response, err := atracker.request(ctx, core.POST, atrackerV2TargetsPath+"/"+url.PathEscape(id)+"/validate", nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func (atracker *atrackerV2) CreateRoute(ctx context.Context, route *atrackerV2Route) (*atrackerV2Route, *core.DetailedResponse, error) {
+	result := &atrackerV2Route{}
+	response, err := atracker.request(ctx, core.POST, atrackerV2RoutesPath, route, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func (atracker *atrackerV2) ListRoutes(ctx context.Context) (*atrackerV2RouteList, *core.DetailedResponse, error) {
+	result := &atrackerV2RouteList{}
+	response, err := atracker.request(ctx, core.GET, atrackerV2RoutesPath, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func (atracker *atrackerV2) GetRoute(ctx context.Context, id string) (*atrackerV2Route, *core.DetailedResponse, error) {
+	result := &atrackerV2Route{}
+	response, err := atracker.request(ctx, core.GET, atrackerV2RoutesPath+"/"+url.PathEscape(id), nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func (atracker *atrackerV2) ReplaceRoute(ctx context.Context, id string, route *atrackerV2Route) (*atrackerV2Route, *core.DetailedResponse, error) {
+	result := &atrackerV2Route{}
+	response, err := atracker.request(ctx, core.PUT, atrackerV2RoutesPath+"/"+url.PathEscape(id), route, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func (atracker *atrackerV2) DeleteRoute(ctx context.Context, id string) (*core.DetailedResponse, error) {
+	return atracker.request(ctx, core.DELETE, atrackerV2RoutesPath+"/"+url.PathEscape(id), nil, nil)
+}
+
+func (atracker *atrackerV2) GetSettings(ctx context.Context) (*atrackerV2Settings, *core.DetailedResponse, error) {
+	result := &atrackerV2Settings{}
+	response, err := atracker.request(ctx, core.GET, atrackerV2SettingsPath, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func (atracker *atrackerV2) PutSettings(ctx context.Context, settings *atrackerV2Settings) (*atrackerV2Settings, *core.DetailedResponse, error) {
+	result := &atrackerV2Settings{}
+	response, err := atracker.request(ctx, core.PUT, atrackerV2SettingsPath, settings, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestAtrackerV2ValidateTarget(t *testing.T) {
+	var method, path string
+	service := testCoreService(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"f7dcfae6","target_type":"logdna","write_status":{"status":"failed","last_failure":"2021-10-19T12:00:00.000Z","reason_for_last_failure":"Provided API key could not be found"}}`))
+	})
+	client := &atrackerV2{Service: service}
+
+	target, _, err := client.ValidateTarget(context.Background(), "f7dcfae6")
+	if err != nil {
+		t.Fatalf("ValidateTarget failed: %s", err)
+	}
+	if method != http.MethodPost || path != "/api/v2/targets/f7dcfae6/validate" {
+		t.Errorf("unexpected request %s %s", method, path)
+	}
+	writeStatus := resourceIBMAtrackerTargetWriteStatusToMap(*target.WriteStatus)
+	if *writeStatus["status"].(*string) != "failed" || writeStatus["last_failure"] != "2021-10-19T12:00:00.000Z" {
+		t.Errorf("unexpected write status %v", writeStatus)
+	}
+}
+
+func TestAtrackerV2ReplaceRoute(t *testing.T) {
+	var method, path string
+	body := map[string]interface{}{}
+	service := testCoreService(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"c3af557f","version":2}`))
+	})
+	client := &atrackerV2{Service: service}
+
+	route := &atrackerV2Route{
+		Name: core.StringPtr("my-route"),
+		Rules: []atrackerV2Rule{
+			resourceIBMAtrackerRouteMapToRule(map[string]interface{}{"target_ids": []interface{}{"f7dcfae6"}, "locations": []interface{}{"us-south", "global"}}),
+			resourceIBMAtrackerRouteMapToRule(map[string]interface{}{"target_ids": []interface{}{"a9d2b8f1"}, "locations": []interface{}{}}),
+		},
+	}
+	replaced, _, err := client.ReplaceRoute(context.Background(), "c3af557f", route)
+	if err != nil {
+		t.Fatalf("ReplaceRoute failed: %s", err)
+	}
+	if method != http.MethodPut || path != "/api/v2/routes/c3af557f" || intValue(replaced.Version) != 2 {
+		t.Errorf("unexpected request %s %s or version %d", method, path, intValue(replaced.Version))
+	}
+	rules := body["rules"].([]interface{})
+	if locations := rules[0].(map[string]interface{})["locations"].([]interface{}); len(locations) != 2 || locations[1] != "global" {
+		t.Errorf("unexpected locations of the first rule %v", locations)
+	}
+	if _, ok := rules[1].(map[string]interface{})["locations"]; ok {
+		t.Errorf("the rule without locations has locations %v", rules[1])
+	}
+}
+
+func TestAtrackerV2GetSettingsNotFound(t *testing.T) {
+	service := testCoreService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"errors":[{"code":"not_found","message":"Settings not found"}],"status_code":404}`))
+	})
+	client := &atrackerV2{Service: service}
+
+	_, response, err := client.GetSettings(context.Background())
+	if err == nil || response == nil || response.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a not found response, got %v", err)
+	}
+}
+
+func TestAtrackerV2ListRoutes(t *testing.T) {
+	var method, path string
+	service := testCoreService(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"routes":[{"id":"c3af557f","name":"my-route","version":1,"rules":[{"target_ids":["f7dcfae6"],"locations":["us-south","global"]}]},{"id":"a9d2b8f1","name":"regional","rules":[{"target_ids":["f7dcfae6"],"locations":["us-south"]}]}]}`))
+	})
+	client := &atrackerV2{Service: service}
+
+	routeList, _, err := client.ListRoutes(context.Background())
+	if err != nil {
+		t.Fatalf("ListRoutes failed: %s", err)
+	}
+	if method != http.MethodGet || path != "/api/v2/routes" {
+		t.Errorf("unexpected request %s %s", method, path)
+	}
+	routes := dataSourceRouteListFlattenRoutes(routeList.Routes)
+	if len(routes) != 2 || routes[0]["receive_global_events"] != true || routes[1]["receive_global_events"] != false {
+		t.Errorf("unexpected routes %v", routes)
+	}
+	if locations := routes[0]["rules"].([]map[string]interface{})[0]["locations"].([]string); len(locations) != 2 || locations[1] != "global" {
+		t.Errorf("unexpected locations of the first route %v", locations)
+	}
+}
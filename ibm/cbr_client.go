@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// contextBasedRestrictionsV1 manages the zones and rules of the Context Based Restrictions API.
+type contextBasedRestrictionsV1 struct {
+	Service *core.BaseService
+}
+
+const (
+	cbrDefaultServiceURL = "https://cbr.cloud.ibm.com"
+
+	cbrZonesPath = "/v1/zones"
+	cbrRulesPath = "/v1/rules"
+
+	cbrAddressTypeIPAddress  = "ipAddress"
+	cbrAddressTypeIPRange    = "ipRange"
+	cbrAddressTypeSubnet     = "subnet"
+	cbrAddressTypeVPC        = "vpc"
+	cbrAddressTypeServiceRef = "serviceRef"
+)
+
+type cbrServiceRef struct {
+	AccountID       *string `json:"account_id,omitempty"`
+	ServiceType     *string `json:"service_type,omitempty"`
+	ServiceName     *string `json:"service_name,omitempty"`
+	ServiceInstance *string `json:"service_instance,omitempty"`
+	Location        *string `json:"location,omitempty"`
+}
+
+type cbrAddress struct {
+	Type  *string        `json:"type"`
+	Value *string        `json:"value,omitempty"`
+	Ref   *cbrServiceRef `json:"ref,omitempty"`
+}
+
+type cbrZone struct {
+	ID               *string      `json:"id,omitempty"`
+	CRN              *string      `json:"crn,omitempty"`
+	Name             *string      `json:"name,omitempty"`
+	AccountID        *string      `json:"account_id,omitempty"`
+	Description      *string      `json:"description,omitempty"`
+	Addresses        []cbrAddress `json:"addresses"`
+	Excluded         []cbrAddress `json:"excluded,omitempty"`
+	AddressCount     *int64       `json:"address_count,omitempty"`
+	ExcludedCount    *int64       `json:"excluded_count,omitempty"`
+	Href             *string      `json:"href,omitempty"`
+	CreatedAt        *string      `json:"created_at,omitempty"`
+	CreatedByID      *string      `json:"created_by_id,omitempty"`
+	LastModifiedAt   *string      `json:"last_modified_at,omitempty"`
+	LastModifiedByID *string      `json:"last_modified_by_id,omitempty"`
+}
+
+type cbrRuleAttribute struct {
+	Name     *string `json:"name"`
+	Value    *string `json:"value"`
+	Operator *string `json:"operator,omitempty"`
+}
+
+type cbrRuleContext struct {
+	Attributes []cbrRuleAttribute `json:"attributes"`
+}
+
+type cbrRuleResource struct {
+	Attributes []cbrRuleAttribute `json:"attributes"`
+	Tags       []cbrRuleAttribute `json:"tags,omitempty"`
+}
+
+type cbrRule struct {
+	ID               *string           `json:"id,omitempty"`
+	CRN              *string           `json:"crn,omitempty"`
+	Description      *string           `json:"description,omitempty"`
+	Contexts         []cbrRuleContext  `json:"contexts"`
+	Resources        []cbrRuleResource `json:"resources"`
+	EnforcementMode  *string           `json:"enforcement_mode,omitempty"`
+	Href             *string           `json:"href,omitempty"`
+	CreatedAt        *string           `json:"created_at,omitempty"`
+	CreatedByID      *string           `json:"created_by_id,omitempty"`
+	LastModifiedAt   *string           `json:"last_modified_at,omitempty"`
+	LastModifiedByID *string           `json:"last_modified_by_id,omitempty"`
+}
+
+func newContextBasedRestrictionsV1(serviceURL string, authenticator core.Authenticator) (*contextBasedRestrictionsV1, error) {
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           serviceURL,
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &contextBasedRestrictionsV1{Service: service}, nil
+}
+
+// request sends a request to the Context Based Restrictions API, result is decoded from the JSON response when it is not nil
+func (cbr *contextBasedRestrictionsV1) request(ctx context.Context, method, path, ifMatch string, body, result interface{}) (*core.DetailedResponse, error) {
+	return sendCoreRequest(ctx, cbr.Service, coreRequest{Method: method, Path: path, Headers: map[string]string{"If-Match": ifMatch}, Body: body}, result)
+}
+
+func (cbr *contextBasedRestrictionsV1) CreateZone(ctx context.Context, zone *cbrZone) (*cbrZone, *core.DetailedResponse, error) {
+	result := &cbrZone{}
+	response, err := cbr.request(ctx, core.POST, cbrZonesPath, "", zone, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// GetZone returns the zone together with the response, the ETag header of the response is the version of the zone
+func (cbr *contextBasedRestrictionsV1) GetZone(ctx context.Context, zoneID string) (*cbrZone, *core.DetailedResponse, error) {
+	result := &cbrZone{}
+	response, err := cbr.request(ctx, core.GET, cbrZonesPath+"/"+url.PathEscape(zoneID), "", nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func (cbr *contextBasedRestrictionsV1) ReplaceZone(ctx context.Context, zoneID, ifMatch string, zone *cbrZone) (*core.DetailedResponse, error) {
+	return cbr.request(ctx, core.PUT, cbrZonesPath+"/"+url.PathEscape(zoneID), ifMatch, zone, nil)
+}
+
+func (cbr *contextBasedRestrictionsV1) DeleteZone(ctx context.Context, zoneID string) (*core.DetailedResponse, error) {
+	return cbr.request(ctx, core.DELETE, cbrZonesPath+"/"+url.PathEscape(zoneID), "", nil, nil)
+}
+
+func (cbr *contextBasedRestrictionsV1) CreateRule(ctx context.Context, rule *cbrRule) (*cbrRule, *core.DetailedResponse, error) {
+	result := &cbrRule{}
+	response, err := cbr.request(ctx, core.POST, cbrRulesPath, "", rule, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+// GetRule returns the rule together with the response, the ETag header of the response is the version of the rule
+func (cbr *contextBasedRestrictionsV1) GetRule(ctx context.Context, ruleID string) (*cbrRule, *core.DetailedResponse, error) {
+	result := &cbrRule{}
+	response, err := cbr.request(ctx, core.GET, cbrRulesPath+"/"+url.PathEscape(ruleID), "", nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result, response, nil
+}
+
+func (cbr *contextBasedRestrictionsV1) ReplaceRule(ctx context.Context, ruleID, ifMatch string, rule *cbrRule) (*core.DetailedResponse, error) {
+	return cbr.request(ctx, core.PUT, cbrRulesPath+"/"+url.PathEscape(ruleID), ifMatch, rule, nil)
+}
+
+func (cbr *contextBasedRestrictionsV1) DeleteRule(ctx context.Context, ruleID string) (*core.DetailedResponse, error) {
+	return cbr.request(ctx, core.DELETE, cbrRulesPath+"/"+url.PathEscape(ruleID), "", nil, nil)
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	gohttp "net/http"
+	"net/url"
+
+	"github.com/IBM-Cloud/bluemix-go/api/certificatemanager"
+)
+
+// certificateManagerRestClient is implemented by the bluemix-go Certificate Manager service, the requests of
+// the API that bluemix-go doesn't implement, like notification channels, are sent with it
+type certificateManagerRestClient interface {
+	Get(path string, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+	Put(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+	Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+	Delete(path string, extraHeader ...interface{}) (*gohttp.Response, error)
+}
+
+// certificateManagerNotificationChannel is a Slack, webhook or Event Notifications channel, the endpoint of an Event
+// Notifications channel is the CRN of the Event Notifications instance
+type certificateManagerNotificationChannel struct {
+	ID               string `json:"id,omitempty"`
+	Type             string `json:"type"`
+	Endpoint         string `json:"endpoint"`
+	IsActive         bool   `json:"is_active"`
+	ExpiryThresholds []int  `json:"expiry_thresholds,omitempty"`
+	Version          int    `json:"version,omitempty"`
+}
+
+type certificateManagerChannelTestResult struct {
+	Message string `json:"message"`
+}
+
+func certificateManagerREST(cmService certificatemanager.CertificateManagerServiceAPI) (certificateManagerRestClient, error) {
+	client, ok := cmService.(certificateManagerRestClient)
+	if !ok {
+		return nil, fmt.Errorf("The Certificate Manager client does not support notification channels")
+	}
+	return client, nil
+}
+
+func certificateManagerChannelsPath(instanceID string) string {
+	return fmt.Sprintf("/api/v1/instances/%s/notifications/channels", url.QueryEscape(instanceID))
+}
+
+func certificateManagerChannelPath(instanceID, channelID string) string {
+	return fmt.Sprintf("%s/%s", certificateManagerChannelsPath(instanceID), url.PathEscape(channelID))
+}
+
+func createCertificateManagerChannel(client certificateManagerRestClient, instanceID string, channel certificateManagerNotificationChannel) (*certificateManagerNotificationChannel, error) {
+	result := &certificateManagerNotificationChannel{}
+	_, err := client.Post(certificateManagerChannelsPath(instanceID), channel, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func getCertificateManagerChannel(client certificateManagerRestClient, instanceID, channelID string) (*certificateManagerNotificationChannel, error) {
+	result := &certificateManagerNotificationChannel{}
+	_, err := client.Get(certificateManagerChannelPath(instanceID, channelID), result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func updateCertificateManagerChannel(client certificateManagerRestClient, instanceID, channelID string, channel certificateManagerNotificationChannel) error {
+	_, err := client.Put(certificateManagerChannelPath(instanceID, channelID), channel, nil)
+	return err
+}
+
+func deleteCertificateManagerChannel(client certificateManagerRestClient, instanceID, channelID string) error {
+	_, err := client.Delete(certificateManagerChannelPath(instanceID, channelID))
+	return err
+}
+
+// testCertificateManagerChannel sends a test notification to the channel
+func testCertificateManagerChannel(client certificateManagerRestClient, instanceID, channelID string) (string, error) {
+	result := &certificateManagerChannelTestResult{}
+	_, err := client.Post(certificateManagerChannelPath(instanceID, channelID)+"/test", nil, result)
+	if err != nil {
+		return "", err
+	}
+	return result.Message, nil
+}
@@ -0,0 +1,26 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"testing"
+)
+
+func TestCertificateManagerChannelIDParts(t *testing.T) {
+	instanceID, channelID, err := certificateManagerChannelIDParts("crn:v1:bluemix:public:cloudcerts:us-south:a/1234:instance::" + certificateManagerChannelIDSeparator + "channel")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if instanceID != "crn:v1:bluemix:public:cloudcerts:us-south:a/1234:instance::" || channelID != "channel" {
+		t.Errorf("unexpected instance %q and channel %q", instanceID, channelID)
+	}
+	if path := certificateManagerChannelPath(instanceID, channelID); path != "/api/v1/instances/crn%3Av1%3Abluemix%3Apublic%3Acloudcerts%3Aus-south%3Aa%2F1234%3Ainstance%3A%3A/notifications/channels/channel" {
+		t.Errorf("unexpected path %s", path)
+	}
+	for _, id := range []string{"channel", ":channel:channel", "crn::channel:"} {
+		if _, _, err := certificateManagerChannelIDParts(id); err == nil {
+			t.Errorf("expected an error for ID %q", id)
+		}
+	}
+}
@@ -212,6 +212,7 @@ type ClientSession interface {
 	CertificateManagerAPI() (certificatemanager.CertificateManagerServiceAPI, error)
 	keyProtectAPI() (*kp.Client, error)
 	keyManagementAPI() (*kp.Client, error)
+	keyManagementV2API() (*kmsKeysV2, error)
 	VpcV1API() (*vpc.VpcV1, error)
 	APIGateway() (*apigateway.ApiGatewayControllerApiV1, error)
 	PrivateDNSClientSession() (*dns.DnsSvcsV1, error)
@@ -253,8 +254,10 @@ type ClientSession interface {
 	SchematicsV1() (*schematicsv1.SchematicsV1, error)
 	SatelliteClientSession() (*kubernetesserviceapiv1.KubernetesServiceApiV1, error)
 	SatellitLinkClientSession() (*satellitelinkv1.SatelliteLinkV1, error)
+	ContextBasedRestrictionsV1() (*contextBasedRestrictionsV1, error)
 	CisFiltersSession() (*cisfiltersv1.FiltersV1, error)
 	AtrackerV1() (*atrackerv1.AtrackerV1, error)
+	AtrackerV2() (*atrackerV2, error)
 	FindingsV1() (*findingsv1.FindingsV1, error)
 }
 
@@ -337,6 +340,9 @@ type clientSession struct {
 	kmsErr error
 	kmsAPI *kp.API
 
+	kmsKeysClient    *kmsKeysV2
+	kmsKeysClientErr error
+
 	hpcsEndpointErr error
 	hpcsEndpointAPI hpcs.HPCSV2
 
@@ -500,9 +506,16 @@ type clientSession struct {
 	atrackerClient    *atrackerv1.AtrackerV1
 	atrackerClientErr error
 
+	atrackerV2Client    *atrackerV2
+	atrackerV2ClientErr error
+
 	//Satellite link service
 	satelliteLinkClient    *satellitelinkv1.SatelliteLinkV1
 	satelliteLinkClientErr error
+
+	//Context Based Restrictions service
+	cbrClient    *contextBasedRestrictionsV1
+	cbrClientErr error
 	// Security and Compliance Center (SCC)
 	findingsClient    *findingsv1.FindingsV1
 	findingsClientErr error
@@ -668,6 +681,10 @@ func (sess clientSession) keyManagementAPI() (*kp.Client, error) {
 	return sess.kmsAPI, sess.kmsErr
 }
 
+func (sess clientSession) keyManagementV2API() (*kmsKeysV2, error) {
+	return sess.kmsKeysClient, sess.kmsKeysClientErr
+}
+
 func (sess clientSession) VpcV1API() (*vpc.VpcV1, error) {
 	return sess.vpcAPI, sess.vpcErr
 }
@@ -909,6 +926,11 @@ func (session clientSession) SatellitLinkClientSession() (*satellitelinkv1.Satel
 	return session.satelliteLinkClient, session.satelliteLinkClientErr
 }
 
+// Context Based Restrictions
+func (session clientSession) ContextBasedRestrictionsV1() (*contextBasedRestrictionsV1, error) {
+	return session.cbrClient, session.cbrClientErr
+}
+
 var cloudEndpoint = "cloud.ibm.com"
 
 // Session to the Satellite client
@@ -929,6 +951,11 @@ func (session clientSession) AtrackerV1() (*atrackerv1.AtrackerV1, error) {
 	return session.atrackerClient, session.atrackerClientErr
 }
 
+// Activity Tracker API version 2
+func (session clientSession) AtrackerV2() (*atrackerV2, error) {
+	return session.atrackerV2Client, session.atrackerV2ClientErr
+}
+
 // Security and Compliance center Findings API
 func (session clientSession) FindingsV1() (*findingsv1.FindingsV1, error) {
 	if session.findingsClientErr != nil {
@@ -961,6 +988,8 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.pushServiceClientErr = errEmptyBluemixCredentials
 		session.appConfigurationClientErr = errEmptyBluemixCredentials
 		session.kmsErr = errEmptyBluemixCredentials
+		session.kmsKeysClientErr = errEmptyBluemixCredentials
+		session.atrackerV2ClientErr = errEmptyBluemixCredentials
 		session.cfConfigErr = errEmptyBluemixCredentials
 		session.cisConfigErr = errEmptyBluemixCredentials
 		session.functionConfigErr = errEmptyBluemixCredentials
@@ -1020,6 +1049,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.satelliteClientErr = errEmptyBluemixCredentials
 		session.iamPolicyManagementErr = errEmptyBluemixCredentials
 		session.satelliteLinkClientErr = errEmptyBluemixCredentials
+		session.cbrClientErr = errEmptyBluemixCredentials
 
 		return session, nil
 	}
@@ -1263,6 +1293,15 @@ func (c *Config) ClientSession() (interface{}, error) {
 	} else {
 		session.atrackerClientErr = fmt.Errorf("Error occurred while configuring Activity Tracker API service: %q", err)
 	}
+	session.atrackerV2Client, err = newAtrackerV2(envFallBack([]string{"IBMCLOUD_ATRACKER_API_ENDPOINT"}, atrackerClientURL), authenticator)
+	if err == nil {
+		session.atrackerV2Client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.atrackerV2Client.Service.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	} else {
+		session.atrackerV2ClientErr = fmt.Errorf("Error occurred while configuring Activity Tracker API service: %q", err)
+	}
 
 	// Construct an "options" struct for creating the service client.
 	var findingsClientURL string
@@ -2267,6 +2306,34 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.satelliteLinkClientErr = fmt.Errorf("Error occurred while configuring Satellite Link service: %q", err)
 	}
 
+	// Context Based Restrictions Service
+	cbrEndpoint := cbrDefaultServiceURL
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		cbrEndpoint = contructEndpoint("private.cbr", cloudEndpoint)
+	}
+	session.cbrClient, err = newContextBasedRestrictionsV1(envFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrEndpoint), authenticator)
+	if err == nil {
+		// Enable retries for API calls
+		session.cbrClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		// Add custom header for analytics
+		session.cbrClient.Service.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	} else {
+		session.cbrClientErr = fmt.Errorf("Error occurred while configuring Context Based Restrictions service: %q", err)
+	}
+
+	// Key Protect and HPCS key requests that keyprotect-go-client does not implement
+	session.kmsKeysClient, err = newKmsKeysV2(envFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, kmsurl), authenticator)
+	if err == nil {
+		session.kmsKeysClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.kmsKeysClient.Service.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	} else {
+		session.kmsKeysClientErr = fmt.Errorf("Error occurred while configuring key Service: %q", err)
+	}
+
 	return session, nil
 }
 
@@ -0,0 +1,284 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type kubeSecret struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Metadata   struct {
+		Name            string `json:"name"`
+		Namespace       string `json:"namespace"`
+		ResourceVersion string `json:"resourceVersion,omitempty"`
+	} `json:"metadata"`
+	Type string            `json:"type,omitempty"`
+	Data map[string]string `json:"data,omitempty"`
+}
+
+// containerKubeClient is a minimal client of the Kubernetes API server of a cluster that authenticates
+// with the admin certificates of the cluster
+type containerKubeClient struct {
+	host   string
+	token  string
+	client *http.Client
+}
+
+func newContainerKubeClient(cluster string, d *schema.ResourceData, meta interface{}) (*containerKubeClient, error) {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	configDir, err := ioutil.TempDir("", "ibm-cluster-config")
+	if err != nil {
+		return nil, fmt.Errorf("Error creating a directory for the cluster config: %s", err)
+	}
+	defer os.RemoveAll(configDir)
+
+	clusterKeyDetails, err := csClient.Clusters().GetClusterConfigDetail(cluster, configDir, true, targetEnv)
+	if err != nil {
+		return nil, fmt.Errorf("Error downloading the cluster config [%s]: %s", cluster, err)
+	}
+
+	tlsConfig := &tls.Config{}
+	if clusterKeyDetails.ClusterCACertificate != "" {
+		pool := x509.NewCertPool()
+		pool.AppendCertsFromPEM([]byte(clusterKeyDetails.ClusterCACertificate))
+		tlsConfig.RootCAs = pool
+	}
+	if clusterKeyDetails.Admin != "" && clusterKeyDetails.AdminKey != "" {
+		cert, err := tls.X509KeyPair([]byte(clusterKeyDetails.Admin), []byte(clusterKeyDetails.AdminKey))
+		if err != nil {
+			return nil, fmt.Errorf("Error loading the admin certificate of cluster %s: %s", cluster, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return &containerKubeClient{
+		host:  strings.TrimSuffix(clusterKeyDetails.Host, "/"),
+		token: clusterKeyDetails.Token,
+		client: &http.Client{
+			Timeout:   60 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+func (k *containerKubeClient) do(method, path string, body interface{}, result interface{}) (int, error) {
+	return k.doWithContentType(method, path, "application/json", body, result)
+}
+
+func (k *containerKubeClient) doWithContentType(method, path, contentType string, body interface{}, result interface{}) (int, error) {
+	var reader *bytes.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+		reader = bytes.NewReader(b)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	req, err := http.NewRequest(method, k.host+path, reader)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", contentType)
+	if k.token != "" {
+		req.Header.Set("Authorization", "Bearer "+k.token)
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	raw, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return resp.StatusCode, nil
+	}
+	if resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("%s %s failed with status %d: %s", method, path, resp.StatusCode, string(raw))
+	}
+	if result != nil {
+		return resp.StatusCode, json.Unmarshal(raw, result)
+	}
+	return resp.StatusCode, nil
+}
+
+func (k *containerKubeClient) listNamespaces() ([]string, error) {
+	var list struct {
+		Items []struct {
+			Metadata struct {
+				Name string `json:"name"`
+			} `json:"metadata"`
+		} `json:"items"`
+	}
+	_, err := k.do(http.MethodGet, "/api/v1/namespaces", nil, &list)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing namespaces: %s", err)
+	}
+	namespaces := make([]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		namespaces = append(namespaces, item.Metadata.Name)
+	}
+	return namespaces, nil
+}
+
+func (k *containerKubeClient) getSecret(namespace, name string) (*kubeSecret, error) {
+	secret := &kubeSecret{}
+	status, err := k.do(http.MethodGet, fmt.Sprintf("/api/v1/namespaces/%s/secrets/%s", namespace, name), nil, secret)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving secret %s in namespace %s: %s", name, namespace, err)
+	}
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+	return secret, nil
+}
+
+func (k *containerKubeClient) createSecret(namespace string, secret kubeSecret) error {
+	_, err := k.do(http.MethodPost, fmt.Sprintf("/api/v1/namespaces/%s/secrets", namespace), secret, nil)
+	if err != nil {
+		return fmt.Errorf("Error creating secret %s in namespace %s: %s", secret.Metadata.Name, namespace, err)
+	}
+	return nil
+}
+
+func (k *containerKubeClient) updateSecret(namespace string, secret kubeSecret) error {
+	_, err := k.do(http.MethodPut, fmt.Sprintf("/api/v1/namespaces/%s/secrets/%s", namespace, secret.Metadata.Name), secret, nil)
+	if err != nil {
+		return fmt.Errorf("Error updating secret %s in namespace %s: %s", secret.Metadata.Name, namespace, err)
+	}
+	return nil
+}
+
+func (k *containerKubeClient) deleteSecret(namespace, name string) error {
+	_, err := k.do(http.MethodDelete, fmt.Sprintf("/api/v1/namespaces/%s/secrets/%s", namespace, name), nil, nil)
+	if err != nil {
+		return fmt.Errorf("Error deleting secret %s in namespace %s: %s", name, namespace, err)
+	}
+	return nil
+}
+
+func (k *containerKubeClient) cordonNode(name string) error {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"unschedulable": true,
+		},
+	}
+	_, err := k.doWithContentType(http.MethodPatch, fmt.Sprintf("/api/v1/nodes/%s", name), "application/strategic-merge-patch+json", patch, nil)
+	if err != nil {
+		return fmt.Errorf("Error cordoning node %s: %s", name, err)
+	}
+	return nil
+}
+
+// evictionAPIVersion returns the API version of the evictions served by the cluster, policy/v1 from Kubernetes 1.22
+// and policy/v1beta1 before, which is removed in Kubernetes 1.25
+func (k *containerKubeClient) evictionAPIVersion() (string, error) {
+	var list struct {
+		Resources []struct {
+			Name    string `json:"name"`
+			Group   string `json:"group"`
+			Version string `json:"version"`
+		} `json:"resources"`
+	}
+	_, err := k.do(http.MethodGet, "/api/v1", nil, &list)
+	if err != nil {
+		return "", fmt.Errorf("Error listing the resources of the core API: %s", err)
+	}
+	for _, r := range list.Resources {
+		if r.Name == "pods/eviction" && r.Group == "policy" && r.Version != "" {
+			return "policy/" + r.Version, nil
+		}
+	}
+	return "policy/v1", nil
+}
+
+// drainNode evicts the pods of the node, except the DaemonSet and mirror pods. Evictions that are blocked by a
+// pod disruption budget are retried until the timeout expires.
+func (k *containerKubeClient) drainNode(name string, timeout time.Duration) error {
+	var list struct {
+		Items []struct {
+			Metadata struct {
+				Name            string            `json:"name"`
+				Namespace       string            `json:"namespace"`
+				Annotations     map[string]string `json:"annotations"`
+				OwnerReferences []struct {
+					Kind string `json:"kind"`
+				} `json:"ownerReferences"`
+			} `json:"metadata"`
+		} `json:"items"`
+	}
+	_, err := k.do(http.MethodGet, "/api/v1/pods?fieldSelector=spec.nodeName%3D"+name, nil, &list)
+	if err != nil {
+		return fmt.Errorf("Error listing the pods of node %s: %s", name, err)
+	}
+	apiVersion, err := k.evictionAPIVersion()
+	if err != nil {
+		return err
+	}
+
+	for _, pod := range list.Items {
+		if _, ok := pod.Metadata.Annotations["kubernetes.io/config.mirror"]; ok {
+			continue
+		}
+		daemonSet := false
+		for _, owner := range pod.Metadata.OwnerReferences {
+			if owner.Kind == "DaemonSet" {
+				daemonSet = true
+			}
+		}
+		if daemonSet {
+			continue
+		}
+
+		eviction := map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       "Eviction",
+			"metadata": map[string]string{
+				"name":      pod.Metadata.Name,
+				"namespace": pod.Metadata.Namespace,
+			},
+		}
+		path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/eviction", pod.Metadata.Namespace, pod.Metadata.Name)
+		err = resource.Retry(timeout, func() *resource.RetryError {
+			status, err := k.do(http.MethodPost, path, eviction, nil)
+			if status == http.StatusTooManyRequests {
+				return resource.RetryableError(err)
+			}
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return fmt.Errorf("Error evicting pod %s/%s from node %s: %s", pod.Metadata.Namespace, pod.Metadata.Name, name, err)
+		}
+	}
+	return nil
+}
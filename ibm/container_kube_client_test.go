@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestContainerKubeClientEvictionAPIVersion(t *testing.T) {
+	cases := map[string]string{
+		// Kubernetes 1.22 and later
+		`{"resources":[{"name":"pods","version":""},{"name":"pods/eviction","group":"policy","version":"v1"}]}`: "policy/v1",
+		// Kubernetes 1.21 and earlier
+		`{"resources":[{"name":"pods/eviction","group":"policy","version":"v1beta1"}]}`: "policy/v1beta1",
+		`{"resources":[]}`: "policy/v1",
+	}
+	for resources, expected := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/api/v1" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(resources))
+		}))
+		kube := &containerKubeClient{host: server.URL, client: server.Client()}
+
+		apiVersion, err := kube.evictionAPIVersion()
+		server.Close()
+		if err != nil {
+			t.Fatalf("evictionAPIVersion failed: %s", err)
+		}
+		if apiVersion != expected {
+			t.Errorf("evictionAPIVersion() = %s for %s, expected %s", apiVersion, resources, expected)
+		}
+	}
+}
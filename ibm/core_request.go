@@ -0,0 +1,100 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// coreRequest is a request to an API, or to a part of an API, that the SDKs don't implement
+type coreRequest struct {
+	Method string
+	// URL overrides the URL of the service, Path is resolved against it
+	URL     string
+	Path    string
+	Query   url.Values
+	Headers map[string]string
+	// Accept and ContentType default to application/json, ContentType is only sent with a body
+	Accept      string
+	ContentType string
+	Body        interface{}
+	// UseNumber decodes the numbers of the response as json.Number instead of float64
+	UseNumber bool
+}
+
+// sendCoreRequest sends req with service, so that the request is authenticated and retried like the requests of the
+// SDK the service belongs to. result is decoded from the JSON response when it is not nil, whatever the JSON media
+// type of the response is.
+func sendCoreRequest(ctx context.Context, service *core.BaseService, req coreRequest, result interface{}) (*core.DetailedResponse, error) {
+	serviceURL := req.URL
+	if serviceURL == "" {
+		serviceURL = service.Options.URL
+	}
+	accept := req.Accept
+	if accept == "" {
+		accept = core.APPLICATION_JSON
+	}
+	contentType := req.ContentType
+	if contentType == "" {
+		contentType = core.APPLICATION_JSON
+	}
+
+	builder := core.NewRequestBuilder(req.Method)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = service.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(serviceURL, req.Path, nil)
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range req.Query {
+		for _, value := range values {
+			builder.AddQuery(name, value)
+		}
+	}
+	builder.AddHeader("Accept", accept)
+	for name, value := range req.Headers {
+		if value != "" {
+			builder.AddHeader(name, value)
+		}
+	}
+	if req.Body != nil {
+		builder.AddHeader("Content-Type", contentType)
+		if _, err = builder.SetBodyContentJSON(req.Body); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return service.Request(request, nil)
+	}
+
+	// The body is decoded here, the core only decodes the application/json media type
+	var responseBody io.ReadCloser
+	response, err := service.Request(request, &responseBody)
+	if err != nil {
+		return response, err
+	}
+	if responseBody == nil {
+		return response, nil
+	}
+	defer responseBody.Close()
+	decoder := json.NewDecoder(responseBody)
+	if req.UseNumber {
+		decoder.UseNumber()
+	}
+	if err = decoder.Decode(result); err != nil && err != io.EOF {
+		return response, fmt.Errorf("Error decoding the response: %s", err)
+	}
+	return response, nil
+}
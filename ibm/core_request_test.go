@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// testAPIServer starts a server that answers the requests of the API clients under test with handler, the server is
+// closed when the test ends
+func testAPIServer(t *testing.T, handler http.HandlerFunc) string {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+// testCoreService returns a service for the clients that are built on *core.BaseService, its requests go to a test
+// server that answers them with handler
+func testCoreService(t *testing.T, handler http.HandlerFunc) *core.BaseService {
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           testAPIServer(t, handler),
+		Authenticator: &core.NoAuthAuthenticator{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return service
+}
+
+func TestSendCoreRequest(t *testing.T) {
+	var method, path, query, ifMatch, accept, contentType string
+	body := map[string]interface{}{}
+	service := testCoreService(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path, query = r.Method, r.URL.Path, r.URL.RawQuery
+		ifMatch, accept, contentType = r.Header.Get("If-Match"), r.Header.Get("Accept"), r.Header.Get("Content-Type")
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/vnd.example+json")
+		w.Write([]byte(`{"id":12345678901234567890}`))
+	})
+
+	result := map[string]interface{}{}
+	_, err := sendCoreRequest(context.Background(), service, coreRequest{
+		Method:    core.PUT,
+		Path:      "/v1/things/a",
+		Query:     url.Values{"force": {"true"}},
+		Headers:   map[string]string{"If-Match": "1-abc", "X-Empty": ""},
+		Body:      map[string]string{"name": "thing"},
+		UseNumber: true,
+	}, &result)
+	if err != nil {
+		t.Fatalf("sendCoreRequest failed: %s", err)
+	}
+	if method != core.PUT || path != "/v1/things/a" || query != "force=true" || ifMatch != "1-abc" {
+		t.Errorf("unexpected request %s %s?%s with If-Match %q", method, path, query, ifMatch)
+	}
+	if accept != core.APPLICATION_JSON || contentType != core.APPLICATION_JSON || body["name"] != "thing" {
+		t.Errorf("unexpected body %v sent as %q accepting %q", body, contentType, accept)
+	}
+	if result["id"] != json.Number("12345678901234567890") {
+		t.Errorf("unexpected result %v", result)
+	}
+
+	if _, err := sendCoreRequest(context.Background(), service, coreRequest{Method: core.DELETE, Path: "/v1/things/a"}, nil); err != nil {
+		t.Errorf("sendCoreRequest without result failed: %s", err)
+	}
+	if method != core.DELETE || contentType != "" {
+		t.Errorf("unexpected request %s with content type %q", method, contentType)
+	}
+}
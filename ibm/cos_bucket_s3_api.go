@@ -0,0 +1,361 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
+	"github.com/IBM/ibm-cos-sdk-go/private/checksum"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol"
+	"github.com/IBM/ibm-cos-sdk-go/private/protocol/restxml"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+// The S3 operations below are supported by Cloud Object Storage but are missing from the pinned
+// ibm-cos-sdk-go. They are built on the client of the SDK, so they share its endpoint, IAM
+// credentials, retries and XML marshaling. The shapes follow the S3 API.
+
+// Lifecycle
+
+type cosLifecycleConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	Rules []*cosLifecycleRule `locationName:"Rule" type:"list" flattened:"true" required:"true"`
+}
+
+// cosLifecycleRule is s3.LifecycleRule with the noncurrent version expiration and the abort of
+// incomplete multipart uploads.
+type cosLifecycleRule struct {
+	_ struct{} `type:"structure"`
+
+	AbortIncompleteMultipartUpload *cosAbortIncompleteMultipartUpload `type:"structure"`
+	Expiration                     *s3.LifecycleExpiration            `type:"structure"`
+	Filter                         *s3.LifecycleRuleFilter            `type:"structure" required:"true"`
+	ID                             *string                            `type:"string"`
+	NoncurrentVersionExpiration    *cosNoncurrentVersionExpiration    `type:"structure"`
+	Status                         *string                            `type:"string" required:"true"`
+	Transitions                    []*s3.Transition                   `locationName:"Transition" type:"list" flattened:"true"`
+}
+
+type cosAbortIncompleteMultipartUpload struct {
+	_ struct{} `type:"structure"`
+
+	DaysAfterInitiation *int64 `type:"integer"`
+}
+
+type cosNoncurrentVersionExpiration struct {
+	_ struct{} `type:"structure"`
+
+	NoncurrentDays *int64 `type:"integer"`
+}
+
+type cosPutBucketLifecycleConfigurationInput struct {
+	_ struct{} `locationName:"PutBucketLifecycleConfigurationRequest" type:"structure" payload:"LifecycleConfiguration"`
+
+	Bucket                 *string                    `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	LifecycleConfiguration *cosLifecycleConfiguration `locationName:"LifecycleConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type cosGetBucketLifecycleConfigurationInput struct {
+	_ struct{} `locationName:"GetBucketLifecycleConfigurationRequest" type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+}
+
+type cosGetBucketLifecycleConfigurationOutput struct {
+	_ struct{} `type:"structure"`
+
+	Rules []*cosLifecycleRule `locationName:"Rule" type:"list" flattened:"true"`
+}
+
+// s3Rule returns the rule as a s3.LifecycleRule for the archive and expire rule flatteners.
+func (r *cosLifecycleRule) s3Rule() *s3.LifecycleRule {
+	return &s3.LifecycleRule{
+		Expiration:  r.Expiration,
+		Filter:      r.Filter,
+		ID:          r.ID,
+		Status:      r.Status,
+		Transitions: r.Transitions,
+	}
+}
+
+// cosLifecycleRuleFromS3 returns the s3.LifecycleRule built by the archive and expire rules.
+func cosLifecycleRuleFromS3(r *s3.LifecycleRule) *cosLifecycleRule {
+	return &cosLifecycleRule{
+		Expiration:  r.Expiration,
+		Filter:      r.Filter,
+		ID:          r.ID,
+		Status:      r.Status,
+		Transitions: r.Transitions,
+	}
+}
+
+func cosPutBucketLifecycleConfiguration(ctx context.Context, c *s3.S3, input *cosPutBucketLifecycleConfigurationInput) error {
+	op := &request.Operation{
+		Name:       "PutBucketLifecycleConfiguration",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?lifecycle",
+	}
+	return cosSendRequest(ctx, c, op, input, nil, true)
+}
+
+func cosGetBucketLifecycleConfiguration(ctx context.Context, c *s3.S3, input *cosGetBucketLifecycleConfigurationInput) (*cosGetBucketLifecycleConfigurationOutput, error) {
+	op := &request.Operation{
+		Name:       "GetBucketLifecycleConfiguration",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?lifecycle",
+	}
+	output := &cosGetBucketLifecycleConfigurationOutput{}
+	return output, cosSendRequest(ctx, c, op, input, output, false)
+}
+
+// Replication
+
+type cosReplicationConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	Rules []*cosReplicationRule `locationName:"Rule" type:"list" flattened:"true" required:"true"`
+}
+
+type cosReplicationRule struct {
+	_ struct{} `type:"structure"`
+
+	DeleteMarkerReplication *cosDeleteMarkerReplication `type:"structure"`
+	Destination             *cosReplicationDestination  `type:"structure" required:"true"`
+	Filter                  *cosReplicationRuleFilter   `type:"structure"`
+	ID                      *string                     `type:"string"`
+	Priority                *int64                      `type:"integer"`
+	Status                  *string                     `type:"string" required:"true"`
+}
+
+type cosDeleteMarkerReplication struct {
+	_ struct{} `type:"structure"`
+
+	Status *string `type:"string"`
+}
+
+type cosReplicationDestination struct {
+	_ struct{} `type:"structure"`
+
+	// Bucket is the CRN of the destination bucket.
+	Bucket *string `type:"string" required:"true"`
+}
+
+type cosReplicationRuleFilter struct {
+	_ struct{} `type:"structure"`
+
+	Prefix *string `type:"string"`
+}
+
+type cosPutBucketReplicationInput struct {
+	_ struct{} `locationName:"PutBucketReplicationRequest" type:"structure" payload:"ReplicationConfiguration"`
+
+	Bucket                   *string                      `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	ReplicationConfiguration *cosReplicationConfiguration `locationName:"ReplicationConfiguration" type:"structure" required:"true" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type cosBucketInput struct {
+	_ struct{} `type:"structure"`
+
+	Bucket *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+}
+
+type cosGetBucketReplicationOutput struct {
+	_ struct{} `type:"structure" payload:"ReplicationConfiguration"`
+
+	ReplicationConfiguration *cosReplicationConfiguration `type:"structure"`
+}
+
+func cosPutBucketReplication(ctx context.Context, c *s3.S3, input *cosPutBucketReplicationInput) error {
+	op := &request.Operation{
+		Name:       "PutBucketReplication",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?replication",
+	}
+	return cosSendRequest(ctx, c, op, input, nil, true)
+}
+
+func cosGetBucketReplication(ctx context.Context, c *s3.S3, input *cosBucketInput) (*cosGetBucketReplicationOutput, error) {
+	op := &request.Operation{
+		Name:       "GetBucketReplication",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?replication",
+	}
+	output := &cosGetBucketReplicationOutput{}
+	return output, cosSendRequest(ctx, c, op, input, output, false)
+}
+
+func cosDeleteBucketReplication(ctx context.Context, c *s3.S3, input *cosBucketInput) error {
+	op := &request.Operation{
+		Name:       "DeleteBucketReplication",
+		HTTPMethod: "DELETE",
+		HTTPPath:   "/{Bucket}?replication",
+	}
+	return cosSendRequest(ctx, c, op, input, nil, false)
+}
+
+// Object Lock
+
+type cosObjectLockConfiguration struct {
+	_ struct{} `type:"structure"`
+
+	ObjectLockEnabled *string            `type:"string"`
+	Rule              *cosObjectLockRule `type:"structure"`
+}
+
+type cosObjectLockRule struct {
+	_ struct{} `type:"structure"`
+
+	DefaultRetention *cosDefaultRetention `type:"structure"`
+}
+
+type cosDefaultRetention struct {
+	_ struct{} `type:"structure"`
+
+	Days  *int64  `type:"integer"`
+	Mode  *string `type:"string"`
+	Years *int64  `type:"integer"`
+}
+
+type cosPutObjectLockConfigurationInput struct {
+	_ struct{} `locationName:"PutObjectLockConfigurationRequest" type:"structure" payload:"ObjectLockConfiguration"`
+
+	Bucket                  *string                     `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	ObjectLockConfiguration *cosObjectLockConfiguration `locationName:"ObjectLockConfiguration" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+}
+
+type cosGetObjectLockConfigurationOutput struct {
+	_ struct{} `type:"structure" payload:"ObjectLockConfiguration"`
+
+	ObjectLockConfiguration *cosObjectLockConfiguration `type:"structure"`
+}
+
+func cosPutObjectLockConfiguration(ctx context.Context, c *s3.S3, input *cosPutObjectLockConfigurationInput) error {
+	op := &request.Operation{
+		Name:       "PutObjectLockConfiguration",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?object-lock",
+	}
+	return cosSendRequest(ctx, c, op, input, nil, true)
+}
+
+func cosGetObjectLockConfiguration(ctx context.Context, c *s3.S3, input *cosBucketInput) (*cosGetObjectLockConfigurationOutput, error) {
+	op := &request.Operation{
+		Name:       "GetObjectLockConfiguration",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?object-lock",
+	}
+	output := &cosGetObjectLockConfigurationOutput{}
+	return output, cosSendRequest(ctx, c, op, input, output, false)
+}
+
+// Object retention and legal hold
+
+type cosObjectLockRetention struct {
+	_ struct{} `type:"structure"`
+
+	Mode            *string    `type:"string"`
+	RetainUntilDate *time.Time `type:"timestamp" timestampFormat:"iso8601"`
+}
+
+type cosObjectLockLegalHold struct {
+	_ struct{} `type:"structure"`
+
+	Status *string `type:"string"`
+}
+
+type cosObjectInput struct {
+	_ struct{} `type:"structure"`
+
+	Bucket    *string `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	Key       *string `location:"uri" locationName:"Key" min:"1" type:"string" required:"true"`
+	VersionId *string `location:"querystring" locationName:"versionId" type:"string"`
+}
+
+type cosPutObjectRetentionInput struct {
+	_ struct{} `locationName:"PutObjectRetentionRequest" type:"structure" payload:"Retention"`
+
+	Bucket    *string                 `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	Key       *string                 `location:"uri" locationName:"Key" min:"1" type:"string" required:"true"`
+	Retention *cosObjectLockRetention `locationName:"Retention" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+	VersionId *string                 `location:"querystring" locationName:"versionId" type:"string"`
+}
+
+type cosGetObjectRetentionOutput struct {
+	_ struct{} `type:"structure" payload:"Retention"`
+
+	Retention *cosObjectLockRetention `type:"structure"`
+}
+
+type cosPutObjectLegalHoldInput struct {
+	_ struct{} `locationName:"PutObjectLegalHoldRequest" type:"structure" payload:"LegalHold"`
+
+	Bucket    *string                 `location:"uri" locationName:"Bucket" type:"string" required:"true"`
+	Key       *string                 `location:"uri" locationName:"Key" min:"1" type:"string" required:"true"`
+	LegalHold *cosObjectLockLegalHold `locationName:"LegalHold" type:"structure" xmlURI:"http://s3.amazonaws.com/doc/2006-03-01/"`
+	VersionId *string                 `location:"querystring" locationName:"versionId" type:"string"`
+}
+
+type cosGetObjectLegalHoldOutput struct {
+	_ struct{} `type:"structure" payload:"LegalHold"`
+
+	LegalHold *cosObjectLockLegalHold `type:"structure"`
+}
+
+func cosPutObjectRetention(ctx context.Context, c *s3.S3, input *cosPutObjectRetentionInput) error {
+	op := &request.Operation{
+		Name:       "PutObjectRetention",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}/{Key+}?retention",
+	}
+	return cosSendRequest(ctx, c, op, input, nil, true)
+}
+
+func cosGetObjectRetention(ctx context.Context, c *s3.S3, input *cosObjectInput) (*cosGetObjectRetentionOutput, error) {
+	op := &request.Operation{
+		Name:       "GetObjectRetention",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}/{Key+}?retention",
+	}
+	output := &cosGetObjectRetentionOutput{}
+	return output, cosSendRequest(ctx, c, op, input, output, false)
+}
+
+func cosPutObjectLegalHold(ctx context.Context, c *s3.S3, input *cosPutObjectLegalHoldInput) error {
+	op := &request.Operation{
+		Name:       "PutObjectLegalHold",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}/{Key+}?legal-hold",
+	}
+	return cosSendRequest(ctx, c, op, input, nil, true)
+}
+
+func cosGetObjectLegalHold(ctx context.Context, c *s3.S3, input *cosObjectInput) (*cosGetObjectLegalHoldOutput, error) {
+	op := &request.Operation{
+		Name:       "GetObjectLegalHold",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}/{Key+}?legal-hold",
+	}
+	output := &cosGetObjectLegalHoldOutput{}
+	return output, cosSendRequest(ctx, c, op, input, output, false)
+}
+
+// cosSendRequest sends the operation with the client of the SDK. Operations without output discard
+// the response body, and operations with a configuration body send its MD5 as COS requires.
+func cosSendRequest(ctx context.Context, c *s3.S3, op *request.Operation, input, output interface{}, contentMD5 bool) error {
+	req := c.NewRequest(op, input, output)
+	if output == nil {
+		req.Handlers.Unmarshal.Swap(restxml.UnmarshalHandler.Name, protocol.UnmarshalDiscardBodyHandler)
+	}
+	if contentMD5 {
+		req.Handlers.Build.PushBackNamed(request.NamedHandler{
+			Name: "contentMd5Handler",
+			Fn:   checksum.AddBodyContentMD5Handler,
+		})
+	}
+	req.SetContext(ctx)
+	return req.Send()
+}
@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+)
+
+func testCosS3Client(endpoint string) *s3.S3 {
+	conf := aws.NewConfig().WithEndpoint(endpoint).WithCredentials(credentials.AnonymousCredentials).WithS3ForcePathStyle(true).WithRegion("us-south").WithMaxRetries(0)
+	return s3.New(session.Must(session.NewSession()), conf)
+}
+
+func TestCosPutBucketReplication(t *testing.T) {
+	var method, md5, body string
+	var query url.Values
+	endpoint := testAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		method, query, md5 = r.Method, r.URL.Query(), r.Header.Get("Content-MD5")
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+	})
+	client := testCosS3Client(endpoint)
+
+	err := cosPutBucketReplication(context.Background(), client, &cosPutBucketReplicationInput{
+		Bucket: aws.String("source"),
+		ReplicationConfiguration: &cosReplicationConfiguration{
+			Rules: []*cosReplicationRule{{
+				ID:                      aws.String("all"),
+				Priority:                aws.Int64(1),
+				Status:                  aws.String("Enabled"),
+				Filter:                  &cosReplicationRuleFilter{Prefix: aws.String("logs/")},
+				Destination:             &cosReplicationDestination{Bucket: aws.String("crn:v1:bluemix:public:cloud-object-storage:global:a/1::bucket:destination")},
+				DeleteMarkerReplication: &cosDeleteMarkerReplication{Status: aws.String("Disabled")},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("cosPutBucketReplication failed: %s", err)
+	}
+	if _, ok := query["replication"]; method != http.MethodPut || !ok || md5 == "" {
+		t.Errorf("unexpected request %s %v with Content-MD5 %q", method, query, md5)
+	}
+	for _, s := range []string{
+		`<ReplicationConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/"><Rule>`,
+		`<Destination><Bucket>crn:v1:bluemix:public:cloud-object-storage:global:a/1::bucket:destination</Bucket></Destination>`,
+		`<Filter><Prefix>logs/</Prefix></Filter>`,
+		`<Priority>1</Priority>`,
+	} {
+		if !strings.Contains(body, s) {
+			t.Errorf("expected %s in the body %s", s, body)
+		}
+	}
+}
+
+func TestCosGetObjectLockConfiguration(t *testing.T) {
+	endpoint := testAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<ObjectLockConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <ObjectLockEnabled>Enabled</ObjectLockEnabled>
+  <Rule><DefaultRetention><Mode>COMPLIANCE</Mode><Days>30</Days></DefaultRetention></Rule>
+</ObjectLockConfiguration>`))
+	})
+	client := testCosS3Client(endpoint)
+
+	output, err := cosGetObjectLockConfiguration(context.Background(), client, &cosBucketInput{Bucket: aws.String("bucket")})
+	if err != nil {
+		t.Fatalf("cosGetObjectLockConfiguration failed: %s", err)
+	}
+	config := output.ObjectLockConfiguration
+	if config == nil || aws.StringValue(config.ObjectLockEnabled) != "Enabled" || config.Rule == nil || config.Rule.DefaultRetention == nil {
+		t.Fatalf("unexpected object lock configuration %+v", config)
+	}
+	if aws.StringValue(config.Rule.DefaultRetention.Mode) != "COMPLIANCE" || aws.Int64Value(config.Rule.DefaultRetention.Days) != 30 {
+		t.Errorf("unexpected default retention %+v", config.Rule.DefaultRetention)
+	}
+}
+
+func TestCosGetBucketLifecycleConfiguration(t *testing.T) {
+	endpoint := testAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?>
+<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+  <Rule><ID>archive</ID><Status>Enabled</Status><Filter/><Transition><Days>30</Days><StorageClass>GLACIER</StorageClass></Transition></Rule>
+  <Rule><ID>noncurrent</ID><Status>Enabled</Status><Filter><Prefix>logs/</Prefix></Filter><NoncurrentVersionExpiration><NoncurrentDays>7</NoncurrentDays></NoncurrentVersionExpiration></Rule>
+  <Rule><ID>multipart</ID><Status>Disabled</Status><Filter/><AbortIncompleteMultipartUpload><DaysAfterInitiation>3</DaysAfterInitiation></AbortIncompleteMultipartUpload></Rule>
+</LifecycleConfiguration>`))
+	})
+	client := testCosS3Client(endpoint)
+
+	output, err := cosGetBucketLifecycleConfiguration(context.Background(), client, &cosGetBucketLifecycleConfigurationInput{Bucket: aws.String("bucket")})
+	if err != nil {
+		t.Fatalf("cosGetBucketLifecycleConfiguration failed: %s", err)
+	}
+	if len(output.Rules) != 3 {
+		t.Fatalf("expected 3 rules, got %d", len(output.Rules))
+	}
+	var s3Rules []*s3.LifecycleRule
+	for _, r := range output.Rules {
+		s3Rules = append(s3Rules, r.s3Rule())
+	}
+	if archive := archiveRuleGet(s3Rules); len(archive) != 1 {
+		t.Errorf("expected 1 archive rule, got %v", archive)
+	}
+	if noncurrent := noncurrentVersionExpirationRuleGet(output.Rules); len(noncurrent) != 1 || noncurrent[0].(map[string]interface{})["noncurrent_days"] != 7 {
+		t.Errorf("unexpected noncurrent version expiration rules %v", noncurrent)
+	}
+	if multipart := abortIncompleteMultipartUploadRuleGet(output.Rules); len(multipart) != 1 || multipart[0].(map[string]interface{})["enable"] != false {
+		t.Errorf("unexpected abort incomplete multipart upload rules %v", multipart)
+	}
+}
+
+func TestCosPutObjectRetention(t *testing.T) {
+	var path, body string
+	var query url.Values
+	endpoint := testAPIServer(t, func(w http.ResponseWriter, r *http.Request) {
+		path, query = r.URL.Path, r.URL.Query()
+		b, _ := ioutil.ReadAll(r.Body)
+		body = string(b)
+	})
+	client := testCosS3Client(endpoint)
+
+	err := cosPutObjectRetention(context.Background(), client, &cosPutObjectRetentionInput{
+		Bucket: aws.String("bucket"),
+		Key:    aws.String("logs/app.log"),
+		Retention: &cosObjectLockRetention{
+			Mode:            aws.String("COMPLIANCE"),
+			RetainUntilDate: aws.Time(time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)),
+		},
+	})
+	if err != nil {
+		t.Fatalf("cosPutObjectRetention failed: %s", err)
+	}
+	if _, ok := query["retention"]; path != "/bucket/logs/app.log" || !ok {
+		t.Errorf("unexpected request path %s query %v", path, query)
+	}
+	// The SDK doesn't keep the order of the elements of the body, which COS doesn't require either
+	var retention struct {
+		XMLName         xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ Retention"`
+		Mode            string
+		RetainUntilDate string
+	}
+	if err := xml.Unmarshal([]byte(body), &retention); err != nil {
+		t.Fatalf("unexpected body %s: %s", body, err)
+	}
+	if retention.Mode != "COMPLIANCE" || retention.RetainUntilDate != "2030-01-02T03:04:05Z" {
+		t.Errorf("unexpected body %s", body)
+	}
+}
@@ -11,8 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/IBM/platform-services-go-sdk/atrackerv1"
 )
 
 func dataSourceIBMAtrackerRoutes() *schema.Resource {
@@ -54,7 +52,8 @@ func dataSourceIBMAtrackerRoutes() *schema.Resource {
 						"receive_global_events": &schema.Schema{
 							Type:        schema.TypeBool,
 							Computed:    true,
-							Description: "Indicates whether or not all global events should be forwarded to this region.",
+							Deprecated:  "use the global location in rules.locations instead",
+							Description: "Indicates whether or not the global events are routed, that is when every rule includes the global location.",
 						},
 						"rules": &schema.Schema{
 							Type:        schema.TypeList,
@@ -70,6 +69,14 @@ func dataSourceIBMAtrackerRoutes() *schema.Resource {
 											Type: schema.TypeString,
 										},
 									},
+									"locations": &schema.Schema{
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The locations of the events that are routed by the rule, such as a region, global for the global events or * for all the locations.",
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
 								},
 							},
 						},
@@ -91,21 +98,19 @@ func dataSourceIBMAtrackerRoutes() *schema.Resource {
 }
 
 func dataSourceIBMAtrackerRoutesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(ClientSession).AtrackerV1()
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	listRoutesOptions := &atrackerv1.ListRoutesOptions{}
-
-	routeList, response, err := atrackerClient.ListRoutesWithContext(context, listRoutesOptions)
+	routeList, response, err := atrackerClient.ListRoutes(context)
 	if err != nil {
-		log.Printf("[DEBUG] ListRoutesWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListRoutesWithContext failed %s\n%s", err, response))
+		log.Printf("[DEBUG] ListRoutes failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListRoutes failed %s\n%s", err, response))
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
-	var matchRoutes []atrackerv1.Route
+	var matchRoutes []atrackerV2Route
 	var name string
 	var suppliedFilter bool
 
@@ -146,7 +151,7 @@ func dataSourceIBMAtrackerRoutesID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
 }
 
-func dataSourceRouteListFlattenRoutes(result []atrackerv1.Route) (routes []map[string]interface{}) {
+func dataSourceRouteListFlattenRoutes(result []atrackerV2Route) (routes []map[string]interface{}) {
 	for _, routesItem := range result {
 		routes = append(routes, dataSourceRouteListRoutesToMap(routesItem))
 	}
@@ -154,7 +159,7 @@ func dataSourceRouteListFlattenRoutes(result []atrackerv1.Route) (routes []map[s
 	return routes
 }
 
-func dataSourceRouteListRoutesToMap(routesItem atrackerv1.Route) (routesMap map[string]interface{}) {
+func dataSourceRouteListRoutesToMap(routesItem atrackerV2Route) (routesMap map[string]interface{}) {
 	routesMap = map[string]interface{}{}
 
 	if routesItem.ID != nil {
@@ -167,34 +172,37 @@ func dataSourceRouteListRoutesToMap(routesItem atrackerv1.Route) (routesMap map[
 		routesMap["crn"] = routesItem.CRN
 	}
 	if routesItem.Version != nil {
-		routesMap["version"] = routesItem.Version
-	}
-	if routesItem.ReceiveGlobalEvents != nil {
-		routesMap["receive_global_events"] = routesItem.ReceiveGlobalEvents
+		routesMap["version"] = intValue(routesItem.Version)
 	}
 	if routesItem.Rules != nil {
 		rulesList := []map[string]interface{}{}
+		receiveGlobalEvents := len(routesItem.Rules) > 0
 		for _, rulesItem := range routesItem.Rules {
 			rulesList = append(rulesList, dataSourceRouteListRoutesRulesToMap(rulesItem))
+			receiveGlobalEvents = receiveGlobalEvents && atrackerRouteHasGlobalLocation(rulesItem.Locations)
 		}
 		routesMap["rules"] = rulesList
+		routesMap["receive_global_events"] = receiveGlobalEvents
 	}
-	if routesItem.Created != nil {
-		routesMap["created"] = routesItem.Created.String()
+	if routesItem.CreatedAt != nil {
+		routesMap["created"] = dateTimeToString(routesItem.CreatedAt)
 	}
-	if routesItem.Updated != nil {
-		routesMap["updated"] = routesItem.Updated.String()
+	if routesItem.UpdatedAt != nil {
+		routesMap["updated"] = dateTimeToString(routesItem.UpdatedAt)
 	}
 
 	return routesMap
 }
 
-func dataSourceRouteListRoutesRulesToMap(rulesItem atrackerv1.Rule) (rulesMap map[string]interface{}) {
+func dataSourceRouteListRoutesRulesToMap(rulesItem atrackerV2Rule) (rulesMap map[string]interface{}) {
 	rulesMap = map[string]interface{}{}
 
 	if rulesItem.TargetIds != nil {
 		rulesMap["target_ids"] = rulesItem.TargetIds
 	}
+	if rulesItem.Locations != nil {
+		rulesMap["locations"] = rulesItem.Locations
+	}
 
 	return rulesMap
 }
@@ -13,7 +13,7 @@ import (
 
 func TestAccIBMAtrackerRoutesDataSourceBasic(t *testing.T) {
 	routeName := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
-	routeReceiveGlobalEvents := "false"
+	routeReceiveGlobalEvents := "true"
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
@@ -26,6 +26,8 @@ func TestAccIBMAtrackerRoutesDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_atracker_routes.atracker_routes", "routes.#"),
 					resource.TestCheckResourceAttr("data.ibm_atracker_routes.atracker_routes", "routes.0.name", routeName),
 					resource.TestCheckResourceAttr("data.ibm_atracker_routes.atracker_routes", "routes.0.receive_global_events", routeReceiveGlobalEvents),
+					resource.TestCheckResourceAttr("data.ibm_atracker_routes.atracker_routes", "routes.0.rules.0.locations.#", "2"),
+					resource.TestCheckResourceAttr("data.ibm_atracker_routes.atracker_routes", "routes.0.rules.0.locations.1", "global"),
 				),
 			},
 		},
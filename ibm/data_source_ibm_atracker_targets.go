@@ -11,8 +11,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-
-	"github.com/IBM/platform-services-go-sdk/atrackerv1"
 )
 
 func dataSourceIBMAtrackerTargets() *schema.Resource {
@@ -51,9 +49,15 @@ func dataSourceIBMAtrackerTargets() *schema.Resource {
 							Computed:    true,
 							Description: "The type of the target.",
 						},
+						"region": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The region of the target.",
+						},
 						"encrypt_key": &schema.Schema{
 							Type:        schema.TypeString,
 							Computed:    true,
+							Deprecated:  "The encryption key is not returned by the Activity Tracker API anymore",
 							Description: "The encryption key that is used to encrypt events before Activity Tracker services buffer them on storage. This credential is masked in the response.",
 						},
 						"cos_endpoint": &schema.Schema{
@@ -83,12 +87,69 @@ func dataSourceIBMAtrackerTargets() *schema.Resource {
 										Sensitive:   true,
 										Description: "The IAM API key that has writer access to the Cloud Object Storage instance. This credential is masked in the response.",
 									},
+									"service_to_service_enabled": &schema.Schema{
+										Type:        schema.TypeBool,
+										Computed:    true,
+										Description: "Determines if IBM Cloud Activity Tracker uses a service to service authorization to write to the Cloud Object Storage bucket instead of the API key.",
+									},
+								},
+							},
+						},
+						"logdna_endpoint": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Property values for a Logging instance (LogDNA) endpoint.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_crn": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The CRN of the Logging instance.",
+									},
+									"ingestion_key": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Sensitive:   true,
+										Description: "The ingestion key of the Logging instance. This credential is masked in the response.",
+									},
+								},
+							},
+						},
+						"eventstreams_endpoint": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Property values for an Event Streams endpoint.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_crn": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The CRN of the Event Streams instance.",
+									},
+									"brokers": &schema.Schema{
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "The Kafka brokers of the Event Streams instance.",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"topic": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The topic the events are written to.",
+									},
+									"api_key": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Sensitive:   true,
+										Description: "The IAM API key that has writer access to the Event Streams instance. This credential is masked in the response.",
+									},
 								},
 							},
 						},
 						"cos_write_status": &schema.Schema{
 							Type:        schema.TypeList,
 							Computed:    true,
+							Deprecated:  "use write_status instead",
 							Description: "The status of the write attempt with the provided cos_endpoint parameters.",
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -110,6 +171,30 @@ func dataSourceIBMAtrackerTargets() *schema.Resource {
 								},
 							},
 						},
+						"write_status": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The status of the write attempt to the target with the provided endpoint parameters.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The status such as failed or success.",
+									},
+									"last_failure": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The timestamp of the failure.",
+									},
+									"reason_for_last_failure": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Detailed description of the cause of the failure.",
+									},
+								},
+							},
+						},
 						"created": &schema.Schema{
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -128,21 +213,19 @@ func dataSourceIBMAtrackerTargets() *schema.Resource {
 }
 
 func dataSourceIBMAtrackerTargetsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(ClientSession).AtrackerV1()
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	listTargetsOptions := &atrackerv1.ListTargetsOptions{}
-
-	targetList, response, err := atrackerClient.ListTargetsWithContext(context, listTargetsOptions)
+	targetList, response, err := atrackerClient.ListTargets(context)
 	if err != nil {
-		log.Printf("[DEBUG] ListTargetsWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ListTargetsWithContext failed %s\n%s", err, response))
+		log.Printf("[DEBUG] ListTargets failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListTargets failed %s\n%s", err, response))
 	}
 
 	// Use the provided filter argument and construct a new list with only the requested resource(s)
-	var matchTargets []atrackerv1.Target
+	var matchTargets []atrackerV2Target
 	var name string
 	var suppliedFilter bool
 
@@ -183,7 +266,7 @@ func dataSourceIBMAtrackerTargetsID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
 }
 
-func dataSourceTargetListFlattenTargets(result []atrackerv1.Target) (targets []map[string]interface{}) {
+func dataSourceTargetListFlattenTargets(result []atrackerV2Target) (targets []map[string]interface{}) {
 	for _, targetsItem := range result {
 		targets = append(targets, dataSourceTargetListTargetsToMap(targetsItem))
 	}
@@ -191,7 +274,7 @@ func dataSourceTargetListFlattenTargets(result []atrackerv1.Target) (targets []m
 	return targets
 }
 
-func dataSourceTargetListTargetsToMap(targetsItem atrackerv1.Target) (targetsMap map[string]interface{}) {
+func dataSourceTargetListTargetsToMap(targetsItem atrackerV2Target) (targetsMap map[string]interface{}) {
 	targetsMap = map[string]interface{}{}
 
 	if targetsItem.ID != nil {
@@ -206,62 +289,31 @@ func dataSourceTargetListTargetsToMap(targetsItem atrackerv1.Target) (targetsMap
 	if targetsItem.TargetType != nil {
 		targetsMap["target_type"] = targetsItem.TargetType
 	}
-	if targetsItem.EncryptKey != nil {
-		targetsMap["encrypt_key"] = targetsItem.EncryptKey
+	if targetsItem.Region != nil {
+		targetsMap["region"] = targetsItem.Region
 	}
 	if targetsItem.CosEndpoint != nil {
-		cosEndpointList := []map[string]interface{}{}
-		cosEndpointMap := dataSourceTargetListTargetsCosEndpointToMap(*targetsItem.CosEndpoint)
-		cosEndpointList = append(cosEndpointList, cosEndpointMap)
-		targetsMap["cos_endpoint"] = cosEndpointList
-	}
-	if targetsItem.CosWriteStatus != nil {
-		cosWriteStatusList := []map[string]interface{}{}
-		cosWriteStatusMap := dataSourceTargetListTargetsCosWriteStatusToMap(*targetsItem.CosWriteStatus)
-		cosWriteStatusList = append(cosWriteStatusList, cosWriteStatusMap)
-		targetsMap["cos_write_status"] = cosWriteStatusList
-	}
-	if targetsItem.Created != nil {
-		targetsMap["created"] = targetsItem.Created.String()
-	}
-	if targetsItem.Updated != nil {
-		targetsMap["updated"] = targetsItem.Updated.String()
-	}
-
-	return targetsMap
-}
-
-func dataSourceTargetListTargetsCosEndpointToMap(cosEndpointItem atrackerv1.CosEndpoint) (cosEndpointMap map[string]interface{}) {
-	cosEndpointMap = map[string]interface{}{}
-
-	if cosEndpointItem.Endpoint != nil {
-		cosEndpointMap["endpoint"] = cosEndpointItem.Endpoint
+		targetsMap["cos_endpoint"] = []map[string]interface{}{resourceIBMAtrackerTargetCosEndpointToMap(*targetsItem.CosEndpoint)}
 	}
-	if cosEndpointItem.TargetCRN != nil {
-		cosEndpointMap["target_crn"] = cosEndpointItem.TargetCRN
+	if targetsItem.LogdnaEndpoint != nil {
+		targetsMap["logdna_endpoint"] = []map[string]interface{}{resourceIBMAtrackerTargetLogdnaEndpointToMap(*targetsItem.LogdnaEndpoint)}
 	}
-	if cosEndpointItem.Bucket != nil {
-		cosEndpointMap["bucket"] = cosEndpointItem.Bucket
+	if targetsItem.EventstreamsEndpoint != nil {
+		targetsMap["eventstreams_endpoint"] = []map[string]interface{}{resourceIBMAtrackerTargetEventstreamsEndpointToMap(*targetsItem.EventstreamsEndpoint)}
 	}
-	if cosEndpointItem.APIKey != nil {
-		cosEndpointMap["api_key"] = cosEndpointItem.APIKey
-	}
-
-	return cosEndpointMap
-}
-
-func dataSourceTargetListTargetsCosWriteStatusToMap(cosWriteStatusItem atrackerv1.CosWriteStatus) (cosWriteStatusMap map[string]interface{}) {
-	cosWriteStatusMap = map[string]interface{}{}
-
-	if cosWriteStatusItem.Status != nil {
-		cosWriteStatusMap["status"] = cosWriteStatusItem.Status
+	if targetsItem.WriteStatus != nil {
+		writeStatus := []map[string]interface{}{resourceIBMAtrackerTargetWriteStatusToMap(*targetsItem.WriteStatus)}
+		targetsMap["write_status"] = writeStatus
+		if targetsItem.CosEndpoint != nil {
+			targetsMap["cos_write_status"] = writeStatus
+		}
 	}
-	if cosWriteStatusItem.LastFailure != nil {
-		cosWriteStatusMap["last_failure"] = cosWriteStatusItem.LastFailure.String()
+	if targetsItem.CreatedAt != nil {
+		targetsMap["created"] = dateTimeToString(targetsItem.CreatedAt)
 	}
-	if cosWriteStatusItem.ReasonForLastFailure != nil {
-		cosWriteStatusMap["reason_for_last_failure"] = cosWriteStatusItem.ReasonForLastFailure
+	if targetsItem.UpdatedAt != nil {
+		targetsMap["updated"] = dateTimeToString(targetsItem.UpdatedAt)
 	}
 
-	return cosWriteStatusMap
+	return targetsMap
 }
@@ -26,6 +26,8 @@ func TestAccIBMAtrackerTargetsDataSourceBasic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("data.ibm_atracker_targets.atracker_targets", "targets.#"),
 					resource.TestCheckResourceAttr("data.ibm_atracker_targets.atracker_targets", "targets.0.name", targetName),
 					resource.TestCheckResourceAttr("data.ibm_atracker_targets.atracker_targets", "targets.0.target_type", targetTargetType),
+					resource.TestCheckResourceAttrSet("data.ibm_atracker_targets.atracker_targets", "targets.0.region"),
+					resource.TestCheckResourceAttr("data.ibm_atracker_targets.atracker_targets", "targets.0.cos_endpoint.0.bucket", "my-atracker-bucket"),
 				),
 			},
 		},
@@ -0,0 +1,146 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMCbrRule() *schema.Resource {
+	attributeSchema := func(withOperator bool) *schema.Resource {
+		s := map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The attribute name.",
+			},
+			"value": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The attribute value.",
+			},
+		}
+		if withOperator {
+			s["operator"] = &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The attribute operator.",
+			}
+		}
+		return &schema.Resource{Schema: s}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCbrRuleRead,
+
+		Schema: map[string]*schema.Schema{
+			"rule_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the rule.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the rule.",
+			},
+			"contexts": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The contexts this rule applies to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The attributes of the context.",
+							Elem:        attributeSchema(false),
+						},
+					},
+				},
+			},
+			"resources": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources this rule apply to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The resource attributes.",
+							Elem:        attributeSchema(true),
+						},
+						"tags": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The optional resource tags.",
+							Elem:        attributeSchema(true),
+						},
+					},
+				},
+			},
+			"enforcement_mode": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rule enforcement mode.",
+			},
+			"crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rule CRN.",
+			},
+			"href": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The href link to the resource.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the resource was created.",
+			},
+			"created_by_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAM ID of the user or service which created the resource.",
+			},
+			"last_modified_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last time the resource was modified.",
+			},
+			"last_modified_by_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAM ID of the user or service which modified the resource.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMCbrRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule, response, err := cbrClient.GetRule(context, d.Get("rule_id").(string))
+	if err != nil {
+		log.Printf("[DEBUG] GetRule failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetRule failed %s\n%s", err, response))
+	}
+
+	d.SetId(*rule.ID)
+	if err = setCbrRuleAttributes(d, rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
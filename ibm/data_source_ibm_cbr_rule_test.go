@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCbrRuleDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-cbr-zone-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCbrRuleConfig(name, "report") + `
+					data "ibm_cbr_rule" "rule" {
+						rule_id = ibm_cbr_rule.rule.id
+					}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cbr_rule.rule", "enforcement_mode", "report"),
+					resource.TestCheckResourceAttrPair("data.ibm_cbr_rule.rule", "crn", "ibm_cbr_rule.rule", "crn"),
+				),
+			},
+		},
+	})
+}
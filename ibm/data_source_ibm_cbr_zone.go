@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMCbrZone() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCbrZoneRead,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the zone.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the zone.",
+			},
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The id of the account owning this zone.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the zone.",
+			},
+			"addresses": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of addresses in the zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of address.",
+						},
+						"value": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address, IP range, subnet or VPC CRN, depending on the type of the address.",
+						},
+						"ref": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "A service reference value, only for addresses of type serviceRef.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The id of the account owning the service.",
+									},
+									"service_type": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The service type.",
+									},
+									"service_name": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The service name.",
+									},
+									"service_instance": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The service instance.",
+									},
+									"location": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The location.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"excluded": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of excluded addresses in the zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of address.",
+						},
+						"value": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address, IP range or subnet.",
+						},
+					},
+				},
+			},
+			"crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone CRN.",
+			},
+			"address_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of addresses in the zone.",
+			},
+			"excluded_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of excluded addresses in the zone.",
+			},
+			"href": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The href link to the resource.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the resource was created.",
+			},
+			"created_by_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAM ID of the user or service which created the resource.",
+			},
+			"last_modified_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last time the resource was modified.",
+			},
+			"last_modified_by_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAM ID of the user or service which modified the resource.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMCbrZoneRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zone, response, err := cbrClient.GetZone(context, d.Get("zone_id").(string))
+	if err != nil {
+		log.Printf("[DEBUG] GetZone failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetZone failed %s\n%s", err, response))
+	}
+
+	d.SetId(*zone.ID)
+	if err = setCbrZoneAttributes(d, zone); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
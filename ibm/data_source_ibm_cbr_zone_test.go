@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCbrZoneDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-cbr-zone-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCbrZoneDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_cbr_zone.zone", "name", "ibm_cbr_zone.zone", "name"),
+					resource.TestCheckResourceAttrPair("data.ibm_cbr_zone.zone", "crn", "ibm_cbr_zone.zone", "crn"),
+					resource.TestCheckResourceAttr("data.ibm_cbr_zone.zone", "addresses.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCbrZoneDataSourceConfig(name string) string {
+	return testAccCheckIBMCbrZoneConfig(name, "169.23.56.0/24") + `
+		data "ibm_cbr_zone" "zone" {
+			zone_id = ibm_cbr_zone.zone.id
+		}
+	`
+}
@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	openshiftConsoleRoutePrefix = "console-openshift-console."
+	openshiftOAuthRoutePrefix   = "oauth-openshift."
+)
+
+func dataSourceIBMContainerOpenshiftEndpoints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMContainerOpenshiftEndpointsRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Description: "Name or id of the OpenShift cluster",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"resource_group_id": {
+				Description: "ID of the resource group.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"openshift_version": {
+				Description: "OpenShift version of the cluster master",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"entitlement": {
+				Description: "The OpenShift entitlement of the cluster",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"master_url": {
+				Description: "URL of the OpenShift API server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ingress_domain": {
+				Description: "The Ingress subdomain of the cluster that the OpenShift routes are exposed on",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"ingress_secret": {
+				Description: "The name of the TLS secret of the Ingress subdomain",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"console_url": {
+				Description: "URL of the OpenShift web console",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"oauth_domain": {
+				Description: "Domain of the OpenShift OAuth server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"oauth_url": {
+				Description: "URL of the OpenShift OAuth server",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceIBMContainerOpenshiftEndpointsRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	cluster := d.Get("cluster").(string)
+	cls, err := csClient.Clusters().GetCluster(cluster, targetEnv)
+	if err != nil {
+		return fmt.Errorf("Error retrieving cluster %s: %s", cluster, err)
+	}
+	if !strings.HasSuffix(cls.MasterKubeVersion, _OPENSHIFT) {
+		return fmt.Errorf("Cluster %s is not an OpenShift cluster, its version is %s", cluster, cls.MasterKubeVersion)
+	}
+	if cls.Ingress.HostName == "" {
+		return fmt.Errorf("The Ingress subdomain of cluster %s is not yet available, the OpenShift routes are exposed once it is assigned", cluster)
+	}
+
+	d.SetId(cls.ID)
+	d.Set("resource_group_id", cls.ResourceGroupID)
+	d.Set("openshift_version", strings.Split(cls.MasterKubeVersion, "_")[0]+_OPENSHIFT)
+	d.Set("entitlement", cls.Entitlement)
+	d.Set("master_url", cls.MasterURL)
+	d.Set("ingress_domain", cls.Ingress.HostName)
+	d.Set("ingress_secret", cls.Ingress.SecretName)
+	d.Set("console_url", "https://"+openshiftConsoleRoutePrefix+cls.Ingress.HostName)
+	d.Set("oauth_domain", openshiftOAuthRoutePrefix+cls.Ingress.HostName)
+	d.Set("oauth_url", "https://"+openshiftOAuthRoutePrefix+cls.Ingress.HostName)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerOpenshiftEndpointsDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckContainerCluster(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerOpenshiftEndpointsDataSource(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.ibm_container_openshift_endpoints.endpoints", "ingress_domain"),
+					resource.TestMatchResourceAttr(
+						"data.ibm_container_openshift_endpoints.endpoints", "console_url", regexp.MustCompile("^https://console-openshift-console\\.")),
+					resource.TestMatchResourceAttr(
+						"data.ibm_container_openshift_endpoints.endpoints", "oauth_url", regexp.MustCompile("^https://oauth-openshift\\.")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerOpenshiftEndpointsDataSource() string {
+	return fmt.Sprintf(`
+data "ibm_container_openshift_endpoints" "endpoints" {
+  cluster = "%s"
+}`, containerClusterName)
+}
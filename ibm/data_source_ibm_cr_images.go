@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func dataIBMContainerRegistryImages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataIBMContainerRegistryImagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only images that are in the namespace.",
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only images that are in the repository, for example `us.icr.io/birds/woodpecker`.",
+			},
+			"include_ibm": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Includes IBM-provided public images in the list of images.",
+			},
+			"include_vulnerabilities": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Includes the Vulnerability Advisor status of each image.",
+			},
+			"images": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Container Registry images",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the image.",
+						},
+						"repo_tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The fully qualified tags of the image.",
+						},
+						"repo_digests": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The fully qualified digest references of the image.",
+						},
+						"digest_tags": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Map of digest to the comma separated tags of that digest.",
+						},
+						"manifest_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the image manifest.",
+						},
+						"created": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The date the image was created, as a Unix timestamp.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the image in bytes.",
+						},
+						"vulnerable": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Vulnerability Advisor status of the image, for example `OK`, `FAIL` or `UNSCANNED`.",
+						},
+						"vulnerability_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of vulnerabilities found in the image.",
+						},
+						"configuration_issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of configuration issues found in the image.",
+						},
+						"issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of issues found in the image.",
+						},
+						"exempt_issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of exempted issues found in the image.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataIBMContainerRegistryImagesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listImagesOptions := &containerregistryv1.ListImagesOptions{}
+	if namespace, ok := d.GetOk("namespace"); ok {
+		listImagesOptions.SetNamespace(namespace.(string))
+	}
+	if repository, ok := d.GetOk("repository"); ok {
+		listImagesOptions.SetRepository(repository.(string))
+	}
+	listImagesOptions.SetIncludeIBM(d.Get("include_ibm").(bool))
+	listImagesOptions.SetVulnerabilities(d.Get("include_vulnerabilities").(bool))
+
+	imageList, response, err := containerRegistryClient.ListImagesWithContext(context, listImagesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListImagesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	images := make([]map[string]interface{}, 0, len(imageList))
+	for _, image := range imageList {
+		images = append(images, flattenContainerRegistryImage(image))
+	}
+	if err = d.Set("images", images); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting images: %s", err))
+	}
+	d.SetId(time.Now().UTC().String())
+	return nil
+}
+
+func flattenContainerRegistryImage(image containerregistryv1.RemoteAPIImage) map[string]interface{} {
+	digestTags := make(map[string]interface{}, len(image.DigestTags))
+	for digest, tags := range image.DigestTags {
+		digestTags[digest] = strings.Join(tags, ",")
+	}
+	return map[string]interface{}{
+		"id":                        image.ID,
+		"repo_tags":                 image.RepoTags,
+		"repo_digests":              image.RepoDigests,
+		"digest_tags":               digestTags,
+		"manifest_type":             image.ManifestType,
+		"created":                   intValue(image.Created),
+		"size":                      intValue(image.Size),
+		"vulnerable":                image.Vulnerable,
+		"vulnerability_count":       intValue(image.VulnerabilityCount),
+		"configuration_issue_count": intValue(image.ConfigurationIssueCount),
+		"issue_count":               intValue(image.IssueCount),
+		"exempt_issue_count":        intValue(image.ExemptIssueCount),
+	}
+}
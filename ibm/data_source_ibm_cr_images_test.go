@@ -0,0 +1,37 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCrImagesDataSourceBasic(t *testing.T) {
+	namespaceName := fmt.Sprintf("terraform-tf-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCrImagesDataSourceConfig(namespaceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cr_images.images", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cr_images.images", "images.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrImagesDataSourceConfig(namespaceName string) string {
+	return testAccCheckIBMCrNamespaceConfigBasic(namespaceName) + fmt.Sprintf(`
+	data "ibm_cr_images" "images" {
+		namespace = ibm_cr_namespace.cr_namespace.name
+	}
+`)
+}
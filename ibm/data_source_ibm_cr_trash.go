@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func dataIBMContainerRegistryTrash() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataIBMContainerRegistryTrashRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only deleted images that are in the namespace.",
+			},
+			"images": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Images that are in the trash can",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"digest": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The fully qualified digest reference of the deleted image.",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The tags that were associated with the image when it was deleted.",
+						},
+						"days_until_expiry": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of days before the image is permanently removed from the trash can.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataIBMContainerRegistryTrashRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listDeletedImagesOptions := &containerregistryv1.ListDeletedImagesOptions{}
+	if namespace, ok := d.GetOk("namespace"); ok {
+		listDeletedImagesOptions.SetNamespace(namespace.(string))
+	}
+
+	trash, response, err := containerRegistryClient.ListDeletedImagesWithContext(context, listDeletedImagesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListDeletedImagesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	digests := make([]string, 0, len(trash))
+	for digest := range trash {
+		digests = append(digests, digest)
+	}
+	sort.Strings(digests)
+
+	images := make([]map[string]interface{}, 0, len(digests))
+	for _, digest := range digests {
+		images = append(images, map[string]interface{}{
+			"digest":            digest,
+			"tags":              trash[digest].Tags,
+			"days_until_expiry": intValue(trash[digest].DaysUntilExpiry),
+		})
+	}
+	if err = d.Set("images", images); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting images: %s", err))
+	}
+	d.SetId(time.Now().UTC().String())
+	return nil
+}
@@ -112,6 +112,25 @@ func dataSourceIBMIAMAccountSettings() *schema.Resource {
 				Computed:    true,
 				Description: "Defines the max allowed sessions per identity required by the account. Value values: * Any whole number greater than '0'   * NOT_SET - To unset account setting and use service default.",
 			},
+			"user_mfa": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of users with an MFA trait that overrides the MFA trait of the account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IAM ID of the user.",
+						},
+						"mfa": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Defines the MFA trait of the user.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -122,17 +141,12 @@ func dataSourceIbmIamAccountSettingsRead(context context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	getAccountSettingsOptions := &iamidentityv1.GetAccountSettingsOptions{}
-	getAccountSettingsOptions.SetIncludeHistory(d.Get("include_history").(bool))
-
 	userDetails, err := meta.(ClientSession).BluemixUserDetails()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	getAccountSettingsOptions.SetAccountID(userDetails.userAccount)
-
-	accountSettingsResponse, response, err := iamIdentityClient.GetAccountSettings(getAccountSettingsOptions)
+	accountSettingsResponse, userMFA, response, err := getIamAccountSettings(iamIdentityClient, userDetails.userAccount, d.Get("include_history").(bool))
 	if err != nil {
 		log.Printf("[DEBUG] GetAccountSettings failed %s\n%s", err, response)
 		return diag.FromErr(err)
@@ -175,6 +189,10 @@ func dataSourceIbmIamAccountSettingsRead(context context.Context, d *schema.Reso
 		return diag.FromErr(fmt.Errorf("Error setting max_sessions_per_identity: %s", err))
 	}
 
+	if err = d.Set("user_mfa", flattenIamAccountSettingsUserMFA(userMFA)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting user_mfa: %s", err))
+	}
+
 	return nil
 }
 
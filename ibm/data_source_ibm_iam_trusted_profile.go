@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMIAMTrustedProfile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIAMTrustedProfileRead,
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Description:  "ID of the trusted profile",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"profile_id", "name"},
+			},
+
+			"name": {
+				Description:  "Name of the trusted profile",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"profile_id", "name"},
+			},
+
+			"description": {
+				Description: "Description of the trusted profile",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"account_id": {
+				Description: "ID of the account that the trusted profile belongs to",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"crn": {
+				Description: "CRN of the trusted profile",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"iam_id": {
+				Description: "The IAM ID of the trusted profile",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"entity_tag": {
+				Description: "Version of the trusted profile",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"created_at": {
+				Description: "Timestamp of creation of the trusted profile",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"modified_at": {
+				Description: "Timestamp of the last modification of the trusted profile",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMTrustedProfileRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var profile *iamTrustedProfile
+	if v, ok := d.GetOk("profile_id"); ok {
+		p, resp, err := getIAMTrustedProfile(context, iamIdentityClient, v.(string))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving trusted profile: %s\n%s", err, resp))
+		}
+		profile = p
+	} else {
+		userDetails, err := meta.(ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		profiles, err := listIAMTrustedProfiles(context, iamIdentityClient, userDetails.userAccount)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		name := d.Get("name").(string)
+		for i := range profiles {
+			if profiles[i].Name != nil && *profiles[i].Name == name {
+				profile = &profiles[i]
+				break
+			}
+		}
+		if profile == nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] No trusted profile found with name [%s]", name))
+		}
+	}
+
+	d.SetId(*profile.ID)
+	d.Set("profile_id", profile.ID)
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("account_id", profile.AccountID)
+	d.Set("crn", profile.CRN)
+	d.Set("iam_id", profile.IamID)
+	d.Set("entity_tag", profile.EntityTag)
+	d.Set("created_at", profile.CreatedAt)
+	d.Set("modified_at", profile.ModifiedAt)
+
+	return nil
+}
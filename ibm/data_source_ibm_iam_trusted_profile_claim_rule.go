@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMIAMTrustedProfileClaimRule() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIAMTrustedProfileClaimRuleRead,
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Description: "ID of the trusted profile",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"rule_id": {
+				Description: "ID of the claim rule",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"type": {
+				Description: "Type of the claim rule",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"name": {
+				Description: "Name of the claim rule",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"realm_name": {
+				Description: "The realm name of the identity provider that is authorized to apply the trusted profile",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"cr_type": {
+				Description: "The compute resource type the rule applies to",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"expiration": {
+				Description: "Session expiration in seconds",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+
+			"conditions": {
+				Description: "Conditions of the claim rule",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"claim": {
+							Description: "The claim to evaluate against",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"operator": {
+							Description: "The operation to perform on the claim",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"value": {
+							Description: "The stringified JSON value that the claim is compared to using the operator",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"entity_tag": {
+				Description: "Version of the claim rule",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"created_at": {
+				Description: "Timestamp of creation of the claim rule",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"modified_at": {
+				Description: "Timestamp of the last modification of the claim rule",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMTrustedProfileClaimRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profileID := d.Get("profile_id").(string)
+	ruleID := d.Get("rule_id").(string)
+	rule, resp, err := getIAMTrustedProfileClaimRule(context, iamIdentityClient, profileID, ruleID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving trusted profile claim rule: %s\n%s", err, resp))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", profileID, ruleID))
+	d.Set("type", rule.Type)
+	d.Set("name", rule.Name)
+	d.Set("realm_name", rule.RealmName)
+	d.Set("cr_type", rule.CrType)
+	d.Set("expiration", rule.Expiration)
+	d.Set("conditions", flattenIAMTrustedProfileClaimRuleConditions(rule.Conditions))
+	d.Set("entity_tag", rule.EntityTag)
+	d.Set("created_at", rule.CreatedAt)
+	d.Set("modified_at", rule.ModifiedAt)
+
+	return nil
+}
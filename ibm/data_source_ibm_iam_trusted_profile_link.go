@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMIAMTrustedProfileLink() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIAMTrustedProfileLinkRead,
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Description: "ID of the trusted profile",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"link_id": {
+				Description: "ID of the link",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"cr_type": {
+				Description: "The compute resource type",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"name": {
+				Description: "Name of the link",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"link": {
+				Description: "The compute resource that is linked to the trusted profile",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Description: "The CRN of the compute resource",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"namespace": {
+							Description: "The Kubernetes namespace of the service account",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the Kubernetes service account",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+
+			"entity_tag": {
+				Description: "Version of the link",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"created_at": {
+				Description: "Timestamp of creation of the link",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+
+			"modified_at": {
+				Description: "Timestamp of the last modification of the link",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMTrustedProfileLinkRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profileID := d.Get("profile_id").(string)
+	linkID := d.Get("link_id").(string)
+	link, resp, err := getIAMTrustedProfileLink(context, iamIdentityClient, profileID, linkID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving trusted profile link: %s\n%s", err, resp))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", profileID, linkID))
+	d.Set("cr_type", link.CrType)
+	d.Set("name", link.Name)
+	d.Set("link", flattenIAMTrustedProfileLinkTarget(link.Link))
+	d.Set("entity_tag", link.EntityTag)
+	d.Set("created_at", link.CreatedAt)
+	d.Set("modified_at", link.ModifiedAt)
+
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+)
+
+// Data source to find all the policies for a trusted profile
+func dataSourceIBMIAMTrustedProfilePolicy() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMIAMTrustedProfilePolicyRead,
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"profile_id", "iam_id"},
+				Description:  "UUID of Trusted Profile",
+			},
+			"iam_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"profile_id", "iam_id"},
+				Description:  "IAM ID of Trusted Profile",
+			},
+			"sort": {
+				Description: "Sort query for policies",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Role names of the policy definition",
+						},
+						"resources": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Service name of the policy definition",
+									},
+									"resource_instance_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of resource instance of the policy definition",
+									},
+									"region": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Region of the policy definition",
+									},
+									"resource_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Resource type of the policy definition",
+									},
+									"resource": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Resource of the policy definition",
+									},
+									"resource_group_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "ID of the resource group.",
+									},
+								},
+							},
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Description of the Policy",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	iamID, err := getTrustedProfileIamID(d, meta)
+	if err != nil {
+		return err
+	}
+
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	listPoliciesOptions := &iampolicymanagementv1.ListPoliciesOptions{
+		AccountID: core.StringPtr(userDetails.userAccount),
+		IamID:     core.StringPtr(iamID),
+		Type:      core.StringPtr("access"),
+	}
+
+	if v, ok := d.GetOk("sort"); ok {
+		listPoliciesOptions.Sort = core.StringPtr(v.(string))
+	}
+
+	policyList, resp, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing trusted profile policies: %s\n%s", err, resp)
+	}
+
+	subject := d.Get("profile_id").(string)
+	if v, ok := d.GetOk("iam_id"); ok && v != nil {
+		subject = v.(string)
+	}
+
+	profilePolicies := make([]map[string]interface{}, 0, len(policyList.Policies))
+	for _, policy := range policyList.Policies {
+		roles := make([]string, len(policy.Roles))
+		for i, role := range policy.Roles {
+			roles[i] = *role.DisplayName
+		}
+		p := map[string]interface{}{
+			"id":        fmt.Sprintf("%s/%s", subject, *policy.ID),
+			"roles":     roles,
+			"resources": flattenPolicyResource(policy.Resources),
+		}
+		if policy.Description != nil {
+			p["description"] = policy.Description
+		}
+		profilePolicies = append(profilePolicies, p)
+	}
+
+	d.SetId(subject)
+	d.Set("policies", profilePolicies)
+	return nil
+}
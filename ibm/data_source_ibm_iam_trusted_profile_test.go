@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMIAMTrustedProfileDataSource_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfileDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ibm_iam_trusted_profile.by_name", "profile_id", "ibm_iam_trusted_profile.profile", "id"),
+					resource.TestCheckResourceAttrPair("data.ibm_iam_trusted_profile.by_id", "iam_id", "ibm_iam_trusted_profile.profile", "iam_id"),
+					resource.TestCheckResourceAttr("data.ibm_iam_trusted_profile_claim_rule.rule", "conditions.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_iam_trusted_profile_policy.policies", "policies.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfileDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "profile" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile_claim_rule" "rule" {
+			profile_id = ibm_iam_trusted_profile.profile.id
+			type       = "Profile-CR"
+			cr_type    = "VSI"
+			conditions {
+				claim    = "crn"
+				operator = "EQUALS"
+				value    = "\"crn:v1:bluemix:public:is:us-south-1:a/123::instance:abc\""
+			}
+		}
+
+		resource "ibm_iam_trusted_profile_policy" "policy" {
+			profile_id = ibm_iam_trusted_profile.profile.id
+			roles      = ["Viewer"]
+		}
+
+		data "ibm_iam_trusted_profile" "by_name" {
+			name = ibm_iam_trusted_profile.profile.name
+		}
+
+		data "ibm_iam_trusted_profile" "by_id" {
+			profile_id = ibm_iam_trusted_profile.profile.id
+		}
+
+		data "ibm_iam_trusted_profile_claim_rule" "rule" {
+			profile_id = ibm_iam_trusted_profile.profile.id
+			rule_id    = ibm_iam_trusted_profile_claim_rule.rule.rule_id
+		}
+
+		data "ibm_iam_trusted_profile_policy" "policies" {
+			profile_id = ibm_iam_trusted_profile_policy.policy.profile_id
+		}
+	`, name)
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMKMSkeyRegistrations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMKMSKeyRegistrationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the root key, the registrations of all the keys of the instance are listed when it is not set",
+			},
+			"resource_crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only the registrations of the cloud resources that match the CRN, a trailing * matches any CRN with the prefix",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"prevent_key_deletion": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True when any of the registrations prevents the deletion of its key",
+			},
+			"registrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The cloud resources that use the keys",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key the resource is registered with",
+						},
+						"resource_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the cloud resource that uses the key",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the registration",
+						},
+						"prevent_key_deletion": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "If true, the key can't be deleted, even with force, while the registration exists",
+						},
+						"key_version_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key version the resource uses",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for the resource that created the registration",
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the registration was created. The date format follows RFC 3339.",
+						},
+						"updated_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for the resource that updated the registration",
+						},
+						"last_update_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the registration was last updated. The date format follows RFC 3339.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMKMSKeyRegistrationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	api, err := meta.(ClientSession).keyManagementAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	endpointType := d.Get("endpoint_type").(string)
+	keyID := d.Get("key_id").(string)
+	resourceCRN := d.Get("resource_crn").(string)
+
+	rContollerApi := rContollerClient.ResourceServiceInstanceV2()
+
+	instanceData, err := rContollerApi.GetInstance(instanceID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting KMS Instance %s", err))
+	}
+	instanceCRN := instanceData.Crn.String()
+
+	var hpcsEndpointURL string
+	crnData := strings.Split(instanceCRN, ":")
+
+	if crnData[4] == "hs-crypto" {
+		hpcsEndpointApi, err := meta.(ClientSession).HpcsEndpointAPI()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		resp, err := hpcsEndpointApi.Endpoint().GetAPIEndpoint(instanceID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if endpointType == "public" {
+			hpcsEndpointURL = "https://" + resp.Kms.Public + "/api/v2/keys"
+		} else {
+			hpcsEndpointURL = "https://" + resp.Kms.Private + "/api/v2/keys"
+		}
+
+		u, err := url.Parse(hpcsEndpointURL)
+		if err != nil {
+			return diag.Errorf("Error Parsing hpcs EndpointURL")
+		}
+		api.URL = u
+	} else if crnData[4] == "kms" {
+		if endpointType == "private" {
+			URL, _ := updatePrivateURL(api.Config.BaseURL)
+			u, err := url.Parse(URL)
+			if err != nil {
+				return diag.Errorf("Error Parsing kms EndpointURL")
+			}
+			api.URL = u
+		}
+	} else {
+		return diag.Errorf("Invalid or unsupported service Instance")
+	}
+
+	api.Config.InstanceID = instanceID
+
+	regs, err := api.ListRegistrations(context, keyID, resourceCRN)
+	if err != nil {
+		return diag.Errorf("Failed to list registrations: %s", err)
+	}
+
+	preventKeyDeletion := false
+	registrations := make([]map[string]interface{}, 0, len(regs.Registrations))
+	for _, r := range regs.Registrations {
+		registration := map[string]interface{}{
+			"key_id":               r.KeyID,
+			"resource_crn":         r.ResourceCrn,
+			"description":          r.Description,
+			"prevent_key_deletion": r.PreventKeyDeletion,
+			"key_version_id":       r.KeyVersion.ID,
+			"created_by":           r.CreatedBy,
+			"updated_by":           r.UpdatedBy,
+		}
+		if r.CreationDate != nil {
+			registration["creation_date"] = r.CreationDate.Format(time.RFC3339)
+		}
+		if r.LastUpdateDate != nil {
+			registration["last_update_date"] = r.LastUpdateDate.Format(time.RFC3339)
+		}
+		preventKeyDeletion = preventKeyDeletion || r.PreventKeyDeletion
+		registrations = append(registrations, registration)
+	}
+
+	if keyID != "" {
+		d.SetId(fmt.Sprintf("%s/%s", instanceID, keyID))
+	} else {
+		d.SetId(instanceID)
+	}
+	d.Set("registrations", registrations)
+	d.Set("prevent_key_deletion", preventKeyDeletion)
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", endpointType)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKmsDataSourceKeyRegistrations_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	cosInstanceName := fmt.Sprintf("cos_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("tf-testacc-kms-reg-%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMKmsDataSourceKeyRegistrationsConfig(instanceName, keyName, cosInstanceName, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_kms_key_registrations.test", "registrations.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_kms_key_registrations.test", "registrations.0.resource_crn", "ibm_cos_bucket.test", "crn"),
+					resource.TestCheckResourceAttrPair("data.ibm_kms_key_registrations.test", "registrations.0.key_id", "ibm_kms_key.test", "key_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsDataSourceKeyRegistrationsConfig(instanceName, keyName, cosInstanceName, bucketName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kp_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_resource_instance" "cos_instance" {
+		name     = "%s"
+		service  = "cloud-object-storage"
+		plan     = "standard"
+		location = "global"
+	}
+	resource "ibm_iam_authorization_policy" "policy" {
+		source_service_name = "cloud-object-storage"
+		target_service_name = "kms"
+		roles               = ["Reader"]
+	}
+	resource "ibm_cos_bucket" "test" {
+		depends_on           = [ibm_iam_authorization_policy.policy]
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.cos_instance.id
+		region_location      = "us-south"
+		storage_class        = "smart"
+		key_protect          = ibm_kms_key.test.id
+	}
+	data "ibm_kms_key_registrations" "test" {
+		instance_id = ibm_kms_key.test.instance_id
+		key_id      = ibm_kms_key.test.key_id
+		depends_on  = [ibm_cos_bucket.test]
+	}
+`, instanceName, keyName, cosInstanceName, bucketName)
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+func dataSourceIBMResourceReclamation() *schema.Resource {
+	reclamationSchema := dataSourceIBMResourceReclamationSchema()
+	delete(reclamationSchema, "id")
+	reclamationSchema["resource_instance_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The ID of the resource instance pending reclamation.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceIBMResourceReclamationRead,
+		Schema:      reclamationSchema,
+	}
+}
+
+func dataSourceIBMResourceReclamationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("resource_instance_id").(string)
+	listReclamationsOptions := &rc.ListReclamationsOptions{
+		ResourceInstanceID: &instanceID,
+	}
+
+	reclamationsList, response, err := rsConClient.ListReclamationsWithContext(context, listReclamationsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListReclamationsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListReclamationsWithContext failed %s\n%s", err, response))
+	}
+
+	var reclamation *rc.Reclamation
+	for i := range reclamationsList.Resources {
+		if reclamationsList.Resources[i].State != nil && *reclamationsList.Resources[i].State == rsReclamationScheduled {
+			reclamation = &reclamationsList.Resources[i]
+			break
+		}
+	}
+	if reclamation == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] No scheduled reclamation found for resource instance %s", instanceID))
+	}
+
+	d.SetId(*reclamation.ID)
+	for k, v := range flattenResourceReclamation(*reclamation) {
+		if k == "id" || k == "resource_instance_id" {
+			continue
+		}
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s: %s", k, err))
+		}
+	}
+
+	return nil
+}
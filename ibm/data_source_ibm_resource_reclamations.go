@@ -0,0 +1,212 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+func dataSourceIBMResourceReclamations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMResourceReclamationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the account the reclamations belong to.",
+			},
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ID of the resource instance to list reclamations for.",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return reclamations in this state, for example SCHEDULED.",
+			},
+			"reclamations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of reclamations.",
+				Elem: &schema.Resource{
+					Schema: dataSourceIBMResourceReclamationSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMResourceReclamationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the reclamation.",
+		},
+		"entity_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the entity for the reclamation.",
+		},
+		"entity_type_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the entity type for the reclamation.",
+		},
+		"entity_crn": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The full Cloud Resource Name (CRN) associated with the entity.",
+		},
+		"resource_instance_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the resource instance.",
+		},
+		"resource_group_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the resource group.",
+		},
+		"account_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "An alpha-numeric value identifying the account ID.",
+		},
+		"policy_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the policy for the reclamation.",
+		},
+		"state": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The state of the reclamation.",
+		},
+		"target_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The target time that the reclamation retention period end.",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date when the reclamation was created.",
+		},
+		"created_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The subject who created the reclamation.",
+		},
+		"updated_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date when the reclamation was last updated.",
+		},
+		"updated_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The subject who updated the reclamation.",
+		},
+	}
+}
+
+func dataSourceIBMResourceReclamationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listReclamationsOptions := &rc.ListReclamationsOptions{}
+	if v, ok := d.GetOk("account_id"); ok {
+		listReclamationsOptions.SetAccountID(v.(string))
+	}
+	if v, ok := d.GetOk("resource_instance_id"); ok {
+		listReclamationsOptions.SetResourceInstanceID(v.(string))
+	}
+
+	reclamationsList, response, err := rsConClient.ListReclamationsWithContext(context, listReclamationsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListReclamationsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListReclamationsWithContext failed %s\n%s", err, response))
+	}
+
+	state := d.Get("state").(string)
+	reclamations := make([]map[string]interface{}, 0, len(reclamationsList.Resources))
+	for _, reclamation := range reclamationsList.Resources {
+		if state != "" && (reclamation.State == nil || *reclamation.State != state) {
+			continue
+		}
+		reclamations = append(reclamations, flattenResourceReclamation(reclamation))
+	}
+
+	d.SetId(dataSourceIBMResourceReclamationsID(d))
+	if err = d.Set("reclamations", reclamations); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting reclamations %s", err))
+	}
+
+	return nil
+}
+
+// dataSourceIBMResourceReclamationsID returns a reasonable ID for the list.
+func dataSourceIBMResourceReclamationsID(d *schema.ResourceData) string {
+	return time.Now().UTC().String()
+}
+
+func flattenResourceReclamation(reclamation rc.Reclamation) map[string]interface{} {
+	m := map[string]interface{}{}
+	if reclamation.ID != nil {
+		m["id"] = *reclamation.ID
+	}
+	if reclamation.EntityID != nil {
+		m["entity_id"] = *reclamation.EntityID
+	}
+	if reclamation.EntityTypeID != nil {
+		m["entity_type_id"] = *reclamation.EntityTypeID
+	}
+	if reclamation.EntityCRN != nil {
+		m["entity_crn"] = *reclamation.EntityCRN
+	}
+	if reclamation.ResourceInstanceID != nil {
+		m["resource_instance_id"] = *reclamation.ResourceInstanceID
+	}
+	if reclamation.ResourceGroupID != nil {
+		m["resource_group_id"] = *reclamation.ResourceGroupID
+	}
+	if reclamation.AccountID != nil {
+		m["account_id"] = *reclamation.AccountID
+	}
+	if reclamation.PolicyID != nil {
+		m["policy_id"] = *reclamation.PolicyID
+	}
+	if reclamation.State != nil {
+		m["state"] = *reclamation.State
+	}
+	if reclamation.TargetTime != nil {
+		m["target_time"] = *reclamation.TargetTime
+	}
+	if reclamation.CreatedAt != nil {
+		m["created_at"] = reclamation.CreatedAt.String()
+	}
+	if reclamation.CreatedBy != nil {
+		m["created_by"] = *reclamation.CreatedBy
+	}
+	if reclamation.UpdatedAt != nil {
+		m["updated_at"] = reclamation.UpdatedAt.String()
+	}
+	if reclamation.UpdatedBy != nil {
+		m["updated_by"] = *reclamation.UpdatedBy
+	}
+	return m
+}
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourceReclamationsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceReclamationsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_resource_reclamations.reclamations", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_resource_reclamations.reclamations", "reclamations.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceReclamationsDataSourceConfig() string {
+	return `
+	data "ibm_resource_reclamations" "reclamations" {
+		state = "SCHEDULED"
+	}
+	`
+}
@@ -32,18 +32,27 @@ func dataSourceIBMResourceTag() *schema.Resource {
 				Optional:    true,
 				Description: "Resource type on which the tags should be fetched",
 			},
+			"tag_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"service", "access", "user"}),
+				Description:  "Type of the tags to fetch. Only allowed values are: user, or service or access (default value : user)",
+			},
 		},
 	}
 }
 
 func dataSourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error {
-	var rID, rType string
+	var rID, rType, tType string
 	rID = d.Get("resource_id").(string)
 	if v, ok := d.GetOk(resourceType); ok && v != nil {
 		rType = v.(string)
 	}
+	if v, ok := d.GetOk(tagType); ok && v != nil {
+		tType = v.(string)
+	}
 
-	tags, err := GetGlobalTagsUsingCRN(meta, rID, rType, "")
+	tags, err := GetGlobalTagsUsingCRN(meta, rID, rType, tType)
 	if err != nil {
 		return fmt.Errorf(
 			"Error on get of resource tags (%s) tags: %s", d.Id(), err)
@@ -52,6 +61,7 @@ func dataSourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) erro
 	d.SetId(rID)
 	d.Set("resource_id", rID)
 	d.Set("resource_type", rType)
+	d.Set("tag_type", tType)
 	d.Set("tags", tags)
 
 	return nil
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/api/globalsearch/globalsearchv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMResourcesSearch() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMResourcesSearchRead,
+
+		Schema: map[string]*schema.Schema{
+			"query": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Lucene-formatted query string, for example `tags:\"env:dev\" AND service_name:cloud-object-storage`",
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources that match the query",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the resource",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the resource",
+						},
+						"service_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the service the resource belongs to",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The resource type segment of the CRN, empty for service instances",
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The user tags attached to the resource",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMResourcesSearchRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	gsClient, err := meta.(ClientSession).GlobalSearchAPI()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error getting global search client settings: %s", err))
+	}
+
+	query := d.Get("query").(string)
+	searchBody := globalsearchv2.SearchBody{
+		Query:  query,
+		Fields: []string{"name", "crn", "service_name", "tags"},
+	}
+
+	items := []map[string]interface{}{}
+	for {
+		result, err := gsClient.Searches().PostQuery(searchBody)
+		if err != nil {
+			log.Printf("[DEBUG] Error searching resources with query %s: %s", query, err)
+			return diag.FromErr(fmt.Errorf("Error searching resources with query %s: %s", query, err))
+		}
+		for _, item := range result.Items {
+			items = append(items, map[string]interface{}{
+				"crn":           item.CRN,
+				"name":          item.Name,
+				"service_name":  item.ServiceName,
+				"resource_type": crnResourceType(item.CRN),
+				"tags":          item.Tags,
+			})
+		}
+		if !result.MoreData || result.Token == "" {
+			break
+		}
+		searchBody.Token = result.Token
+	}
+
+	d.SetId(query)
+	if err = d.Set("items", items); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting items %s", err))
+	}
+
+	return nil
+}
+
+// crnResourceType returns the resource type segment of a CRN, which is empty
+// for service instances.
+func crnResourceType(crn string) string {
+	parts := strings.Split(crn, ":")
+	if len(parts) < 10 {
+		return ""
+	}
+	return parts[8]
+}
@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMResourcesSearchDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-search-cos-%d", acctest.RandIntRange(10, 100))
+	tag := fmt.Sprintf("tfsearch:%d", acctest.RandIntRange(1000, 9999))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMResourcesSearchDataSourceConfig(name, tag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_resources_search.search", "items.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ibm_resources_search.search", "items.0.crn", "ibm_resource_instance.instance", "crn"),
+					resource.TestCheckResourceAttr("data.ibm_resources_search.search", "items.0.name", name),
+					resource.TestCheckResourceAttr("data.ibm_resources_search.search", "items.0.service_name", "cloud-object-storage"),
+				),
+			},
+		},
+	})
+}
+
+func TestCrnResourceType(t *testing.T) {
+	cases := map[string]string{
+		"crn:v1:bluemix:public:cloud-object-storage:global:a/4448261269a14562b839e0a3019ed980:8d7af921-b136-4078-9666-081bd8470d94::":               "",
+		"crn:v1:bluemix:public:is:us-south:a/4448261269a14562b839e0a3019ed980::vpc:r006-4727d842-f94f-4a2d-824a-9bc9b02c523b":                       "vpc",
+		"crn:v1:bluemix:public:cloud-object-storage:global:a/4448261269a14562b839e0a3019ed980:8d7af921-b136-4078-9666-081bd8470d94:bucket:mybucket": "bucket",
+		"not-a-crn": "",
+	}
+	for crn, expected := range cases {
+		if got := crnResourceType(crn); got != expected {
+			t.Errorf("crnResourceType(%q) = %q, expected %q", crn, got, expected)
+		}
+	}
+}
+
+func testAccCheckIBMResourcesSearchDataSourceConfig(name, tag string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_resource_instance" "instance" {
+		name     = "%s"
+		service  = "cloud-object-storage"
+		plan     = "lite"
+		location = "global"
+	}
+
+	resource "ibm_resource_tag" "tag" {
+		resource_id = ibm_resource_instance.instance.crn
+		tags        = ["%s"]
+	}
+
+	data "ibm_resources_search" "search" {
+		query      = "tags:\"%s\""
+		depends_on = [ibm_resource_tag.tag]
+	}
+`, name, tag, tag)
+}
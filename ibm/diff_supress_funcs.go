@@ -39,3 +39,21 @@ func suppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
 	}
 	return reflect.DeepEqual(oldm, newm)
 }
+
+// suppressEquivalentJSONStructure suppresses the diff of two JSON documents that decode to the same value, unlike
+// suppressEquivalentJSON the documents aren't expected to be lists of key/value pairs
+func suppressEquivalentJSONStructure(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" {
+		return false
+	}
+	var oldObj, newObj interface{}
+	if err := json.Unmarshal([]byte(old), &oldObj); err != nil {
+		log.Printf("Error unmarshalling old json :: %s", err.Error())
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newObj); err != nil {
+		log.Printf("Error unmarshalling new json :: %s", err.Error())
+		return false
+	}
+	return reflect.DeepEqual(oldObj, newObj)
+}
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"testing"
+)
+
+func TestSuppressEquivalentJSON(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{`[{"key":"a","value":1},{"key":"b","value":2}]`, `[{"key":"b","value":2},{"key":"a","value":1}]`, true},
+		{`[{"key":"a","value":1}]`, `[{"key":"a","value":2}]`, false},
+		{`{"name":"cpu"}`, `{"name":"cpu"}`, false},
+		{"", `[{"key":"a","value":1}]`, false},
+	}
+	for _, c := range cases {
+		if suppress := suppressEquivalentJSON("json", c.old, c.new, nil); suppress != c.suppress {
+			t.Errorf("suppressEquivalentJSON(%s, %s) = %t, expected %t", c.old, c.new, suppress, c.suppress)
+		}
+	}
+}
+
+func TestSuppressEquivalentJSONStructure(t *testing.T) {
+	cases := []struct {
+		old, new string
+		suppress bool
+	}{
+		{`{"name":"cpu","scope":{"team":1}}`, `{ "scope": {"team": 1}, "name": "cpu" }`, true},
+		{`{"name":"cpu"}`, `{"name":"memory"}`, false},
+		{`{"name":"cpu"}`, `[{"key":"name","value":"cpu"}]`, false},
+		{`[1,2]`, `[2,1]`, false},
+		{"", `{"name":"cpu"}`, false},
+	}
+	for _, c := range cases {
+		if suppress := suppressEquivalentJSONStructure("json", c.old, c.new, nil); suppress != c.suppress {
+			t.Errorf("suppressEquivalentJSONStructure(%s, %s) = %t, expected %t", c.old, c.new, suppress, c.suppress)
+		}
+	}
+}
+
+func TestValidateJSONString(t *testing.T) {
+	cases := map[string]bool{
+		`[{"key":"a","value":1}]`:    true,
+		`[{"key":"a"}]`:              false,
+		`{"name":"cpu","enabled":1}`: false,
+		`{"name":`:                   false,
+	}
+	for value, valid := range cases {
+		_, errs := validateJSONString()(value, "json")
+		if (len(errs) == 0) != valid {
+			t.Errorf("validateJSONString(%s) returned %v", value, errs)
+		}
+	}
+}
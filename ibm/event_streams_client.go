@@ -0,0 +1,194 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+)
+
+// eventStreamsAdminV1 calls the administration REST API and the Confluent compatible schema registry API of an
+// Event Streams instance, the Kafka admin API doesn't manage quotas, mirroring or schemas
+type eventStreamsAdminV1 struct {
+	Service *core.BaseService
+}
+
+const (
+	eventStreamsSchemaRegistryPath      = "/confluent"
+	eventStreamsSchemaContentType       = "application/vnd.schemaregistry.v1+json"
+	eventStreamsMirroringSelectionPath  = "/admin/mirroring/topic-selection"
+	eventStreamsMirroringActiveTopics   = "/admin/mirroring/active-topics"
+	eventStreamsQuotaPathFmt            = "/admin/quotas/%s"
+	eventStreamsSchemaVersionsPathFmt   = eventStreamsSchemaRegistryPath + "/subjects/%s/versions"
+	eventStreamsSchemaLatestPathFmt     = eventStreamsSchemaRegistryPath + "/subjects/%s/versions/latest"
+	eventStreamsSchemaSubjectPathFmt    = eventStreamsSchemaRegistryPath + "/subjects/%s"
+	eventStreamsSchemaCompatibilityPath = eventStreamsSchemaRegistryPath + "/config/%s"
+)
+
+type eventStreamsQuota struct {
+	ProducerByteRate *int64 `json:"producer_byte_rate,omitempty"`
+	ConsumerByteRate *int64 `json:"consumer_byte_rate,omitempty"`
+}
+
+type eventStreamsMirroringTopicSelection struct {
+	Includes []string `json:"includes"`
+}
+
+type eventStreamsMirroringActiveTopicList struct {
+	ActiveTopics []string `json:"active_topics"`
+}
+
+type eventStreamsSchema struct {
+	Subject string `json:"subject,omitempty"`
+	Version int    `json:"version,omitempty"`
+	ID      int    `json:"id,omitempty"`
+	Schema  string `json:"schema,omitempty"`
+}
+
+type eventStreamsSchemaCompatibility struct {
+	Compatibility      string `json:"compatibility,omitempty"`
+	CompatibilityLevel string `json:"compatibilityLevel,omitempty"`
+}
+
+func newEventStreamsAdminV1(serviceURL string, authenticator core.Authenticator) (*eventStreamsAdminV1, error) {
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           strings.TrimSuffix(serviceURL, "/"),
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &eventStreamsAdminV1{Service: service}, nil
+}
+
+// eventStreamsAdminAPI returns the client of the REST APIs of the Event Streams instance, it authenticates with
+// the API key of the provider like the Kafka admin client does
+func eventStreamsAdminAPI(meta interface{}, instanceCRN string) (*eventStreamsAdminV1, error) {
+	bxSession, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	apiKey := bxSession.Config.BluemixAPIKey
+	if len(apiKey) == 0 {
+		return nil, fmt.Errorf("failed to get IBM cloud API key")
+	}
+	rsConClient, err := meta.(ClientSession).ResourceControllerAPI()
+	if err != nil {
+		return nil, err
+	}
+	instance, err := rsConClient.ResourceServiceInstance().GetInstance(instanceCRN)
+	if err != nil {
+		return nil, err
+	}
+	adminURL, ok := instance.Extensions["kafka_http_url"].(string)
+	if !ok || adminURL == "" {
+		return nil, fmt.Errorf("instance %s has no kafka_http_url", instance.ID)
+	}
+	authenticator, err := core.NewBasicAuthenticator("token", apiKey)
+	if err != nil {
+		return nil, err
+	}
+	client, err := newEventStreamsAdminV1(adminURL, authenticator)
+	if err != nil {
+		return nil, err
+	}
+	client.Service.SetDefaultHeaders(gohttp.Header{
+		"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+	})
+	return client, nil
+}
+
+func (es *eventStreamsAdminV1) request(ctx context.Context, method, path, contentType string, body, result interface{}) (*core.DetailedResponse, error) {
+	// The schema registry uses its own media type, which sendCoreRequest decodes as JSON
+	return sendCoreRequest(ctx, es.Service, coreRequest{Method: method, Path: path, Accept: contentType, ContentType: contentType, Body: body}, result)
+}
+
+func (es *eventStreamsAdminV1) CreateQuota(ctx context.Context, entity string, quota eventStreamsQuota) (*core.DetailedResponse, error) {
+	return es.request(ctx, core.POST, fmt.Sprintf(eventStreamsQuotaPathFmt, url.PathEscape(entity)), core.APPLICATION_JSON, quota, nil)
+}
+
+func (es *eventStreamsAdminV1) UpdateQuota(ctx context.Context, entity string, quota eventStreamsQuota) (*core.DetailedResponse, error) {
+	return es.request(ctx, core.PATCH, fmt.Sprintf(eventStreamsQuotaPathFmt, url.PathEscape(entity)), core.APPLICATION_JSON, quota, nil)
+}
+
+func (es *eventStreamsAdminV1) GetQuota(ctx context.Context, entity string) (*eventStreamsQuota, *core.DetailedResponse, error) {
+	quota := &eventStreamsQuota{}
+	response, err := es.request(ctx, core.GET, fmt.Sprintf(eventStreamsQuotaPathFmt, url.PathEscape(entity)), core.APPLICATION_JSON, nil, quota)
+	if err != nil {
+		return nil, response, err
+	}
+	return quota, response, nil
+}
+
+func (es *eventStreamsAdminV1) DeleteQuota(ctx context.Context, entity string) (*core.DetailedResponse, error) {
+	return es.request(ctx, core.DELETE, fmt.Sprintf(eventStreamsQuotaPathFmt, url.PathEscape(entity)), core.APPLICATION_JSON, nil, nil)
+}
+
+func (es *eventStreamsAdminV1) GetMirroringTopicSelection(ctx context.Context) (*eventStreamsMirroringTopicSelection, *core.DetailedResponse, error) {
+	selection := &eventStreamsMirroringTopicSelection{}
+	response, err := es.request(ctx, core.GET, eventStreamsMirroringSelectionPath, core.APPLICATION_JSON, nil, selection)
+	if err != nil {
+		return nil, response, err
+	}
+	return selection, response, nil
+}
+
+// ReplaceMirroringTopicSelection replaces the patterns of the topics mirrored from the source instance
+func (es *eventStreamsAdminV1) ReplaceMirroringTopicSelection(ctx context.Context, includes []string) (*core.DetailedResponse, error) {
+	return es.request(ctx, core.POST, eventStreamsMirroringSelectionPath, core.APPLICATION_JSON, eventStreamsMirroringTopicSelection{Includes: includes}, nil)
+}
+
+func (es *eventStreamsAdminV1) GetMirroringActiveTopics(ctx context.Context) ([]string, *core.DetailedResponse, error) {
+	topics := &eventStreamsMirroringActiveTopicList{}
+	response, err := es.request(ctx, core.GET, eventStreamsMirroringActiveTopics, core.APPLICATION_JSON, nil, topics)
+	if err != nil {
+		return nil, response, err
+	}
+	return topics.ActiveTopics, response, nil
+}
+
+// RegisterSchema registers the schema as the latest version of the subject, the version is unchanged when the
+// schema is already registered
+func (es *eventStreamsAdminV1) RegisterSchema(ctx context.Context, subject, schema string) (int, *core.DetailedResponse, error) {
+	registered := &eventStreamsSchema{}
+	response, err := es.request(ctx, core.POST, fmt.Sprintf(eventStreamsSchemaVersionsPathFmt, url.PathEscape(subject)), eventStreamsSchemaContentType, eventStreamsSchema{Schema: schema}, registered)
+	if err != nil {
+		return 0, response, err
+	}
+	return registered.ID, response, nil
+}
+
+func (es *eventStreamsAdminV1) GetLatestSchema(ctx context.Context, subject string) (*eventStreamsSchema, *core.DetailedResponse, error) {
+	schema := &eventStreamsSchema{}
+	response, err := es.request(ctx, core.GET, fmt.Sprintf(eventStreamsSchemaLatestPathFmt, url.PathEscape(subject)), eventStreamsSchemaContentType, nil, schema)
+	if err != nil {
+		return nil, response, err
+	}
+	return schema, response, nil
+}
+
+// DeleteSchemaSubject deletes all the versions of the subject
+func (es *eventStreamsAdminV1) DeleteSchemaSubject(ctx context.Context, subject string) (*core.DetailedResponse, error) {
+	return es.request(ctx, core.DELETE, fmt.Sprintf(eventStreamsSchemaSubjectPathFmt, url.PathEscape(subject)), eventStreamsSchemaContentType, nil, nil)
+}
+
+func (es *eventStreamsAdminV1) SetSchemaCompatibility(ctx context.Context, subject, compatibility string) (*core.DetailedResponse, error) {
+	return es.request(ctx, core.PUT, fmt.Sprintf(eventStreamsSchemaCompatibilityPath, url.PathEscape(subject)), eventStreamsSchemaContentType, eventStreamsSchemaCompatibility{Compatibility: compatibility}, nil)
+}
+
+func (es *eventStreamsAdminV1) GetSchemaCompatibility(ctx context.Context, subject string) (string, *core.DetailedResponse, error) {
+	compatibility := &eventStreamsSchemaCompatibility{}
+	response, err := es.request(ctx, core.GET, fmt.Sprintf(eventStreamsSchemaCompatibilityPath, url.PathEscape(subject)), eventStreamsSchemaContentType, nil, compatibility)
+	if err != nil {
+		return "", response, err
+	}
+	return compatibility.CompatibilityLevel, response, nil
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestEventStreamsRegisterSchema(t *testing.T) {
+	var method, path, contentType string
+	body := &eventStreamsSchema{}
+	service := testCoreService(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path, contentType = r.Method, r.URL.EscapedPath(), r.Header.Get("Content-Type")
+		json.NewDecoder(r.Body).Decode(body)
+		w.Header().Set("Content-Type", eventStreamsSchemaContentType)
+		w.Write([]byte(`{"id":7}`))
+	})
+	client := &eventStreamsAdminV1{Service: service}
+
+	id, _, err := client.RegisterSchema(context.Background(), "orders-value", `{"type":"string"}`)
+	if err != nil {
+		t.Fatalf("RegisterSchema failed: %s", err)
+	}
+	if method != http.MethodPost || path != "/confluent/subjects/orders-value/versions" || contentType != eventStreamsSchemaContentType {
+		t.Errorf("unexpected request %s %s with content type %q", method, path, contentType)
+	}
+	if body.Schema != `{"type":"string"}` || id != 7 {
+		t.Errorf("unexpected body %+v or ID %d", body, id)
+	}
+}
+
+func TestEventStreamsQuota(t *testing.T) {
+	var method, path string
+	body := map[string]interface{}{}
+	service := testCoreService(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+		switch r.Method {
+		case http.MethodPatch:
+			json.NewDecoder(r.Body).Decode(&body)
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error_code":404,"message":"Not Found"}`))
+		}
+	})
+	client := &eventStreamsAdminV1{Service: service}
+
+	rate := int64(1024)
+	if _, err := client.UpdateQuota(context.Background(), "iam-ServiceId-1234", eventStreamsQuota{ConsumerByteRate: &rate}); err != nil {
+		t.Fatalf("UpdateQuota failed: %s", err)
+	}
+	if method != http.MethodPatch || path != "/admin/quotas/iam-ServiceId-1234" {
+		t.Errorf("unexpected request %s %s", method, path)
+	}
+	if _, ok := body["producer_byte_rate"]; ok || body["consumer_byte_rate"] != float64(1024) {
+		t.Errorf("unexpected body %v", body)
+	}
+
+	_, response, err := client.GetQuota(context.Background(), "default")
+	if err == nil || response == nil || response.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a not found response, got %v", err)
+	}
+}
@@ -0,0 +1,582 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Rule conditions and patterns only exist in the v2 policies, which the iampolicymanagementv1 package
+// doesn't model, as well as the policy templates.
+
+const (
+	iamPoliciesV1Path      = "/v1/policies"
+	iamPoliciesV2Path      = "/v2/policies"
+	iamPolicyTemplatesPath = "/v1/policy_templates"
+)
+
+var iamPolicyRuleConditionOperators = []string{
+	"stringEquals", "stringExists", "stringMatch", "stringEqualsAnyOf", "stringMatchAnyOf", "ipMatch",
+	"timeLessThan", "timeLessThanOrEquals", "timeGreaterThan", "timeGreaterThanOrEquals",
+	"dateTimeLessThan", "dateTimeLessThanOrEquals", "dateTimeGreaterThan", "dateTimeGreaterThanOrEquals",
+	"dayOfWeekEquals", "dayOfWeekAnyOf",
+}
+
+type iamPolicyV2Attribute struct {
+	Key      *string     `json:"key"`
+	Operator *string     `json:"operator"`
+	Value    interface{} `json:"value"`
+}
+
+type iamPolicyV2Subject struct {
+	Attributes []iamPolicyV2Attribute `json:"attributes"`
+}
+
+type iamPolicyV2Resource struct {
+	Attributes []iamPolicyV2Attribute `json:"attributes"`
+	Tags       []iamPolicyV2Attribute `json:"tags,omitempty"`
+}
+
+type iamPolicyV2Role struct {
+	RoleID      *string `json:"role_id"`
+	DisplayName *string `json:"display_name,omitempty"`
+}
+
+type iamPolicyV2Grant struct {
+	Roles []iamPolicyV2Role `json:"roles"`
+}
+
+type iamPolicyV2Control struct {
+	Grant *iamPolicyV2Grant `json:"grant"`
+}
+
+// iamPolicyV2Rule is either a single condition, or an operator that combines a list of conditions
+type iamPolicyV2Rule struct {
+	Key        *string                `json:"key,omitempty"`
+	Operator   *string                `json:"operator"`
+	Value      interface{}            `json:"value,omitempty"`
+	Conditions []iamPolicyV2Attribute `json:"conditions,omitempty"`
+}
+
+type iamPolicyV2 struct {
+	ID             *string              `json:"id,omitempty"`
+	Type           *string              `json:"type"`
+	Description    *string              `json:"description,omitempty"`
+	Subject        *iamPolicyV2Subject  `json:"subject,omitempty"`
+	Resource       *iamPolicyV2Resource `json:"resource"`
+	Pattern        *string              `json:"pattern,omitempty"`
+	Rule           *iamPolicyV2Rule     `json:"rule,omitempty"`
+	Control        *iamPolicyV2Control  `json:"control"`
+	State          *string              `json:"state,omitempty"`
+	Href           *string              `json:"href,omitempty"`
+	CreatedAt      *string              `json:"created_at,omitempty"`
+	LastModifiedAt *string              `json:"last_modified_at,omitempty"`
+}
+
+type iamPolicyTemplate struct {
+	ID          *string      `json:"id,omitempty"`
+	Name        *string      `json:"name,omitempty"`
+	Description *string      `json:"description,omitempty"`
+	AccountID   *string      `json:"account_id,omitempty"`
+	Version     *string      `json:"version,omitempty"`
+	Committed   *bool        `json:"committed,omitempty"`
+	State       *string      `json:"state,omitempty"`
+	Policy      *iamPolicyV2 `json:"policy"`
+	Href        *string      `json:"href,omitempty"`
+	CreatedAt   *string      `json:"created_at,omitempty"`
+}
+
+func iamPolicyV2Request(client *iampolicymanagementv1.IamPolicyManagementV1, method, path, ifMatch string, body, result interface{}) (*core.DetailedResponse, error) {
+	return sendCoreRequest(context.Background(), client.Service, coreRequest{Method: method, Path: path, Headers: map[string]string{"If-Match": ifMatch}, Body: body}, result)
+}
+
+func iamPolicyV2Path(policyID string) string {
+	return iamPoliciesV2Path + "/" + url.PathEscape(policyID)
+}
+
+func iamPolicyTemplatePath(templateID string) string {
+	return iamPolicyTemplatesPath + "/" + url.PathEscape(templateID)
+}
+
+func iamPolicyTemplateVersionPath(templateID, version string) string {
+	return iamPolicyTemplatePath(templateID) + "/versions/" + url.PathEscape(version)
+}
+
+func getIAMPolicyV2(client *iampolicymanagementv1.IamPolicyManagementV1, policyID string) (*iamPolicyV2, *core.DetailedResponse, error) {
+	result := &iamPolicyV2{}
+	resp, err := iamPolicyV2Request(client, core.GET, iamPolicyV2Path(policyID), "", nil, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
+// listIAMPoliciesV2 lists the policies that match the query through the v2 API, page by page
+func listIAMPoliciesV2(client *iampolicymanagementv1.IamPolicyManagementV1, query url.Values) ([]iamPolicyV2, *core.DetailedResponse, error) {
+	policies := []iamPolicyV2{}
+	query.Set("limit", "100")
+	for {
+		result := &struct {
+			Policies []iamPolicyV2 `json:"policies"`
+			Next     *struct {
+				Start *string `json:"start"`
+			} `json:"next"`
+		}{}
+		resp, err := iamPolicyV2Request(client, core.GET, iamPoliciesV2Path+"?"+query.Encode(), "", nil, result)
+		if err != nil {
+			return nil, resp, err
+		}
+		policies = append(policies, result.Policies...)
+		if result.Next == nil || result.Next.Start == nil || *result.Next.Start == "" {
+			return policies, resp, nil
+		}
+		query.Set("start", *result.Next.Start)
+	}
+}
+
+func getIAMPolicyTemplateVersion(client *iampolicymanagementv1.IamPolicyManagementV1, templateID, version string) (*iamPolicyTemplate, *core.DetailedResponse, error) {
+	result := &iamPolicyTemplate{}
+	resp, err := iamPolicyV2Request(client, core.GET, iamPolicyTemplateVersionPath(templateID, version), "", nil, result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return result, resp, nil
+}
+
+// Schema of the rule conditions shared by the policy resources
+func iamPolicyRuleConditionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Rule conditions enforced by the policy, the policy only grants access while the conditions are met",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Key of the condition, for example {{environment.attributes.current_date_time}}",
+				},
+				"operator": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateAllowedStringValue(iamPolicyRuleConditionOperators),
+					Description:  "Operator of the condition",
+				},
+				"value": {
+					Type:        schema.TypeSet,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: "Value of the condition, more than one value is only supported by the AnyOf operators",
+				},
+			},
+		},
+	}
+}
+
+func iamPolicyRuleOperatorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validateAllowedStringValue([]string{"and", "or"}),
+		Description:  "Operator that combines the rule conditions, defaults to and",
+	}
+}
+
+func iamPolicyPatternSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Pattern of the rule conditions, for example time-based-conditions:once or time-based-conditions:weekly:custom-hours",
+	}
+}
+
+// iamPolicyUsesV2 reports whether the configured policy has rule conditions or a pattern, which can only be
+// written through the v2 API
+func iamPolicyUsesV2(d *schema.ResourceData) bool {
+	if conditions, ok := d.Get("rule_conditions").(*schema.Set); ok && conditions.Len() > 0 {
+		return true
+	}
+	return d.Get("pattern").(string) != ""
+}
+
+// hasRule reports whether the policy has rule conditions or a pattern
+func (policy *iamPolicyV2) hasRule() bool {
+	return policy.Rule != nil || (policy.Pattern != nil && *policy.Pattern != "")
+}
+
+func expandIAMPolicyRule(conditions *schema.Set, operator string) *iamPolicyV2Rule {
+	if conditions == nil || conditions.Len() == 0 {
+		return nil
+	}
+	attributes := make([]iamPolicyV2Attribute, 0, conditions.Len())
+	for _, c := range conditions.List() {
+		condition := c.(map[string]interface{})
+		conditionOperator := condition["operator"].(string)
+		values := expandStringList(condition["value"].(*schema.Set).List())
+		sort.Strings(values)
+		var value interface{} = values
+		if !strings.HasSuffix(conditionOperator, "AnyOf") && len(values) == 1 {
+			value = values[0]
+		}
+		attributes = append(attributes, iamPolicyV2Attribute{
+			Key:      core.StringPtr(condition["key"].(string)),
+			Operator: core.StringPtr(conditionOperator),
+			Value:    value,
+		})
+	}
+	if len(attributes) == 1 {
+		return &iamPolicyV2Rule{
+			Key:      attributes[0].Key,
+			Operator: attributes[0].Operator,
+			Value:    attributes[0].Value,
+		}
+	}
+	if operator == "" {
+		operator = "and"
+	}
+	return &iamPolicyV2Rule{
+		Operator:   core.StringPtr(operator),
+		Conditions: attributes,
+	}
+}
+
+func flattenIAMPolicyRuleValue(value interface{}) []string {
+	switch v := value.(type) {
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+		return values
+	case nil:
+		return []string{}
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+func flattenIAMPolicyRuleConditions(rule *iamPolicyV2Rule) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	if rule == nil {
+		return result
+	}
+	conditions := rule.Conditions
+	if len(conditions) == 0 && rule.Key != nil {
+		conditions = []iamPolicyV2Attribute{{Key: rule.Key, Operator: rule.Operator, Value: rule.Value}}
+	}
+	for _, condition := range conditions {
+		result = append(result, map[string]interface{}{
+			"key":      condition.Key,
+			"operator": condition.Operator,
+			"value":    flattenIAMPolicyRuleValue(condition.Value),
+		})
+	}
+	return result
+}
+
+// setIAMPolicyRule sets the rule conditions and the pattern of a policy
+func setIAMPolicyRule(d *schema.ResourceData, policy *iamPolicyV2) {
+	if policy == nil {
+		d.Set("rule_conditions", []map[string]interface{}{})
+		d.Set("rule_operator", "")
+		d.Set("pattern", "")
+		return
+	}
+	d.Set("rule_conditions", flattenIAMPolicyRuleConditions(policy.Rule))
+	if policy.Rule != nil && len(policy.Rule.Conditions) > 0 {
+		d.Set("rule_operator", policy.Rule.Operator)
+	} else {
+		d.Set("rule_operator", "")
+	}
+	d.Set("pattern", policy.Pattern)
+}
+
+func expandIAMPolicyV2Attributes(attributes []iampolicymanagementv1.ResourceAttribute) []iamPolicyV2Attribute {
+	result := make([]iamPolicyV2Attribute, 0, len(attributes))
+	for _, a := range attributes {
+		operator := a.Operator
+		if operator == nil || *operator == "" {
+			operator = core.StringPtr("stringEquals")
+		}
+		result = append(result, iamPolicyV2Attribute{
+			Key:      a.Name,
+			Operator: operator,
+			Value:    a.Value,
+		})
+	}
+	return result
+}
+
+// expandIAMPolicyV2 converts the v1 model of a policy to the v2 model and adds the rule and the pattern
+// of the resource to it
+func expandIAMPolicyV2(d *schema.ResourceData, policyType *string, subjects []iampolicymanagementv1.PolicySubject, roles []iampolicymanagementv1.PolicyRole, resources []iampolicymanagementv1.PolicyResource, description *string) *iamPolicyV2 {
+	policy := &iamPolicyV2{
+		Type:        policyType,
+		Description: description,
+		Resource:    &iamPolicyV2Resource{Attributes: []iamPolicyV2Attribute{}},
+		Control:     &iamPolicyV2Control{Grant: &iamPolicyV2Grant{Roles: []iamPolicyV2Role{}}},
+		Rule:        expandIAMPolicyRule(d.Get("rule_conditions").(*schema.Set), d.Get("rule_operator").(string)),
+	}
+	if len(subjects) > 0 {
+		policy.Subject = &iamPolicyV2Subject{Attributes: []iamPolicyV2Attribute{}}
+		for _, a := range subjects[0].Attributes {
+			policy.Subject.Attributes = append(policy.Subject.Attributes, iamPolicyV2Attribute{
+				Key:      a.Name,
+				Operator: core.StringPtr("stringEquals"),
+				Value:    a.Value,
+			})
+		}
+	}
+	if len(resources) > 0 {
+		policy.Resource.Attributes = expandIAMPolicyV2Attributes(resources[0].Attributes)
+	}
+	for _, role := range roles {
+		policy.Control.Grant.Roles = append(policy.Control.Grant.Roles, iamPolicyV2Role{RoleID: role.RoleID})
+	}
+	if pattern := d.Get("pattern").(string); pattern != "" {
+		policy.Pattern = core.StringPtr(pattern)
+	}
+	return policy
+}
+
+// expandIAMPolicyV2Grant returns the resource and the control of a v2 policy that grants the roles with
+// the given names on the resource with the given attributes
+func expandIAMPolicyV2Grant(meta interface{}, roleNames []string, attributes *schema.Set) (*iamPolicyV2Resource, *iamPolicyV2Control, error) {
+	resource := iampolicymanagementv1.PolicyResource{Attributes: []iampolicymanagementv1.ResourceAttribute{}}
+	for _, a := range attributes.List() {
+		attribute := a.(map[string]interface{})
+		resource.Attributes = append(resource.Attributes, iampolicymanagementv1.ResourceAttribute{
+			Name:     core.StringPtr(attribute["name"].(string)),
+			Value:    core.StringPtr(attribute["value"].(string)),
+			Operator: core.StringPtr(attribute["operator"].(string)),
+		})
+	}
+
+	supported, err := listIAMPolicyRoles(meta, iamPolicyRoleService(*getResourceAttribute("serviceName", resource), *getResourceAttribute("serviceType", resource)))
+	if err != nil {
+		return nil, nil, err
+	}
+	roles, err := getRolesFromRoleNames(roleNames, supported)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	control := &iamPolicyV2Control{Grant: &iamPolicyV2Grant{Roles: []iamPolicyV2Role{}}}
+	for _, role := range roles {
+		control.Grant.Roles = append(control.Grant.Roles, iamPolicyV2Role{RoleID: role.RoleID})
+	}
+	return &iamPolicyV2Resource{Attributes: expandIAMPolicyV2Attributes(resource.Attributes)}, control, nil
+}
+
+func iamPolicyV2AttributeValue(a iamPolicyV2Attribute) *string {
+	if s, ok := a.Value.(string); ok {
+		return core.StringPtr(s)
+	}
+	return core.StringPtr(fmt.Sprint(a.Value))
+}
+
+// iamPolicyRoleService returns the service to list the roles of a policy with the given resource attributes
+func iamPolicyRoleService(serviceName, serviceType string) string {
+	if serviceName == "" && serviceType != "platform_service" {
+		return "alliamserviceroles"
+	}
+	return serviceName
+}
+
+func listIAMPolicyRoles(meta interface{}, serviceName string) ([]iampolicymanagementv1.PolicyRole, error) {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID:   &userDetails.userAccount,
+		ServiceName: &serviceName,
+	}
+	roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	if err != nil {
+		return nil, err
+	}
+	return mapRoleListToPolicyRoles(*roleList), nil
+}
+
+// iamPolicyV2ToV1 converts the v2 model of a policy to the v1 model, the v2 API doesn't return the display
+// names of the roles so they are looked up in the roles of the service of the policy
+func iamPolicyV2ToV1(meta interface{}, policy *iamPolicyV2) (*iampolicymanagementv1.Policy, error) {
+	result := &iampolicymanagementv1.Policy{
+		ID:          policy.ID,
+		Type:        policy.Type,
+		Description: policy.Description,
+		State:       policy.State,
+		Href:        policy.Href,
+		Subjects:    []iampolicymanagementv1.PolicySubject{},
+		Roles:       []iampolicymanagementv1.PolicyRole{},
+		Resources:   []iampolicymanagementv1.PolicyResource{},
+	}
+	if policy.Subject != nil {
+		subject := iampolicymanagementv1.PolicySubject{}
+		for _, a := range policy.Subject.Attributes {
+			subject.Attributes = append(subject.Attributes, iampolicymanagementv1.SubjectAttribute{
+				Name:  a.Key,
+				Value: iamPolicyV2AttributeValue(a),
+			})
+		}
+		result.Subjects = append(result.Subjects, subject)
+	}
+	resource := iampolicymanagementv1.PolicyResource{}
+	if policy.Resource != nil {
+		for _, a := range policy.Resource.Attributes {
+			resource.Attributes = append(resource.Attributes, iampolicymanagementv1.ResourceAttribute{
+				Name:     a.Key,
+				Value:    iamPolicyV2AttributeValue(a),
+				Operator: a.Operator,
+			})
+		}
+	}
+	result.Resources = append(result.Resources, resource)
+
+	if policy.Control != nil && policy.Control.Grant != nil {
+		names, err := iamPolicyRoleNames(meta, resource, policy.Control.Grant.Roles)
+		if err != nil {
+			return nil, err
+		}
+		for i, role := range policy.Control.Grant.Roles {
+			result.Roles = append(result.Roles, iampolicymanagementv1.PolicyRole{
+				RoleID:      role.RoleID,
+				DisplayName: core.StringPtr(names[i]),
+			})
+		}
+	}
+	return result, nil
+}
+
+func iamPolicyRoleNames(meta interface{}, resource iampolicymanagementv1.PolicyResource, roles []iamPolicyV2Role) ([]string, error) {
+	names := make([]string, len(roles))
+	var supported []iampolicymanagementv1.PolicyRole
+	for i, role := range roles {
+		if role.DisplayName != nil {
+			names[i] = *role.DisplayName
+			continue
+		}
+		if supported == nil {
+			var err error
+			supported, err = listIAMPolicyRoles(meta, iamPolicyRoleService(*getResourceAttribute("serviceName", resource), *getResourceAttribute("serviceType", resource)))
+			if err != nil {
+				return nil, err
+			}
+		}
+		names[i] = (*role.RoleID)[strings.LastIndex(*role.RoleID, ":")+1:]
+		for _, s := range supported {
+			if s.RoleID != nil && *s.RoleID == *role.RoleID && s.DisplayName != nil {
+				names[i] = *s.DisplayName
+				break
+			}
+		}
+	}
+	return names, nil
+}
+
+// getIAMPolicy gets the policy through the v1 API. A policy with rule conditions or a pattern, in the configuration or
+// in the v1 response, is read through the v2 API and is returned in the v1 model together with its v2 model
+func getIAMPolicy(d *schema.ResourceData, meta interface{}, client *iampolicymanagementv1.IamPolicyManagementV1, policyID string) (*iampolicymanagementv1.Policy, *iamPolicyV2, *core.DetailedResponse, error) {
+	if !iamPolicyUsesV2(d) {
+		// The v1 model drops the rule and the pattern, so the response is checked for them before it is decoded
+		raw := map[string]json.RawMessage{}
+		resp, err := iamPolicyV2Request(client, core.GET, iamPoliciesV1Path+"/"+url.PathEscape(policyID), "", nil, &raw)
+		if err != nil {
+			return nil, nil, resp, err
+		}
+		_, hasRule := raw["rule"]
+		_, hasPattern := raw["pattern"]
+		if !hasRule && !hasPattern {
+			var policy *iampolicymanagementv1.Policy
+			if err := core.UnmarshalModel(raw, "", &policy, iampolicymanagementv1.UnmarshalPolicy); err != nil {
+				return nil, nil, resp, err
+			}
+			return policy, nil, resp, nil
+		}
+	}
+	policyV2, resp, err := getIAMPolicyV2(client, policyID)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+	policy, err := iamPolicyV2ToV1(meta, policyV2)
+	if err != nil {
+		return nil, nil, resp, err
+	}
+	return policy, policyV2, resp, nil
+}
+
+func createIAMPolicy(d *schema.ResourceData, client *iampolicymanagementv1.IamPolicyManagementV1, options *iampolicymanagementv1.CreatePolicyOptions) (*iampolicymanagementv1.Policy, *core.DetailedResponse, error) {
+	if !iamPolicyUsesV2(d) {
+		return client.CreatePolicy(options)
+	}
+	result := &iamPolicyV2{}
+	resp, err := iamPolicyV2Request(client, core.POST, iamPoliciesV2Path, "", expandIAMPolicyV2(d, options.Type, options.Subjects, options.Roles, options.Resources, options.Description), result)
+	if err != nil {
+		return nil, resp, err
+	}
+	return &iampolicymanagementv1.Policy{ID: result.ID}, resp, nil
+}
+
+// updateIAMPolicy updates the policy through the v2 API when the configuration or the current policy has rule
+// conditions or a pattern, so that the ones that are no longer configured are removed
+func updateIAMPolicy(d *schema.ResourceData, client *iampolicymanagementv1.IamPolicyManagementV1, options *iampolicymanagementv1.UpdatePolicyOptions) (*core.DetailedResponse, error) {
+	current, resp, err := getIAMPolicyV2(client, *options.PolicyID)
+	if err != nil {
+		return resp, err
+	}
+	if !iamPolicyUsesV2(d) && !current.hasRule() {
+		_, resp, err := client.UpdatePolicy(options)
+		return resp, err
+	}
+	policy := expandIAMPolicyV2(d, options.Type, options.Subjects, options.Roles, options.Resources, options.Description)
+	return iamPolicyV2Request(client, core.PUT, iamPolicyV2Path(*options.PolicyID), resp.Headers.Get("ETag"), policy, nil)
+}
+
+// deleteIAMPolicy deletes the policy through the v2 API when it has rule conditions or a pattern, the state is
+// read through the v2 API so it has them whenever the policy does
+func deleteIAMPolicy(d *schema.ResourceData, client *iampolicymanagementv1.IamPolicyManagementV1, policyID string) (*core.DetailedResponse, error) {
+	if !iamPolicyUsesV2(d) {
+		return client.DeletePolicy(client.NewDeletePolicyOptions(policyID))
+	}
+	return iamPolicyV2Request(client, core.DELETE, iamPolicyV2Path(policyID), "", nil, nil)
+}
+
+// orderIAMPolicyRoles keeps the roles in the order of the configuration when the policy grants the same
+// roles, the API doesn't preserve the order of the roles
+func orderIAMPolicyRoles(configured []interface{}, roles []string) []string {
+	if len(configured) != len(roles) {
+		return roles
+	}
+	remaining := make(map[string]int, len(roles))
+	for _, role := range roles {
+		remaining[role]++
+	}
+	ordered := make([]string, 0, len(configured))
+	for _, c := range configured {
+		role, ok := c.(string)
+		if !ok || remaining[role] == 0 {
+			return roles
+		}
+		remaining[role]--
+		ordered = append(ordered, role)
+	}
+	return ordered
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestIAMPolicyRuleConditions(t *testing.T) {
+	conditions := iamPolicyRuleConditionsSchema()
+	set := schema.NewSet(schema.HashResource(conditions.Elem.(*schema.Resource)), []interface{}{
+		map[string]interface{}{
+			"key":      "{{environment.attributes.day_of_week}}",
+			"operator": "dayOfWeekAnyOf",
+			"value":    schema.NewSet(schema.HashString, []interface{}{"5+00:00", "1+00:00"}),
+		},
+	})
+
+	rule := expandIAMPolicyRule(set, "")
+	if rule.Conditions != nil || *rule.Key != "{{environment.attributes.day_of_week}}" {
+		t.Fatalf("a single condition should be the rule, got %+v", rule)
+	}
+	if !reflect.DeepEqual(rule.Value, []string{"1+00:00", "5+00:00"}) {
+		t.Errorf("the values of an AnyOf operator should be a sorted list, got %#v", rule.Value)
+	}
+
+	set.Add(map[string]interface{}{
+		"key":      "{{environment.attributes.current_time}}",
+		"operator": "timeGreaterThanOrEquals",
+		"value":    schema.NewSet(schema.HashString, []interface{}{"09:00:00+00:00"}),
+	})
+	rule = expandIAMPolicyRule(set, "")
+	if *rule.Operator != "and" || len(rule.Conditions) != 2 {
+		t.Fatalf("conditions should be combined with and by default, got %+v", rule)
+	}
+	for _, c := range rule.Conditions {
+		if *c.Operator == "timeGreaterThanOrEquals" && c.Value != "09:00:00+00:00" {
+			t.Errorf("the value of a single value operator should be a string, got %#v", c.Value)
+		}
+	}
+
+	flattened := flattenIAMPolicyRuleConditions(&iamPolicyV2Rule{
+		Operator: rule.Operator,
+		Conditions: []iamPolicyV2Attribute{
+			{Key: rule.Conditions[0].Key, Operator: rule.Conditions[0].Operator, Value: []interface{}{"1+00:00", "5+00:00"}},
+			{Key: rule.Conditions[1].Key, Operator: rule.Conditions[1].Operator, Value: rule.Conditions[1].Value},
+		},
+	})
+	if len(flattened) != 2 {
+		t.Fatalf("expected 2 conditions, got %d", len(flattened))
+	}
+}
+
+func TestOrderIAMPolicyRoles(t *testing.T) {
+	cases := []struct {
+		configured []interface{}
+		roles      []string
+		expected   []string
+	}{
+		{[]interface{}{"Writer", "Viewer"}, []string{"Viewer", "Writer"}, []string{"Writer", "Viewer"}},
+		{[]interface{}{"Writer", "Viewer"}, []string{"Viewer", "Manager"}, []string{"Viewer", "Manager"}},
+		{[]interface{}{"Writer"}, []string{"Viewer", "Writer"}, []string{"Viewer", "Writer"}},
+		{[]interface{}{}, []string{"Viewer"}, []string{"Viewer"}},
+	}
+	for _, c := range cases {
+		if got := orderIAMPolicyRoles(c.configured, c.roles); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("orderIAMPolicyRoles(%v, %v) = %v, expected %v", c.configured, c.roles, got, c.expected)
+		}
+	}
+}
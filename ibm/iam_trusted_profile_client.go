@@ -0,0 +1,156 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+// Trusted profiles, their claim rules and links are missing from the iamidentityv1 package the
+// provider is built with.
+
+const (
+	iamTrustedProfilesPath = "/v1/profiles"
+
+	iamTrustedProfileClaimRuleTypeSAML = "Profile-SAML"
+	iamTrustedProfileClaimRuleTypeCR   = "Profile-CR"
+)
+
+type iamTrustedProfile struct {
+	ID           *string `json:"id,omitempty"`
+	EntityTag    *string `json:"entity_tag,omitempty"`
+	CRN          *string `json:"crn,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	CreatedAt    *string `json:"created_at,omitempty"`
+	ModifiedAt   *string `json:"modified_at,omitempty"`
+	IamID        *string `json:"iam_id,omitempty"`
+	AccountID    *string `json:"account_id,omitempty"`
+	ImsAccountID *int64  `json:"ims_account_id,omitempty"`
+	ImsUserID    *int64  `json:"ims_user_id,omitempty"`
+}
+
+type iamTrustedProfileList struct {
+	Profiles []iamTrustedProfile `json:"profiles"`
+	Next     *string             `json:"next,omitempty"`
+}
+
+type iamTrustedProfileClaimRuleCondition struct {
+	Claim    *string `json:"claim"`
+	Operator *string `json:"operator"`
+	Value    *string `json:"value"`
+}
+
+type iamTrustedProfileClaimRule struct {
+	ID         *string                               `json:"id,omitempty"`
+	EntityTag  *string                               `json:"entity_tag,omitempty"`
+	CreatedAt  *string                               `json:"created_at,omitempty"`
+	ModifiedAt *string                               `json:"modified_at,omitempty"`
+	Name       *string                               `json:"name,omitempty"`
+	Type       *string                               `json:"type,omitempty"`
+	RealmName  *string                               `json:"realm_name,omitempty"`
+	CrType     *string                               `json:"cr_type,omitempty"`
+	Expiration *int64                                `json:"expiration,omitempty"`
+	Conditions []iamTrustedProfileClaimRuleCondition `json:"conditions"`
+}
+
+type iamTrustedProfileLinkTarget struct {
+	CRN       *string `json:"crn"`
+	Namespace *string `json:"namespace,omitempty"`
+	Name      *string `json:"name,omitempty"`
+}
+
+type iamTrustedProfileLink struct {
+	ID         *string                      `json:"id,omitempty"`
+	EntityTag  *string                      `json:"entity_tag,omitempty"`
+	CreatedAt  *string                      `json:"created_at,omitempty"`
+	ModifiedAt *string                      `json:"modified_at,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	CrType     *string                      `json:"cr_type"`
+	Link       *iamTrustedProfileLinkTarget `json:"link"`
+}
+
+// iamTrustedProfileRequest sends a request to the trusted profile API, result is decoded from the JSON response when it is not nil
+func iamTrustedProfileRequest(ctx context.Context, client *iamidentityv1.IamIdentityV1, method, path string, query url.Values, ifMatch string, body, result interface{}) (*core.DetailedResponse, error) {
+	return sendCoreRequest(ctx, client.Service, coreRequest{Method: method, Path: path, Query: query, Headers: map[string]string{"If-Match": ifMatch}, Body: body}, result)
+}
+
+func iamTrustedProfilePath(profileID string) string {
+	return iamTrustedProfilesPath + "/" + url.PathEscape(profileID)
+}
+
+func iamTrustedProfileClaimRulePath(profileID, ruleID string) string {
+	path := iamTrustedProfilePath(profileID) + "/rules"
+	if ruleID != "" {
+		path += "/" + url.PathEscape(ruleID)
+	}
+	return path
+}
+
+func iamTrustedProfileLinkPath(profileID, linkID string) string {
+	path := iamTrustedProfilePath(profileID) + "/links"
+	if linkID != "" {
+		path += "/" + url.PathEscape(linkID)
+	}
+	return path
+}
+
+func getIAMTrustedProfile(ctx context.Context, client *iamidentityv1.IamIdentityV1, profileID string) (*iamTrustedProfile, *core.DetailedResponse, error) {
+	profile := &iamTrustedProfile{}
+	response, err := iamTrustedProfileRequest(ctx, client, core.GET, iamTrustedProfilePath(profileID), nil, "", nil, profile)
+	if err != nil {
+		return nil, response, err
+	}
+	return profile, response, nil
+}
+
+func listIAMTrustedProfiles(ctx context.Context, client *iamidentityv1.IamIdentityV1, accountID string) ([]iamTrustedProfile, error) {
+	profiles := []iamTrustedProfile{}
+	query := url.Values{}
+	query.Set("account_id", accountID)
+	query.Set("pagesize", "100")
+	for {
+		list := &iamTrustedProfileList{}
+		response, err := iamTrustedProfileRequest(ctx, client, core.GET, iamTrustedProfilesPath, query, "", nil, list)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing trusted profiles: %s\n%s", err, response)
+		}
+		profiles = append(profiles, list.Profiles...)
+		if list.Next == nil || *list.Next == "" {
+			return profiles, nil
+		}
+		next, err := url.Parse(*list.Next)
+		if err != nil {
+			return nil, err
+		}
+		start := next.Query().Get("pagetoken")
+		if start == "" {
+			return profiles, nil
+		}
+		query.Set("pagetoken", start)
+	}
+}
+
+func getIAMTrustedProfileClaimRule(ctx context.Context, client *iamidentityv1.IamIdentityV1, profileID, ruleID string) (*iamTrustedProfileClaimRule, *core.DetailedResponse, error) {
+	rule := &iamTrustedProfileClaimRule{}
+	response, err := iamTrustedProfileRequest(ctx, client, core.GET, iamTrustedProfileClaimRulePath(profileID, ruleID), nil, "", nil, rule)
+	if err != nil {
+		return nil, response, err
+	}
+	return rule, response, nil
+}
+
+func getIAMTrustedProfileLink(ctx context.Context, client *iamidentityv1.IamIdentityV1, profileID, linkID string) (*iamTrustedProfileLink, *core.DetailedResponse, error) {
+	link := &iamTrustedProfileLink{}
+	response, err := iamTrustedProfileRequest(ctx, client, core.GET, iamTrustedProfileLinkPath(profileID, linkID), nil, "", nil, link)
+	if err != nil {
+		return nil, response, err
+	}
+	return link, response, nil
+}
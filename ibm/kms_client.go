@@ -0,0 +1,278 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	kp "github.com/IBM/keyprotect-go-client"
+)
+
+// kmsKeysV2 lists key versions, restores keys and manages KMIP adapters of Key Protect and Hyper Protect
+// Crypto Services instances, at the endpoint, instance and key ring of the kp.Client it is given.
+type kmsKeysV2 struct {
+	Service *core.BaseService
+}
+
+const (
+	kmsKeyCollectionType             = "application/vnd.ibm.kms.key+json"
+	kmsKMIPAdapterCollectionType     = "application/vnd.ibm.kms.kmip_adapter+json"
+	kmsKMIPClientCertCollectionType  = "application/vnd.ibm.kms.kmip_client_certificate+json"
+	kmsKMIPAdapterProfileNative      = "native_1.0"
+	kmsKMIPAdaptersPath              = "kmip_adapters"
+	kmsKMIPAdapterClientCertsPathFmt = "kmip_adapters/%s/certificates"
+)
+
+type kmsCollectionMetadata struct {
+	CollectionType  string `json:"collectionType"`
+	CollectionTotal int    `json:"collectionTotal"`
+}
+
+type kmsKeyVersion struct {
+	ID           string     `json:"id"`
+	CreationDate *time.Time `json:"creationDate,omitempty"`
+}
+
+type kmsKeyVersions struct {
+	Metadata  kmsCollectionMetadata `json:"metadata"`
+	Resources []kmsKeyVersion       `json:"resources"`
+}
+
+type kmsRestoreKeyMaterial struct {
+	Payload        string `json:"payload"`
+	EncryptedNonce string `json:"encryptedNonce,omitempty"`
+	IV             string `json:"iv,omitempty"`
+}
+
+type kmsRestoreKeyBody struct {
+	Metadata  kmsCollectionMetadata   `json:"metadata"`
+	Resources []kmsRestoreKeyMaterial `json:"resources"`
+}
+
+type kmsKMIPProfileData struct {
+	CrkID string `json:"crk_id,omitempty"`
+}
+
+type kmsKMIPAdapter struct {
+	ID          string              `json:"id,omitempty"`
+	Name        string              `json:"name,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Profile     string              `json:"profile,omitempty"`
+	ProfileData *kmsKMIPProfileData `json:"profile_data,omitempty"`
+	CreatedBy   string              `json:"created_by,omitempty"`
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	UpdatedBy   string              `json:"updated_by,omitempty"`
+	UpdatedAt   *time.Time          `json:"updated_at,omitempty"`
+}
+
+type kmsKMIPAdapters struct {
+	Metadata  kmsCollectionMetadata `json:"metadata"`
+	Resources []kmsKMIPAdapter      `json:"resources"`
+}
+
+type kmsKMIPClientCert struct {
+	ID          string     `json:"id,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Certificate string     `json:"certificate,omitempty"`
+	CreatedBy   string     `json:"created_by,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+}
+
+type kmsKMIPClientCerts struct {
+	Metadata  kmsCollectionMetadata `json:"metadata"`
+	Resources []kmsKMIPClientCert   `json:"resources"`
+}
+
+func newKmsKeysV2(serviceURL string, authenticator core.Authenticator) (*kmsKeysV2, error) {
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           serviceURL,
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &kmsKeysV2{Service: service}, nil
+}
+
+// request sends a request to the keys API of the instance kpAPI is configured for, path is resolved
+// the same way keyprotect-go-client resolves it so that Key Protect and HPCS endpoints both work.
+// body is sent with contentType, which differs between the key and the KMIP endpoints, and result is
+// decoded from the JSON response when it is not nil
+func (kms *kmsKeysV2) request(ctx context.Context, kpAPI *kp.Client, method, path, contentType string, body, result interface{}) (*core.DetailedResponse, error) {
+	u, err := kpAPI.URL.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	// Keys are returned with vendor media types, which sendCoreRequest decodes as JSON
+	return sendCoreRequest(ctx, kms.Service, coreRequest{
+		Method: method,
+		URL:    u.String(),
+		Headers: map[string]string{
+			"bluemix-instance": kpAPI.Config.InstanceID,
+			"x-kms-key-ring":   kpAPI.Config.KeyRing,
+		},
+		ContentType: contentType,
+		Body:        body,
+	}, result)
+}
+
+// ListKeyVersions returns the versions of the key material of a root key, every rotation adds a version
+func (kms *kmsKeysV2) ListKeyVersions(ctx context.Context, kpAPI *kp.Client, keyID string) ([]kmsKeyVersion, error) {
+	result := &kmsKeyVersions{}
+	_, err := kms.request(ctx, kpAPI, core.GET, fmt.Sprintf("keys/%s/versions", keyID), "", nil, result)
+	if err != nil {
+		return nil, err
+	}
+	return result.Resources, nil
+}
+
+// RestoreKey restores a deleted key, imported keys are restored with the key material they were imported with
+func (kms *kmsKeysV2) RestoreKey(ctx context.Context, kpAPI *kp.Client, keyID string, material kmsRestoreKeyMaterial) (*kp.Key, error) {
+	body := &kmsRestoreKeyBody{
+		Metadata: kmsCollectionMetadata{
+			CollectionType:  kmsKeyCollectionType,
+			CollectionTotal: 1,
+		},
+		Resources: []kmsRestoreKeyMaterial{material},
+	}
+	result := &kp.Keys{}
+	_, err := kms.request(ctx, kpAPI, core.POST, fmt.Sprintf("keys/%s/restore", keyID), kmsKeyCollectionType, body, result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Keys) == 0 {
+		return nil, fmt.Errorf("the restore of key %s returned no key", keyID)
+	}
+	return &result.Keys[0], nil
+}
+
+func (kms *kmsKeysV2) CreateKMIPAdapter(ctx context.Context, kpAPI *kp.Client, adapter kmsKMIPAdapter) (*kmsKMIPAdapter, error) {
+	body := &kmsKMIPAdapters{
+		Metadata: kmsCollectionMetadata{
+			CollectionType:  kmsKMIPAdapterCollectionType,
+			CollectionTotal: 1,
+		},
+		Resources: []kmsKMIPAdapter{adapter},
+	}
+	result := &kmsKMIPAdapters{}
+	_, err := kms.request(ctx, kpAPI, core.POST, kmsKMIPAdaptersPath, "application/json", body, result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Resources) == 0 {
+		return nil, fmt.Errorf("the creation of KMIP adapter %s returned no adapter", adapter.Name)
+	}
+	return &result.Resources[0], nil
+}
+
+// GetKMIPAdapter returns the adapter together with the response, the status code of the response tells
+// whether the adapter is gone
+func (kms *kmsKeysV2) GetKMIPAdapter(ctx context.Context, kpAPI *kp.Client, adapterID string) (*kmsKMIPAdapter, *core.DetailedResponse, error) {
+	result := &kmsKMIPAdapters{}
+	response, err := kms.request(ctx, kpAPI, core.GET, kmsKMIPAdaptersPath+"/"+adapterID, "", nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	if len(result.Resources) == 0 {
+		return nil, response, fmt.Errorf("KMIP adapter %s was not returned", adapterID)
+	}
+	return &result.Resources[0], response, nil
+}
+
+func (kms *kmsKeysV2) DeleteKMIPAdapter(ctx context.Context, kpAPI *kp.Client, adapterID string) (*core.DetailedResponse, error) {
+	return kms.request(ctx, kpAPI, core.DELETE, kmsKMIPAdaptersPath+"/"+adapterID, "", nil, nil)
+}
+
+func (kms *kmsKeysV2) CreateKMIPClientCert(ctx context.Context, kpAPI *kp.Client, adapterID string, cert kmsKMIPClientCert) (*kmsKMIPClientCert, error) {
+	body := &kmsKMIPClientCerts{
+		Metadata: kmsCollectionMetadata{
+			CollectionType:  kmsKMIPClientCertCollectionType,
+			CollectionTotal: 1,
+		},
+		Resources: []kmsKMIPClientCert{cert},
+	}
+	result := &kmsKMIPClientCerts{}
+	_, err := kms.request(ctx, kpAPI, core.POST, fmt.Sprintf(kmsKMIPAdapterClientCertsPathFmt, adapterID), "application/json", body, result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Resources) == 0 {
+		return nil, fmt.Errorf("the creation of KMIP client certificate %s returned no certificate", cert.Name)
+	}
+	return &result.Resources[0], nil
+}
+
+// GetKMIPClientCert returns the certificate together with the response, the status code of the response
+// tells whether the certificate is gone
+func (kms *kmsKeysV2) GetKMIPClientCert(ctx context.Context, kpAPI *kp.Client, adapterID, certID string) (*kmsKMIPClientCert, *core.DetailedResponse, error) {
+	result := &kmsKMIPClientCerts{}
+	response, err := kms.request(ctx, kpAPI, core.GET, fmt.Sprintf(kmsKMIPAdapterClientCertsPathFmt, adapterID)+"/"+certID, "", nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	if len(result.Resources) == 0 {
+		return nil, response, fmt.Errorf("KMIP client certificate %s was not returned", certID)
+	}
+	return &result.Resources[0], response, nil
+}
+
+func (kms *kmsKeysV2) DeleteKMIPClientCert(ctx context.Context, kpAPI *kp.Client, adapterID, certID string) (*core.DetailedResponse, error) {
+	return kms.request(ctx, kpAPI, core.DELETE, fmt.Sprintf(kmsKMIPAdapterClientCertsPathFmt, adapterID)+"/"+certID, "", nil, nil)
+}
+
+// kmsInstanceAPI configures the key management client for the Key Protect or HPCS instance, HPCS
+// instances are reached through the KMS endpoint of the instance
+func kmsInstanceAPI(meta interface{}, instanceID, endpointType string) (*kp.Client, error) {
+	kpAPI, err := meta.(ClientSession).keyManagementAPI()
+	if err != nil {
+		return nil, err
+	}
+	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
+	if err != nil {
+		return nil, err
+	}
+	instanceData, err := rContollerClient.ResourceServiceInstanceV2().GetInstance(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	crnData := strings.Split(instanceData.Crn.String(), ":")
+
+	if crnData[4] == "hs-crypto" {
+		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
+		if err != nil {
+			return nil, err
+		}
+		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
+		if err != nil {
+			return nil, err
+		}
+		hpcsEndpointURL := "https://" + resp.Kms.Public + "/api/v2/keys"
+		if endpointType == "private" {
+			hpcsEndpointURL = "https://" + resp.Kms.Private + "/api/v2/keys"
+		}
+		u, err := url.Parse(hpcsEndpointURL)
+		if err != nil {
+			return nil, fmt.Errorf("Error Parsing hpcs EndpointURL")
+		}
+		kpAPI.URL = u
+	} else if crnData[4] == "kms" {
+		if endpointType == "private" {
+			URL, _ := updatePrivateURL(kpAPI.Config.BaseURL)
+			u, err := url.Parse(URL)
+			if err != nil {
+				return nil, fmt.Errorf("Error Parsing kms EndpointURL")
+			}
+			kpAPI.URL = u
+		}
+	} else {
+		return nil, fmt.Errorf("Invalid or unsupported service Instance")
+	}
+	kpAPI.Config.InstanceID = instanceID
+	return kpAPI, nil
+}
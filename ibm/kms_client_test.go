@@ -0,0 +1,126 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	kp "github.com/IBM/keyprotect-go-client"
+)
+
+// testKmsKeyProtectAPI configures the keys API of the test server with the keys path, like the ibm_kms_key resource
+// does for HPCS endpoints
+func testKmsKeyProtectAPI(service *core.BaseService) *kp.Client {
+	u, _ := url.Parse(service.Options.URL + "/api/v2/keys")
+	return &kp.Client{URL: u, Config: kp.ClientConfig{InstanceID: "instance", KeyRing: "ring"}}
+}
+
+func TestKmsListKeyVersions(t *testing.T) {
+	var path, instance, keyRing string
+	service := testCoreService(t, func(w http.ResponseWriter, r *http.Request) {
+		path, instance, keyRing = r.URL.Path, r.Header.Get("bluemix-instance"), r.Header.Get("x-kms-key-ring")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"metadata":{"collectionType":"application/vnd.ibm.kms.key_version+json","collectionTotal":2},
+			"resources":[{"id":"v2","creationDate":"2021-09-02T10:00:00Z"},{"id":"v1","creationDate":"2021-09-01T10:00:00Z"}]}`))
+	})
+	client, kpAPI := &kmsKeysV2{Service: service}, testKmsKeyProtectAPI(service)
+
+	versions, err := client.ListKeyVersions(context.Background(), kpAPI, "key")
+	if err != nil {
+		t.Fatalf("ListKeyVersions failed: %s", err)
+	}
+	if path != "/api/v2/keys/key/versions" || instance != "instance" || keyRing != "ring" {
+		t.Errorf("unexpected request %s of instance %q and key ring %q", path, instance, keyRing)
+	}
+	flattened := flattenKmsKeyVersions(versions)
+	if len(flattened) != 2 || flattened[0]["id"] != "v2" || flattened[1]["creation_date"] != "2021-09-01T10:00:00Z" {
+		t.Errorf("unexpected versions %v", flattened)
+	}
+}
+
+func TestKmsRestoreKey(t *testing.T) {
+	var method, path, contentType string
+	body := &kmsRestoreKeyBody{}
+	service := testCoreService(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path, contentType = r.Method, r.URL.Path, r.Header.Get("Content-Type")
+		json.NewDecoder(r.Body).Decode(body)
+		w.Header().Set("Content-Type", "application/vnd.ibm.kms.key+json")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"metadata":{"collectionType":"application/vnd.ibm.kms.key+json","collectionTotal":1},
+			"resources":[{"id":"key","name":"root","state":1,"crn":"crn:v1:bluemix:public:kms:us-south:a/1:instance:key:key"}]}`))
+	})
+	client, kpAPI := &kmsKeysV2{Service: service}, testKmsKeyProtectAPI(service)
+
+	key, err := client.RestoreKey(context.Background(), kpAPI, "key", kmsRestoreKeyMaterial{Payload: "payload", EncryptedNonce: "nonce", IV: "iv"})
+	if err != nil {
+		t.Fatalf("RestoreKey failed: %s", err)
+	}
+	if method != http.MethodPost || path != "/api/v2/keys/key/restore" || contentType != "application/vnd.ibm.kms.key+json" {
+		t.Errorf("unexpected request %s %s with content type %q", method, path, contentType)
+	}
+	if len(body.Resources) != 1 || body.Resources[0] != (kmsRestoreKeyMaterial{Payload: "payload", EncryptedNonce: "nonce", IV: "iv"}) || body.Metadata.CollectionTotal != 1 {
+		t.Errorf("unexpected body %+v", body)
+	}
+	if key.CRN != "crn:v1:bluemix:public:kms:us-south:a/1:instance:key:key" || key.State != kmsKeyStateActive {
+		t.Errorf("unexpected key %+v", key)
+	}
+}
+
+func TestKmsKMIPAdapter(t *testing.T) {
+	var method, path, contentType string
+	body := &kmsKMIPAdapters{}
+	service := testCoreService(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path, contentType = r.Method, r.URL.Path, r.Header.Get("Content-Type")
+		switch r.Method {
+		case http.MethodPost:
+			json.NewDecoder(r.Body).Decode(body)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"metadata":{"collectionType":"application/vnd.ibm.kms.kmip_adapter+json","collectionTotal":1},
+				"resources":[{"id":"adapter","name":"storage","profile":"native_1.0","profile_data":{"crk_id":"key"}}]}`))
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"metadata":{"collectionType":"application/vnd.ibm.kms.error+json","collectionTotal":1},"resources":[{"errorMsg":"Not Found"}]}`))
+		}
+	})
+	client, kpAPI := &kmsKeysV2{Service: service}, testKmsKeyProtectAPI(service)
+
+	adapter, err := client.CreateKMIPAdapter(context.Background(), kpAPI, kmsKMIPAdapter{
+		Name:        "storage",
+		Profile:     kmsKMIPAdapterProfileNative,
+		ProfileData: &kmsKMIPProfileData{CrkID: "key"},
+	})
+	if err != nil {
+		t.Fatalf("CreateKMIPAdapter failed: %s", err)
+	}
+	if method != http.MethodPost || path != "/api/v2/kmip_adapters" || contentType != "application/json" {
+		t.Errorf("unexpected request %s %s with content type %q", method, path, contentType)
+	}
+	if len(body.Resources) != 1 || body.Resources[0].ProfileData.CrkID != "key" || body.Metadata.CollectionType != kmsKMIPAdapterCollectionType {
+		t.Errorf("unexpected body %+v", body)
+	}
+	if adapter.ID != "adapter" || adapter.ProfileData.CrkID != "key" {
+		t.Errorf("unexpected adapter %+v", adapter)
+	}
+
+	_, response, err := client.GetKMIPAdapter(context.Background(), kpAPI, "gone")
+	if err == nil || response == nil || response.StatusCode != http.StatusNotFound {
+		t.Errorf("expected a not found response, got %v", err)
+	}
+
+	if _, err := client.DeleteKMIPClientCert(context.Background(), kpAPI, "adapter", "cert"); err != nil {
+		t.Fatalf("DeleteKMIPClientCert failed: %s", err)
+	}
+	if method != http.MethodDelete || path != "/api/v2/kmip_adapters/adapter/certificates/cert" {
+		t.Errorf("unexpected request %s %s", method, path)
+	}
+}
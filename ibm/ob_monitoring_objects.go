@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceIBMObMonitoringObject returns a resource that manages an object of a Monitoring instance from its JSON
+// definition, the dashboards, the alerts and the notification channels only differ by their API path
+func resourceIBMObMonitoringObject(kind obMonitoringObjectKind, jsonKey, idKey string) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return obMonitoringObjectCreate(context, d, meta, kind, jsonKey, idKey)
+		},
+		ReadContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return obMonitoringObjectRead(context, d, meta, kind, jsonKey, idKey)
+		},
+		UpdateContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return obMonitoringObjectUpdate(context, d, meta, kind, jsonKey, idKey)
+		},
+		DeleteContext: func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return obMonitoringObjectDelete(context, d, meta, kind)
+		},
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID or CRN of the Monitoring instance",
+			},
+			"private_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the API of the Monitoring instance is called through the private endpoint",
+			},
+			jsonKey: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJSONObjectString,
+				DiffSuppressFunc: suppressEquivalentJSONStructure,
+				Description:      fmt.Sprintf("The JSON definition of the %s as accepted by the Monitoring API, without the id and version properties", kind.Name),
+			},
+			idKey: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: fmt.Sprintf("The ID of the %s", kind.Name),
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: fmt.Sprintf("The version of the %s, it is increased by every update", kind.Name),
+			},
+		},
+	}
+}
+
+func expandObMonitoringObject(d *schema.ResourceData, kind obMonitoringObjectKind, jsonKey string) (map[string]interface{}, error) {
+	object := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(d.Get(jsonKey).(string)), &object); err != nil {
+		return nil, fmt.Errorf("Error parsing %s: the %s must be a JSON object: %s", jsonKey, kind.Name, err)
+	}
+	return object, nil
+}
+
+func obMonitoringObjectCreate(context context.Context, d *schema.ResourceData, meta interface{}, kind obMonitoringObjectKind, jsonKey, idKey string) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	obClient, err := obMonitoringAPI(context, meta, instanceID, d.Get("private_endpoint").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	object, err := expandObMonitoringObject(d, kind, jsonKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	created, _, err := obClient.CreateMonitoringObject(context, kind, object)
+	if err != nil {
+		return diag.Errorf("Error creating %s in Monitoring instance %s: %s", kind.Name, instanceID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%v", instanceID, created["id"]))
+
+	return obMonitoringObjectRead(context, d, meta, kind, jsonKey, idKey)
+}
+
+func obMonitoringObjectRead(context context.Context, d *schema.ResourceData, meta interface{}, kind obMonitoringObjectKind, jsonKey, idKey string) diag.Diagnostics {
+	instanceID, objectID, err := obResourceIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	obClient, err := obMonitoringAPI(context, meta, instanceID, d.Get("private_endpoint").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	object, response, err := obClient.GetMonitoringObject(context, kind, objectID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading %s %s of Monitoring instance %s: %s", kind.Name, objectID, instanceID, err)
+	}
+	objectJSON, err := obMonitoringObjectJSON(d.Get(jsonKey).(string), object)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("instance_id", instanceID)
+	d.Set(jsonKey, objectJSON)
+	d.Set(idKey, objectID)
+	if version, ok := object["version"].(json.Number); ok {
+		v, err := version.Int64()
+		if err != nil {
+			return diag.Errorf("Error reading version of %s %s: %s", kind.Name, objectID, err)
+		}
+		d.Set("version", v)
+	}
+
+	return nil
+}
+
+func obMonitoringObjectUpdate(context context.Context, d *schema.ResourceData, meta interface{}, kind obMonitoringObjectKind, jsonKey, idKey string) diag.Diagnostics {
+	instanceID, objectID, err := obResourceIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	obClient, err := obMonitoringAPI(context, meta, instanceID, d.Get("private_endpoint").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange(jsonKey) {
+		object, err := expandObMonitoringObject(d, kind, jsonKey)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		// The object is replaced, the API rejects the update when the version isn't the current one
+		object["id"] = json.Number(objectID)
+		object["version"] = d.Get("version").(int)
+		if _, _, err := obClient.UpdateMonitoringObject(context, kind, objectID, object); err != nil {
+			return diag.Errorf("Error updating %s %s of Monitoring instance %s: %s", kind.Name, objectID, instanceID, err)
+		}
+	}
+
+	return obMonitoringObjectRead(context, d, meta, kind, jsonKey, idKey)
+}
+
+func obMonitoringObjectDelete(context context.Context, d *schema.ResourceData, meta interface{}, kind obMonitoringObjectKind) diag.Diagnostics {
+	instanceID, objectID, err := obResourceIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	obClient, err := obMonitoringAPI(context, meta, instanceID, d.Get("private_endpoint").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := obClient.DeleteMonitoringObject(context, kind, objectID)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.Errorf("Error deleting %s %s of Monitoring instance %s: %s", kind.Name, objectID, instanceID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,273 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/version"
+)
+
+const (
+	obServiceLogging    = "logdna"
+	obServiceMonitoring = "sysdig-monitor"
+
+	obLoggingArchivingPath     = "/v1/config/archiving"
+	obLoggingIngestionKeysPath = "/v1/config/ingestion-keys"
+)
+
+// obMonitoringObjectKind is an object of the Monitoring API, the objects are sent and received wrapped in a
+// property named after their kind
+type obMonitoringObjectKind struct {
+	Path    string
+	Wrapper string
+	Name    string
+}
+
+var (
+	obMonitoringDashboard           = obMonitoringObjectKind{Path: "/api/v3/dashboards", Wrapper: "dashboard", Name: "dashboard"}
+	obMonitoringAlert               = obMonitoringObjectKind{Path: "/api/alerts", Wrapper: "alert", Name: "alert"}
+	obMonitoringNotificationChannel = obMonitoringObjectKind{Path: "/api/notificationChannels", Wrapper: "notificationChannel", Name: "notification channel"}
+)
+
+// obMonitoringReadOnlyKeys are the properties set by the Monitoring API, they are left out of the JSON of the
+// imported objects
+var obMonitoringReadOnlyKeys = []string{"id", "version", "createdOn", "modifiedOn", "customerId", "teamId", "username", "createdOnDate", "modifiedOnDate", "lastUpdatedTimestamp"}
+
+type obLoggingArchiving struct {
+	Integration        string `json:"integration"`
+	Bucket             string `json:"bucket"`
+	Endpoint           string `json:"endpoint"`
+	APIKey             string `json:"apikey,omitempty"`
+	ResourceInstanceID string `json:"resourceinstanceid"`
+}
+
+type obLoggingIngestionKey struct {
+	ID      string `json:"id,omitempty"`
+	Key     string `json:"key,omitempty"`
+	Created int64  `json:"created,omitempty"`
+}
+
+// obClient calls the configuration APIs of a Log Analysis or a Monitoring instance, the platform services SDK has
+// no client for them
+type obClient struct {
+	Service *core.BaseService
+}
+
+func newObClient(serviceURL string, authenticator core.Authenticator, headers gohttp.Header) (*obClient, error) {
+	service, err := core.NewBaseService(&core.ServiceOptions{
+		URL:           serviceURL,
+		Authenticator: authenticator,
+	})
+	if err != nil {
+		return nil, err
+	}
+	headers.Set("X-Original-User-Agent", fmt.Sprintf("terraform-provider-ibm/%s", version.Version))
+	service.SetDefaultHeaders(headers)
+	return &obClient{Service: service}, nil
+}
+
+// obInstance returns the resource instance of a Log Analysis or Monitoring instance and checks its service
+func obInstance(ctx context.Context, meta interface{}, instanceID, service string) (*rc.ResourceInstance, error) {
+	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+	instance, _, err := rsConClient.GetResourceInstanceWithContext(ctx, &rc.GetResourceInstanceOptions{
+		ID: core.StringPtr(instanceID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving instance %s: %s", instanceID, err)
+	}
+	if instance.CRN == nil || instance.GUID == nil || instance.RegionID == nil {
+		return nil, fmt.Errorf("Instance %s has no CRN, GUID or region", instanceID)
+	}
+	if crnService := obInstanceService(*instance.CRN); crnService != service {
+		return nil, fmt.Errorf("Instance %s is a %s instance, expected a %s instance", instanceID, crnService, service)
+	}
+	return instance, nil
+}
+
+// obInstanceService returns the service name segment of the CRN of an instance
+func obInstanceService(crn string) string {
+	segments := strings.Split(crn, ":")
+	if len(segments) < 5 {
+		return ""
+	}
+	return segments[4]
+}
+
+// obResourceIDParts returns the instance and the object of an ID built as <instance_id>/<object_id>, the CRN of
+// the instance has a slash so the object ID is after the last one
+func obResourceIDParts(id string) (string, string, error) {
+	i := strings.LastIndex(id, "/")
+	if i <= 0 || i == len(id)-1 {
+		return "", "", fmt.Errorf("Incorrect ID %s: Id should be a combination of instanceID/objectID", id)
+	}
+	return id[:i], id[i+1:], nil
+}
+
+func obEndpoint(service, region string, privateEndpoint bool) string {
+	switch service {
+	case obServiceLogging:
+		if privateEndpoint {
+			return envFallBack([]string{"IBMCLOUD_LOGGING_API_ENDPOINT"}, fmt.Sprintf("https://api.private.%s.logging.cloud.ibm.com", region))
+		}
+		return envFallBack([]string{"IBMCLOUD_LOGGING_API_ENDPOINT"}, fmt.Sprintf("https://api.%s.logging.cloud.ibm.com", region))
+	default:
+		if privateEndpoint {
+			return envFallBack([]string{"IBMCLOUD_MONITORING_API_ENDPOINT"}, fmt.Sprintf("https://private.%s.monitoring.cloud.ibm.com", region))
+		}
+		return envFallBack([]string{"IBMCLOUD_MONITORING_API_ENDPOINT"}, fmt.Sprintf("https://%s.monitoring.cloud.ibm.com", region))
+	}
+}
+
+// obLoggingAPI returns the client of the configuration API of a Log Analysis instance, the API authenticates
+// with a service key of the instance
+func obLoggingAPI(ctx context.Context, meta interface{}, instanceID, serviceKey string, privateEndpoint bool) (*obClient, error) {
+	instance, err := obInstance(ctx, meta, instanceID, obServiceLogging)
+	if err != nil {
+		return nil, err
+	}
+	return newObClient(obEndpoint(obServiceLogging, *instance.RegionID, privateEndpoint), &core.NoAuthAuthenticator{}, gohttp.Header{
+		"servicekey": {serviceKey},
+	})
+}
+
+// obMonitoringAPI returns the client of the API of a Monitoring instance, the API authenticates with the IAM
+// credentials of the provider and the GUID of the instance
+func obMonitoringAPI(ctx context.Context, meta interface{}, instanceID string, privateEndpoint bool) (*obClient, error) {
+	instance, err := obInstance(ctx, meta, instanceID, obServiceMonitoring)
+	if err != nil {
+		return nil, err
+	}
+	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+	return newObClient(obEndpoint(obServiceMonitoring, *instance.RegionID, privateEndpoint), rsConClient.Service.Options.Authenticator, gohttp.Header{
+		"IBMInstanceID": {*instance.GUID},
+	})
+}
+
+// request sends a request to the API, the JSON response is decoded with numbers kept as json.Number so that the
+// IDs of the Monitoring objects are not turned into floats
+func (ob *obClient) request(ctx context.Context, method, path string, body, result interface{}) (*core.DetailedResponse, error) {
+	return sendCoreRequest(ctx, ob.Service, coreRequest{Method: method, Path: path, Body: body, UseNumber: true}, result)
+}
+
+func (ob *obClient) GetArchiving(ctx context.Context) (*obLoggingArchiving, *core.DetailedResponse, error) {
+	archiving := &obLoggingArchiving{}
+	response, err := ob.request(ctx, core.GET, obLoggingArchivingPath, nil, archiving)
+	if err != nil {
+		return nil, response, err
+	}
+	return archiving, response, nil
+}
+
+func (ob *obClient) CreateArchiving(ctx context.Context, archiving obLoggingArchiving) (*core.DetailedResponse, error) {
+	return ob.request(ctx, core.POST, obLoggingArchivingPath, archiving, nil)
+}
+
+func (ob *obClient) UpdateArchiving(ctx context.Context, archiving obLoggingArchiving) (*core.DetailedResponse, error) {
+	return ob.request(ctx, core.PUT, obLoggingArchivingPath, archiving, nil)
+}
+
+func (ob *obClient) DeleteArchiving(ctx context.Context) (*core.DetailedResponse, error) {
+	return ob.request(ctx, core.DELETE, obLoggingArchivingPath, nil, nil)
+}
+
+func (ob *obClient) CreateIngestionKey(ctx context.Context) (*obLoggingIngestionKey, *core.DetailedResponse, error) {
+	key := &obLoggingIngestionKey{}
+	response, err := ob.request(ctx, core.POST, obLoggingIngestionKeysPath, map[string]interface{}{}, key)
+	if err != nil {
+		return nil, response, err
+	}
+	return key, response, nil
+}
+
+func (ob *obClient) GetIngestionKey(ctx context.Context, id string) (*obLoggingIngestionKey, *core.DetailedResponse, error) {
+	key := &obLoggingIngestionKey{}
+	response, err := ob.request(ctx, core.GET, obLoggingIngestionKeysPath+"/"+url.PathEscape(id), nil, key)
+	if err != nil {
+		return nil, response, err
+	}
+	return key, response, nil
+}
+
+func (ob *obClient) DeleteIngestionKey(ctx context.Context, id string) (*core.DetailedResponse, error) {
+	return ob.request(ctx, core.DELETE, obLoggingIngestionKeysPath+"/"+url.PathEscape(id), nil, nil)
+}
+
+func (ob *obClient) monitoringObject(ctx context.Context, method, path string, kind obMonitoringObjectKind, object map[string]interface{}) (map[string]interface{}, *core.DetailedResponse, error) {
+	var body interface{}
+	if object != nil {
+		body = map[string]interface{}{kind.Wrapper: object}
+	}
+	result := map[string]interface{}{}
+	response, err := ob.request(ctx, method, path, body, &result)
+	if err != nil {
+		return nil, response, err
+	}
+	wrapped, ok := result[kind.Wrapper].(map[string]interface{})
+	if !ok {
+		return nil, response, fmt.Errorf("The response has no %s", kind.Name)
+	}
+	return wrapped, response, nil
+}
+
+func (ob *obClient) CreateMonitoringObject(ctx context.Context, kind obMonitoringObjectKind, object map[string]interface{}) (map[string]interface{}, *core.DetailedResponse, error) {
+	return ob.monitoringObject(ctx, core.POST, kind.Path, kind, object)
+}
+
+func (ob *obClient) GetMonitoringObject(ctx context.Context, kind obMonitoringObjectKind, id string) (map[string]interface{}, *core.DetailedResponse, error) {
+	return ob.monitoringObject(ctx, core.GET, kind.Path+"/"+url.PathEscape(id), kind, nil)
+}
+
+// UpdateMonitoringObject replaces the object, the object must have the ID and the current version of the object
+func (ob *obClient) UpdateMonitoringObject(ctx context.Context, kind obMonitoringObjectKind, id string, object map[string]interface{}) (map[string]interface{}, *core.DetailedResponse, error) {
+	return ob.monitoringObject(ctx, core.PUT, kind.Path+"/"+url.PathEscape(id), kind, object)
+}
+
+func (ob *obClient) DeleteMonitoringObject(ctx context.Context, kind obMonitoringObjectKind, id string) (*core.DetailedResponse, error) {
+	return ob.request(ctx, core.DELETE, kind.Path+"/"+url.PathEscape(id), nil, nil)
+}
+
+// obMonitoringObjectJSON returns the JSON of an object read from the Monitoring API with only the top level
+// properties of the configured JSON, the API adds defaults that would otherwise show as a change. The imported
+// objects have no configured JSON, all the properties but the read only ones are kept.
+func obMonitoringObjectJSON(configured string, object map[string]interface{}) (string, error) {
+	projected := map[string]interface{}{}
+	if configured != "" {
+		keys := map[string]interface{}{}
+		if err := json.Unmarshal([]byte(configured), &keys); err != nil {
+			return "", err
+		}
+		for key := range keys {
+			if value, ok := object[key]; ok {
+				projected[key] = value
+			}
+		}
+	} else {
+		for key, value := range object {
+			projected[key] = value
+		}
+		for _, key := range obMonitoringReadOnlyKeys {
+			delete(projected, key)
+		}
+	}
+	out, err := json.Marshal(projected)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestObMonitoringObjectWrapper(t *testing.T) {
+	var method, path, instanceID string
+	body := map[string]map[string]interface{}{}
+	service := testCoreService(t, func(w http.ResponseWriter, r *http.Request) {
+		method, path, instanceID = r.Method, r.URL.Path, r.Header.Get("IBMInstanceID")
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"alert":{"id":1234567890123,"version":3,"name":"cpu","enabled":true}}`))
+	})
+	service.SetDefaultHeaders(http.Header{"IBMInstanceID": {"guid"}})
+	client := &obClient{Service: service}
+
+	object := map[string]interface{}{"id": json.Number("1234567890123"), "version": 2, "name": "cpu"}
+	updated, _, err := client.UpdateMonitoringObject(context.Background(), obMonitoringAlert, "1234567890123", object)
+	if err != nil {
+		t.Fatalf("UpdateMonitoringObject failed: %s", err)
+	}
+	if method != http.MethodPut || path != "/api/alerts/1234567890123" || instanceID != "guid" {
+		t.Errorf("unexpected request %s %s for instance %q", method, path, instanceID)
+	}
+	if body["alert"]["name"] != "cpu" || body["alert"]["version"] != float64(2) {
+		t.Errorf("unexpected body %v", body)
+	}
+	if updated["id"] != json.Number("1234567890123") || updated["version"] != json.Number("3") {
+		t.Errorf("unexpected object %v", updated)
+	}
+
+	if _, _, err := client.GetMonitoringObject(context.Background(), obMonitoringDashboard, "1"); err == nil {
+		t.Errorf("expected an error for a response without dashboard")
+	}
+}
+
+func TestObLoggingServiceKey(t *testing.T) {
+	var path, serviceKey string
+	service := testCoreService(t, func(w http.ResponseWriter, r *http.Request) {
+		path, serviceKey = r.URL.Path, r.Header.Get("servicekey")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"k1","key":"ingestion","created":1634644800}`))
+	})
+	service.SetDefaultHeaders(http.Header{"servicekey": {"my-service-key"}})
+	client := &obClient{Service: service}
+
+	key, _, err := client.GetIngestionKey(context.Background(), "k1")
+	if err != nil {
+		t.Fatalf("GetIngestionKey failed: %s", err)
+	}
+	if path != "/v1/config/ingestion-keys/k1" || serviceKey != "my-service-key" {
+		t.Errorf("unexpected request %s with service key %q", path, serviceKey)
+	}
+	if key.Key != "ingestion" || key.Created != 1634644800 {
+		t.Errorf("unexpected key %+v", key)
+	}
+}
+
+func TestObMonitoringObjectJSON(t *testing.T) {
+	object := map[string]interface{}{
+		"id":         json.Number("12"),
+		"version":    json.Number("4"),
+		"name":       "cpu",
+		"severity":   json.Number("4"),
+		"createdOn":  json.Number("1634644800000"),
+		"teamId":     json.Number("7"),
+		"notifyOnce": false,
+	}
+
+	configured, err := obMonitoringObjectJSON(`{"name": "memory", "severity": 2}`, object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if configured != `{"name":"cpu","severity":4}` {
+		t.Errorf("unexpected JSON of the configured properties %s", configured)
+	}
+
+	imported, err := obMonitoringObjectJSON("", object)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported != `{"name":"cpu","notifyOnce":false,"severity":4}` {
+		t.Errorf("unexpected JSON of the imported object %s", imported)
+	}
+}
+
+func TestObMonitoringObjectJSONValidation(t *testing.T) {
+	if _, errs := validateJSONObjectString(`{"name": "cpu"}`, "alert_json"); len(errs) != 0 {
+		t.Errorf("unexpected errors for a JSON object: %v", errs)
+	}
+	for _, invalid := range []string{`[{"name": "cpu"}]`, `"cpu"`, `{"name": `} {
+		if _, errs := validateJSONObjectString(invalid, "alert_json"); len(errs) == 0 {
+			t.Errorf("expected an error for %s", invalid)
+		}
+	}
+}
+
+func TestObResourceIDParts(t *testing.T) {
+	instanceID, objectID, err := obResourceIDParts("crn:v1:bluemix:public:sysdig-monitor:us-south:a/1234:5678::/42")
+	if err != nil || instanceID != "crn:v1:bluemix:public:sysdig-monitor:us-south:a/1234:5678::" || objectID != "42" {
+		t.Errorf("unexpected parts %q %q %v", instanceID, objectID, err)
+	}
+	if _, _, err := obResourceIDParts("5678"); err == nil {
+		t.Errorf("expected an error for an ID without object")
+	}
+}
@@ -245,11 +245,14 @@ func Provider() *schema.Provider {
 			"ibm_container_vpc_cluster_alb":          dataSourceIBMContainerVPCClusterALB(),
 			"ibm_container_vpc_alb":                  dataSourceIBMContainerVPCClusterALB(),
 			"ibm_container_vpc_cluster":              dataSourceIBMContainerVPCCluster(),
+			"ibm_container_openshift_endpoints":      dataSourceIBMContainerOpenshiftEndpoints(),
 			"ibm_container_vpc_cluster_worker":       dataSourceIBMContainerVPCClusterWorker(),
 			"ibm_container_vpc_cluster_worker_pool":  dataSourceIBMContainerVpcClusterWorkerPool(),
 			"ibm_container_vpc_worker_pool":          dataSourceIBMContainerVpcClusterWorkerPool(),
 			"ibm_container_worker_pool":              dataSourceIBMContainerWorkerPool(),
 			"ibm_cr_namespaces":                      dataIBMContainerRegistryNamespaces(),
+			"ibm_cr_images":                          dataIBMContainerRegistryImages(),
+			"ibm_cr_trash":                           dataIBMContainerRegistryTrash(),
 			"ibm_cloud_shell_account_settings":       dataSourceIBMCloudShellAccountSettings(),
 			"ibm_cos_bucket":                         dataSourceIBMCosBucket(),
 			"ibm_cos_bucket_object":                  dataSourceIBMCosBucketObject(),
@@ -268,6 +271,12 @@ func Provider() *schema.Provider {
 			"ibm_iam_user_profile":                   dataSourceIBMIAMUserProfile(),
 			"ibm_iam_service_id":                     dataSourceIBMIAMServiceID(),
 			"ibm_iam_service_policy":                 dataSourceIBMIAMServicePolicy(),
+			"ibm_iam_trusted_profile":                dataSourceIBMIAMTrustedProfile(),
+			"ibm_iam_trusted_profile_claim_rule":     dataSourceIBMIAMTrustedProfileClaimRule(),
+			"ibm_iam_trusted_profile_link":           dataSourceIBMIAMTrustedProfileLink(),
+			"ibm_iam_trusted_profile_policy":         dataSourceIBMIAMTrustedProfilePolicy(),
+			"ibm_cbr_zone":                           dataSourceIBMCbrZone(),
+			"ibm_cbr_rule":                           dataSourceIBMCbrRule(),
 			"ibm_iam_api_key":                        dataSourceIbmIamApiKey(),
 			"ibm_is_dedicated_host":                  dataSourceIbmIsDedicatedHost(),
 			"ibm_is_dedicated_hosts":                 dataSourceIbmIsDedicatedHosts(),
@@ -346,6 +355,7 @@ func Provider() *schema.Provider {
 			"ibm_kp_key":                             dataSourceIBMkey(),
 			"ibm_kms_key_rings":                      dataSourceIBMKMSkeyRings(),
 			"ibm_kms_key_policies":                   dataSourceIBMKMSkeyPolicies(),
+			"ibm_kms_key_registrations":              dataSourceIBMKMSkeyRegistrations(),
 			"ibm_kms_keys":                           dataSourceIBMKMSkeys(),
 			"ibm_pn_application_chrome":              dataSourceIBMPNApplicationChrome(),
 			"ibm_app_config_environment":             dataSourceIbmAppConfigEnvironment(),
@@ -357,6 +367,8 @@ func Provider() *schema.Provider {
 			"ibm_resource_group":                     dataSourceIBMResourceGroup(),
 			"ibm_resource_instance":                  dataSourceIBMResourceInstance(),
 			"ibm_resource_key":                       dataSourceIBMResourceKey(),
+			"ibm_resource_reclamation":               dataSourceIBMResourceReclamation(),
+			"ibm_resource_reclamations":              dataSourceIBMResourceReclamations(),
 			"ibm_security_group":                     dataSourceIBMSecurityGroup(),
 			"ibm_service_instance":                   dataSourceIBMServiceInstance(),
 			"ibm_service_key":                        dataSourceIBMServiceKey(),
@@ -442,7 +454,8 @@ func Provider() *schema.Provider {
 			"ibm_cm_offering_instance": dataSourceIBMCmOfferingInstance(),
 
 			//Added for Resource Tag
-			"ibm_resource_tag": dataSourceIBMResourceTag(),
+			"ibm_resource_tag":     dataSourceIBMResourceTag(),
+			"ibm_resources_search": dataSourceIBMResourcesSearch(),
 
 			// Atracker
 			"ibm_atracker_targets":   dataSourceIBMAtrackerTargets(),
@@ -495,6 +508,7 @@ func Provider() *schema.Provider {
 			"ibm_cis":                                            resourceIBMCISInstance(),
 			"ibm_database":                                       resourceIBMDatabaseInstance(),
 			"ibm_certificate_manager_import":                     resourceIBMCertificateManagerImport(),
+			"ibm_certificate_manager_notification_channel":       resourceIBMCertificateManagerNotificationChannel(),
 			"ibm_certificate_manager_order":                      resourceIBMCertificateManagerOrder(),
 			"ibm_cis_domain":                                     resourceIBMCISDomain(),
 			"ibm_cis_domain_settings":                            resourceIBMCISSettings(),
@@ -540,20 +554,40 @@ func Provider() *schema.Provider {
 			"ibm_container_alb_cert":                             resourceIBMContainerALBCert(),
 			"ibm_container_cluster":                              resourceIBMContainerCluster(),
 			"ibm_container_cluster_feature":                      resourceIBMContainerClusterFeature(),
+			"ibm_container_cluster_kms":                          resourceIBMContainerClusterKms(),
+			"ibm_container_audit_webhook":                        resourceIBMContainerAuditWebhook(),
+			"ibm_container_private_endpoint_allowlist":           resourceIBMContainerPrivateEndpointAllowlist(),
+			"ibm_container_public_endpoint_allowlist":            resourceIBMContainerPublicEndpointAllowlist(),
+			"ibm_container_pull_secret_sync":                     resourceIBMContainerPullSecretSync(),
 			"ibm_container_bind_service":                         resourceIBMContainerBindService(),
 			"ibm_container_worker_pool":                          resourceIBMContainerWorkerPool(),
 			"ibm_container_worker_pool_zone_attachment":          resourceIBMContainerWorkerPoolZoneAttachment(),
 			"ibm_cr_namespace":                                   resourceIBMCrNamespace(),
 			"ibm_cr_retention_policy":                            resourceIBMCrRetentionPolicy(),
+			"ibm_cr_settings":                                    resourceIBMCrSettings(),
+			"ibm_cr_image_tag":                                   resourceIBMCrImageTag(),
+			"ibm_cr_deleted_image":                               resourceIBMCrDeletedImage(),
 			"ibm_ob_logging":                                     resourceIBMObLogging(),
+			"ibm_ob_logging_archive":                             resourceIBMObLoggingArchive(),
+			"ibm_ob_logging_ingestion_key":                       resourceIBMObLoggingIngestionKey(),
 			"ibm_ob_monitoring":                                  resourceIBMObMonitoring(),
+			"ibm_ob_monitoring_alert":                            resourceIBMObMonitoringAlert(),
+			"ibm_ob_monitoring_dashboard":                        resourceIBMObMonitoringDashboard(),
+			"ibm_ob_monitoring_notification_channel":             resourceIBMObMonitoringNotificationChannel(),
+			"ibm_ob_platform_service":                            resourceIBMObPlatformService(),
 			"ibm_cos_bucket":                                     resourceIBMCOSBucket(),
 			"ibm_cos_bucket_object":                              resourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_replication_rule":                    resourceIBMCOSBucketReplicationRule(),
+			"ibm_cos_bucket_sync":                                resourceIBMCOSBucketSync(),
+			"ibm_cos_bucket_website_configuration":               resourceIBMCOSBucketWebsiteConfiguration(),
 			"ibm_dns_domain":                                     resourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":            resourceIBMDNSDomainRegistrationNameservers(),
 			"ibm_dns_secondary":                                  resourceIBMDNSSecondary(),
 			"ibm_dns_record":                                     resourceIBMDNSRecord(),
 			"ibm_event_streams_topic":                            resourceIBMEventStreamsTopic(),
+			"ibm_event_streams_mirroring_config":                 resourceIBMEventStreamsMirroringConfig(),
+			"ibm_event_streams_quota":                            resourceIBMEventStreamsQuota(),
+			"ibm_event_streams_schema":                           resourceIBMEventStreamsSchema(),
 			"ibm_firewall":                                       resourceIBMFirewall(),
 			"ibm_firewall_policy":                                resourceIBMFirewallPolicy(),
 			"ibm_hpcs":                                           resourceIBMHPCS(),
@@ -563,6 +597,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_access_group_dynamic_rule":                  resourceIBMIAMDynamicRule(),
 			"ibm_iam_access_group_members":                       resourceIBMIAMAccessGroupMembers(),
 			"ibm_iam_access_group_policy":                        resourceIBMIAMAccessGroupPolicy(),
+			"ibm_iam_access_group_policies":                      resourceIBMIAMAccessGroupPolicies(),
 			"ibm_iam_authorization_policy":                       resourceIBMIAMAuthorizationPolicy(),
 			"ibm_iam_authorization_policy_detach":                resourceIBMIAMAuthorizationPolicyDetach(),
 			"ibm_iam_user_policy":                                resourceIBMIAMUserPolicy(),
@@ -570,6 +605,13 @@ func Provider() *schema.Provider {
 			"ibm_iam_service_id":                                 resourceIBMIAMServiceID(),
 			"ibm_iam_service_api_key":                            resourceIBMIAMServiceAPIKey(),
 			"ibm_iam_service_policy":                             resourceIBMIAMServicePolicy(),
+			"ibm_iam_trusted_profile":                            resourceIBMIAMTrustedProfile(),
+			"ibm_iam_trusted_profile_claim_rule":                 resourceIBMIAMTrustedProfileClaimRule(),
+			"ibm_iam_trusted_profile_link":                       resourceIBMIAMTrustedProfileLink(),
+			"ibm_iam_trusted_profile_policy":                     resourceIBMIAMTrustedProfilePolicy(),
+			"ibm_iam_policy_template":                            resourceIBMIAMPolicyTemplate(),
+			"ibm_cbr_zone":                                       resourceIBMCbrZone(),
+			"ibm_cbr_rule":                                       resourceIBMCbrRule(),
 			"ibm_iam_user_invite":                                resourceIBMUserInvite(),
 			"ibm_iam_api_key":                                    resourceIbmIamApiKey(),
 			"ibm_ipsec_vpn":                                      resourceIBMIPSecVPN(),
@@ -645,10 +687,15 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_alias":                                  resourceIBMKmskeyAlias(),
 			"ibm_kms_key_rings":                                  resourceIBMKmskeyRings(),
 			"ibm_kms_key_policies":                               resourceIBMKmskeyPolicies(),
+			"ibm_kms_instance_policies":                          resourceIBMKmsInstancePolicies(),
+			"ibm_kms_kmip_adapter":                               resourceIBMKmsKMIPAdapter(),
+			"ibm_kms_kmip_client_cert":                           resourceIBMKmsKMIPClientCertificate(),
 			"ibm_kp_key":                                         resourceIBMkey(),
 			"ibm_resource_group":                                 resourceIBMResourceGroup(),
 			"ibm_resource_instance":                              resourceIBMResourceInstance(),
 			"ibm_resource_key":                                   resourceIBMResourceKey(),
+			"ibm_resource_alias":                                 resourceIBMResourceAlias(),
+			"ibm_resource_binding":                               resourceIBMResourceBinding(),
 			"ibm_security_group":                                 resourceIBMSecurityGroup(),
 			"ibm_security_group_rule":                            resourceIBMSecurityGroupRule(),
 			"ibm_service_instance":                               resourceIBMServiceInstance(),
@@ -705,9 +752,10 @@ func Provider() *schema.Provider {
 			"ibm_cm_version":           resourceIBMCmVersion(),
 
 			//Added for enterprise
-			"ibm_enterprise":               resourceIbmEnterprise(),
-			"ibm_enterprise_account_group": resourceIbmEnterpriseAccountGroup(),
-			"ibm_enterprise_account":       resourceIbmEnterpriseAccount(),
+			"ibm_enterprise":                resourceIbmEnterprise(),
+			"ibm_enterprise_account_group":  resourceIbmEnterpriseAccountGroup(),
+			"ibm_enterprise_account":        resourceIbmEnterpriseAccount(),
+			"ibm_enterprise_account_import": resourceIbmEnterpriseAccountImport(),
 
 			//Added for Schematics
 			"ibm_schematics_workspace": resourceIBMSchematicsWorkspace(),
@@ -715,19 +763,36 @@ func Provider() *schema.Provider {
 			"ibm_schematics_job":       resourceIBMSchematicsJob(),
 
 			//satellite  resources
-			"ibm_satellite_location":            resourceIBMSatelliteLocation(),
-			"ibm_satellite_host":                resourceIBMSatelliteHost(),
-			"ibm_satellite_cluster":             resourceIBMSatelliteCluster(),
-			"ibm_satellite_cluster_worker_pool": resourceIBMSatelliteClusterWorkerPool(),
-			"ibm_satellite_link":                resourceIbmSatelliteLink(),
-			"ibm_satellite_endpoint":            resourceIbmSatelliteEndpoint(),
+			"ibm_satellite_location":             resourceIBMSatelliteLocation(),
+			"ibm_satellite_host":                 resourceIBMSatelliteHost(),
+			"ibm_satellite_cluster":              resourceIBMSatelliteCluster(),
+			"ibm_satellite_cluster_worker_pool":  resourceIBMSatelliteClusterWorkerPool(),
+			"ibm_satellite_link":                 resourceIbmSatelliteLink(),
+			"ibm_satellite_endpoint":             resourceIbmSatelliteEndpoint(),
+			"ibm_satellite_endpoint_source":      resourceIbmSatelliteEndpointSource(),
+			"ibm_satellite_endpoint_certificate": resourceIbmSatelliteEndpointCertificate(),
+			"ibm_satellite_endpoint_enablement":  resourceIbmSatelliteEndpointEnablement(),
+			"ibm_satellite_link_source":          resourceIbmSatelliteLinkSource(),
+
+			//Added for Secrets Manager
+			"ibm_sm_secret_group":                                     resourceIBMSmSecretGroup(),
+			"ibm_sm_arbitrary_secret":                                 resourceIBMSmArbitrarySecret(),
+			"ibm_sm_username_password_secret":                         resourceIBMSmUsernamePasswordSecret(),
+			"ibm_sm_iam_credentials_secret":                           resourceIBMSmIamCredentialsSecret(),
+			"ibm_sm_imported_certificate":                             resourceIBMSmImportedCertificate(),
+			"ibm_sm_public_certificate":                               resourceIBMSmPublicCertificate(),
+			"ibm_sm_kv_secret":                                        resourceIBMSmKvSecret(),
+			"ibm_sm_iam_credentials_configuration":                    resourceIBMSmIamCredentialsConfiguration(),
+			"ibm_sm_public_certificate_configuration_ca_lets_encrypt": resourceIBMSmPublicCertificateConfigurationCALetsEncrypt(),
+			"ibm_sm_public_certificate_configuration_dns":             resourceIBMSmPublicCertificateConfigurationDNS(),
 
 			//Added for Resource Tag
 			"ibm_resource_tag": resourceIBMResourceTag(),
 
 			// Atracker
-			"ibm_atracker_target": resourceIBMAtrackerTarget(),
-			"ibm_atracker_route":  resourceIBMAtrackerRoute(),
+			"ibm_atracker_target":   resourceIBMAtrackerTarget(),
+			"ibm_atracker_route":    resourceIBMAtrackerRoute(),
+			"ibm_atracker_settings": resourceIBMAtrackerSettings(),
 
 			//Security and Compliance Center
 			"ibm_scc_si_note": resourceIBMSccSiNote(),
@@ -747,6 +812,11 @@ func Validator() ValidatorDict {
 			ResourceValidatorDictionary: map[string]*ResourceValidator{
 				"ibm_iam_account_settings":                resourceIBMIAMAccountSettingsValidator(),
 				"ibm_iam_custom_role":                     resourceIBMIAMCustomRoleValidator(),
+				"ibm_iam_policy_template":                 resourceIBMIAMPolicyTemplateValidator(),
+				"ibm_cbr_zone":                            resourceIBMCbrZoneValidator(),
+				"ibm_cbr_rule":                            resourceIBMCbrRuleValidator(),
+				"ibm_iam_trusted_profile_claim_rule":      resourceIBMIAMTrustedProfileClaimRuleValidator(),
+				"ibm_iam_trusted_profile_link":            resourceIBMIAMTrustedProfileLinkValidator(),
 				"ibm_cis_healthcheck":                     resourceIBMCISHealthCheckValidator(),
 				"ibm_cis_rate_limit":                      resourceIBMCISRateLimitValidator(),
 				"ibm_cis":                                 resourceIBMCISValidator(),
@@ -769,6 +839,7 @@ func Validator() ValidatorDict {
 				"ibm_container_vpc_worker_pool":           resourceContainerVPCWorkerPoolValidator(),
 				"ibm_container_vpc_cluster":               resourceIBMContainerVpcClusterValidator(),
 				"ibm_cr_namespace":                        resourceIBMCrNamespaceValidator(),
+				"ibm_cr_settings":                         resourceIBMCrSettingsValidator(),
 				"ibm_tg_gateway":                          resourceIBMTGValidator(),
 				"ibm_app_config_feature":                  resourceIbmAppConfigFeatureValidator(),
 				"ibm_tg_connection":                       resourceIBMTransitGatewayConnectionValidator(),
@@ -839,6 +910,7 @@ func Validator() ValidatorDict {
 				"ibm_atracker_target":                     resourceIBMAtrackerTargetValidator(),
 				"ibm_atracker_route":                      resourceIBMAtrackerRouteValidator(),
 				"ibm_satellite_endpoint":                  resourceIbmSatelliteEndpointValidator(),
+				"ibm_satellite_link_source":               resourceIbmSatelliteLinkSourceValidator(),
 				"ibm_scc_si_note":                         resourceIBMSccSiNoteValidator(),
 			},
 			DataSourceValidatorDictionary: map[string]*ResourceValidator{
@@ -21,6 +21,9 @@ var cisInstance string
 var cisResourceGroup string
 var cloudShellAccountID string
 var cosCRN string
+var containerClusterName string
+var crImage string
+var satelliteLocationID string
 var ibmid1 string
 var ibmid2 string
 var IAMUser string
@@ -76,6 +79,7 @@ var hpcsInstanceID string
 var secretsManagerInstanceID string
 var secretsManagerSecretType string
 var secretsManagerSecretID string
+var secretsManagerAcmeAccountPrivateKey string
 var hpcsAdmin1 string
 var hpcsToken1 string
 var hpcsAdmin2 string
@@ -558,6 +562,11 @@ func init() {
 		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_SECRET_ID for testing data_source_ibm_secrets_manager_secret_test else tests will fail if this is not set correctly")
 	}
 
+	secretsManagerAcmeAccountPrivateKey = os.Getenv("SECRETS_MANAGER_ACME_ACCOUNT_PRIVATE_KEY")
+	if secretsManagerAcmeAccountPrivateKey == "" {
+		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_ACME_ACCOUNT_PRIVATE_KEY for testing resource_ibm_sm_public_certificate_test else tests will fail if this is not set correctly")
+	}
+
 	tg_cross_network_account_id = os.Getenv("IBM_TG_CROSS_ACCOUNT_ID")
 	if tg_cross_network_account_id == "" {
 		fmt.Println("[INFO] Set the environment variable IBM_TG_CROSS_ACCOUNT_ID for testing ibm_tg_connection resource else  tests will fail if this is not set correctly")
@@ -596,6 +605,21 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable IBM_CLOUD_SHELL_ACCOUNT_ID for ibm-cloud-shell resource or datasource else tests will fail if this is not set correctly")
 	}
 
+	crImage = os.Getenv("IBM_CR_IMAGE")
+	if crImage == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_CR_IMAGE with an existing image reference, for example us.icr.io/namespace/repo:tag, for testing ibm_cr_image_tag and ibm_cr_deleted_image resources else tests will fail if this is not set correctly")
+	}
+
+	containerClusterName = os.Getenv("IBM_CONTAINER_CLUSTER_NAME")
+	if containerClusterName == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_CONTAINER_CLUSTER_NAME with an existing VPC or classic cluster for testing the ibm_container cluster configuration resources else tests will fail if this is not set correctly")
+	}
+
+	satelliteLocationID = os.Getenv("IBM_SATELLITE_LOCATION_ID")
+	if satelliteLocationID == "" {
+		fmt.Println("[INFO] Set the environment variable IBM_SATELLITE_LOCATION_ID with an existing Satellite location for testing the ibm_satellite link resources else tests will fail if this is not set correctly")
+	}
+
 }
 
 var testAccProviders map[string]*schema.Provider
@@ -716,3 +740,24 @@ func testAccPreCheckEncryptedImage(t *testing.T) {
 		t.Fatal("IS_IMAGE_ENCRYPTION_KEY must be set for acceptance tests")
 	}
 }
+
+func testAccPreCheckCrImage(t *testing.T) {
+	testAccPreCheck(t)
+	if crImage == "" {
+		t.Fatal("IBM_CR_IMAGE must be set for acceptance tests")
+	}
+}
+
+func testAccPreCheckContainerCluster(t *testing.T) {
+	testAccPreCheck(t)
+	if containerClusterName == "" {
+		t.Fatal("IBM_CONTAINER_CLUSTER_NAME must be set for acceptance tests")
+	}
+}
+
+func testAccPreCheckSatelliteLocation(t *testing.T) {
+	testAccPreCheck(t)
+	if satelliteLocationID == "" {
+		t.Fatal("IBM_SATELLITE_LOCATION_ID must be set for acceptance tests")
+	}
+}
@@ -11,7 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
-	"github.com/IBM/platform-services-go-sdk/atrackerv1"
+	"github.com/IBM/go-sdk-core/v5/core"
 )
 
 func resourceIBMAtrackerRoute() *schema.Resource {
@@ -31,7 +31,8 @@ func resourceIBMAtrackerRoute() *schema.Resource {
 			},
 			"receive_global_events": &schema.Schema{
 				Type:        schema.TypeBool,
-				Required:    true,
+				Optional:    true,
+				Deprecated:  "use the global location in rules.locations instead",
 				Description: "Indicates whether or not all global events should be forwarded to this region.",
 			},
 			"rules": &schema.Schema{
@@ -46,6 +47,13 @@ func resourceIBMAtrackerRoute() *schema.Resource {
 							Description: "The target ID List. Only 1 target id is supported.",
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
+						"locations": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Computed:    true,
+							Description: "The locations of the events that are routed by the rule, such as a region, global for the global events or * for all the locations.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
@@ -91,28 +99,63 @@ func resourceIBMAtrackerRouteValidator() *ResourceValidator {
 	return &resourceValidator
 }
 
-func resourceIBMAtrackerRouteCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(ClientSession).AtrackerV1()
-	if err != nil {
-		return diag.FromErr(err)
-	}
+// atrackerRouteGlobalLocation is the location of the global events
+const atrackerRouteGlobalLocation = "global"
 
-	createRouteOptions := &atrackerv1.CreateRouteOptions{}
+func atrackerRouteHasGlobalLocation(locations []string) bool {
+	for _, location := range locations {
+		if location == atrackerRouteGlobalLocation || location == "*" {
+			return true
+		}
+	}
+	return false
+}
 
-	createRouteOptions.SetName(d.Get("name").(string))
-	createRouteOptions.SetReceiveGlobalEvents(d.Get("receive_global_events").(bool))
-	var rules []atrackerv1.Rule
+// resourceIBMAtrackerRouteExpand builds the v2 route, the rules without locations keep receiving the events of the
+// region like the v1 routes did and receive_global_events is translated into the global location of every rule
+func resourceIBMAtrackerRouteExpand(d *schema.ResourceData, region string) *atrackerV2Route {
+	route := &atrackerV2Route{
+		Name:  core.StringPtr(d.Get("name").(string)),
+		Rules: []atrackerV2Rule{},
+	}
+	receiveGlobalEvents := d.Get("receive_global_events").(bool)
 	for _, e := range d.Get("rules").([]interface{}) {
 		value := e.(map[string]interface{})
 		rulesItem := resourceIBMAtrackerRouteMapToRule(value)
-		rules = append(rules, rulesItem)
+		if len(rulesItem.Locations) == 0 {
+			rulesItem.Locations = []string{region}
+		}
+		if receiveGlobalEvents && !atrackerRouteHasGlobalLocation(rulesItem.Locations) {
+			rulesItem.Locations = append(rulesItem.Locations, atrackerRouteGlobalLocation)
+		}
+		route.Rules = append(route.Rules, rulesItem)
+	}
+	return route
+}
+
+func resourceIBMAtrackerRouteRegion(meta interface{}) (string, error) {
+	sess, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		return "", err
+	}
+	return sess.Config.Region, nil
+}
+
+func resourceIBMAtrackerRouteCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	createRouteOptions.SetRules(rules)
 
-	route, response, err := atrackerClient.CreateRouteWithContext(context, createRouteOptions)
+	region, err := resourceIBMAtrackerRouteRegion(meta)
 	if err != nil {
-		log.Printf("[DEBUG] CreateRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateRouteWithContext failed %s\n%s", err, response))
+		return diag.FromErr(err)
+	}
+
+	route, response, err := atrackerClient.CreateRoute(context, resourceIBMAtrackerRouteExpand(d, region))
+	if err != nil {
+		log.Printf("[DEBUG] CreateRoute failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateRoute failed %s\n%s", err, response))
 	}
 
 	d.SetId(*route.ID)
@@ -120,46 +163,49 @@ func resourceIBMAtrackerRouteCreate(context context.Context, d *schema.ResourceD
 	return resourceIBMAtrackerRouteRead(context, d, meta)
 }
 
-func resourceIBMAtrackerRouteMapToRule(ruleMap map[string]interface{}) atrackerv1.Rule {
-	rule := atrackerv1.Rule{}
+func resourceIBMAtrackerRouteMapToRule(ruleMap map[string]interface{}) atrackerV2Rule {
+	rule := atrackerV2Rule{}
 
 	targetIds := []string{}
 	for _, targetIdsItem := range ruleMap["target_ids"].([]interface{}) {
 		targetIds = append(targetIds, targetIdsItem.(string))
 	}
 	rule.TargetIds = targetIds
+	if locations, ok := ruleMap["locations"].([]interface{}); ok && len(locations) > 0 {
+		rule.Locations = expandStringList(locations)
+	}
 
 	return rule
 }
 
 func resourceIBMAtrackerRouteRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(ClientSession).AtrackerV1()
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	getRouteOptions := &atrackerv1.GetRouteOptions{}
-
-	getRouteOptions.SetID(d.Id())
-
-	route, response, err := atrackerClient.GetRouteWithContext(context, getRouteOptions)
+	route, response, err := atrackerClient.GetRoute(context, d.Id())
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetRouteWithContext failed %s\n%s", err, response))
+		log.Printf("[DEBUG] GetRoute failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetRoute failed %s\n%s", err, response))
 	}
 
 	if err = d.Set("name", route.Name); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
 	}
-	if err = d.Set("receive_global_events", route.ReceiveGlobalEvents); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting receive_global_events: %s", err))
-	}
 	rules := []map[string]interface{}{}
-	for _, rulesItem := range route.Rules {
+	for i, rulesItem := range route.Rules {
+		// The global location added for receive_global_events is kept out of the locations of the configuration
+		if d.Get("receive_global_events").(bool) {
+			configured := expandStringList(d.Get(fmt.Sprintf("rules.%d.locations", i)).([]interface{}))
+			if !atrackerRouteHasGlobalLocation(configured) {
+				rulesItem.Locations = atrackerRouteWithoutGlobalLocation(rulesItem.Locations)
+			}
+		}
 		rulesItemMap := resourceIBMAtrackerRouteRuleToMap(rulesItem)
 		rules = append(rules, rulesItemMap)
 	}
@@ -172,66 +218,66 @@ func resourceIBMAtrackerRouteRead(context context.Context, d *schema.ResourceDat
 	if err = d.Set("version", intValue(route.Version)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting version: %s", err))
 	}
-	if err = d.Set("created", dateTimeToString(route.Created)); err != nil {
+	if err = d.Set("created", dateTimeToString(route.CreatedAt)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting created: %s", err))
 	}
-	if err = d.Set("updated", dateTimeToString(route.Updated)); err != nil {
+	if err = d.Set("updated", dateTimeToString(route.UpdatedAt)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting updated: %s", err))
 	}
 
 	return nil
 }
 
-func resourceIBMAtrackerRouteRuleToMap(rule atrackerv1.Rule) map[string]interface{} {
+func atrackerRouteWithoutGlobalLocation(locations []string) []string {
+	filtered := []string{}
+	for _, location := range locations {
+		if location != atrackerRouteGlobalLocation {
+			filtered = append(filtered, location)
+		}
+	}
+	return filtered
+}
+
+func resourceIBMAtrackerRouteRuleToMap(rule atrackerV2Rule) map[string]interface{} {
 	ruleMap := map[string]interface{}{}
 
 	ruleMap["target_ids"] = rule.TargetIds
+	ruleMap["locations"] = rule.Locations
 
 	return ruleMap
 }
 
 func resourceIBMAtrackerRouteUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(ClientSession).AtrackerV1()
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	replaceRouteOptions := &atrackerv1.ReplaceRouteOptions{}
-
-	replaceRouteOptions.SetID(d.Id())
-	replaceRouteOptions.SetName(d.Get("name").(string))
-	replaceRouteOptions.SetReceiveGlobalEvents(d.Get("receive_global_events").(bool))
-	var rules []atrackerv1.Rule
-	for _, e := range d.Get("rules").([]interface{}) {
-		value := e.(map[string]interface{})
-		rulesItem := resourceIBMAtrackerRouteMapToRule(value)
-		rules = append(rules, rulesItem)
-	}
-	replaceRouteOptions.SetRules(rules)
-
-	_, response, err := atrackerClient.ReplaceRouteWithContext(context, replaceRouteOptions)
-	if err != nil {
-		log.Printf("[DEBUG] ReplaceRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("ReplaceRouteWithContext failed %s\n%s", err, response))
+	if d.HasChange("name") || d.HasChange("rules") || d.HasChange("receive_global_events") {
+		region, err := resourceIBMAtrackerRouteRegion(meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, response, err := atrackerClient.ReplaceRoute(context, d.Id(), resourceIBMAtrackerRouteExpand(d, region))
+		if err != nil {
+			log.Printf("[DEBUG] ReplaceRoute failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ReplaceRoute failed %s\n%s", err, response))
+		}
 	}
 
 	return resourceIBMAtrackerRouteRead(context, d, meta)
 }
 
 func resourceIBMAtrackerRouteDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(ClientSession).AtrackerV1()
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	deleteRouteOptions := &atrackerv1.DeleteRouteOptions{}
-
-	deleteRouteOptions.SetID(d.Id())
-
-	response, err := atrackerClient.DeleteRouteWithContext(context, deleteRouteOptions)
+	response, err := atrackerClient.DeleteRoute(context, d.Id())
 	if err != nil {
-		log.Printf("[DEBUG] DeleteRouteWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteRouteWithContext failed %s\n%s", err, response))
+		log.Printf("[DEBUG] DeleteRoute failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteRoute failed %s\n%s", err, response))
 	}
 
 	d.SetId("")
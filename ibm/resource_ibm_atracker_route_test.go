@@ -4,22 +4,23 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-
-	"github.com/IBM/platform-services-go-sdk/atrackerv1"
 )
 
 func TestAccIBMAtrackerRouteBasic(t *testing.T) {
-	var conf atrackerv1.Route
+	var conf atrackerV2Route
 	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
-	receiveGlobalEvents := "false"
+	locations := `"us-south"`
 	nameUpdate := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
-	receiveGlobalEventsUpdate := "true"
+	locationsUpdate := `"us-south", "global"`
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
@@ -27,18 +28,20 @@ func TestAccIBMAtrackerRouteBasic(t *testing.T) {
 		CheckDestroy: testAccCheckIBMAtrackerRouteDestroy,
 		Steps: []resource.TestStep{
 			resource.TestStep{
-				Config: testAccCheckIBMAtrackerRouteConfigBasic(name, receiveGlobalEvents),
+				Config: testAccCheckIBMAtrackerRouteConfigBasic(name, locations),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckIBMAtrackerRouteExists("ibm_atracker_route.atracker_route", conf),
 					resource.TestCheckResourceAttr("ibm_atracker_route.atracker_route", "name", name),
-					resource.TestCheckResourceAttr("ibm_atracker_route.atracker_route", "receive_global_events", receiveGlobalEvents),
+					resource.TestCheckResourceAttr("ibm_atracker_route.atracker_route", "rules.0.locations.#", "1"),
+					resource.TestCheckResourceAttr("ibm_atracker_route.atracker_route", "rules.0.locations.0", "us-south"),
 				),
 			},
 			resource.TestStep{
-				Config: testAccCheckIBMAtrackerRouteConfigBasic(nameUpdate, receiveGlobalEventsUpdate),
+				Config: testAccCheckIBMAtrackerRouteConfigBasic(nameUpdate, locationsUpdate),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("ibm_atracker_route.atracker_route", "name", nameUpdate),
-					resource.TestCheckResourceAttr("ibm_atracker_route.atracker_route", "receive_global_events", receiveGlobalEventsUpdate),
+					resource.TestCheckResourceAttr("ibm_atracker_route.atracker_route", "rules.0.locations.#", "2"),
+					resource.TestCheckResourceAttr("ibm_atracker_route.atracker_route", "rules.0.locations.1", "global"),
 				),
 			},
 			resource.TestStep{
@@ -50,7 +53,43 @@ func TestAccIBMAtrackerRouteBasic(t *testing.T) {
 	})
 }
 
-func testAccCheckIBMAtrackerRouteConfigBasic(name string, receiveGlobalEvents string) string {
+func TestAtrackerRouteExpandReceiveGlobalEvents(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceIBMAtrackerRoute().Schema, map[string]interface{}{
+		"name":                  "tf_name_global",
+		"receive_global_events": true,
+		"rules": []interface{}{
+			map[string]interface{}{"target_ids": []interface{}{"target-1"}, "locations": []interface{}{"us-south"}},
+			map[string]interface{}{"target_ids": []interface{}{"target-2"}},
+			map[string]interface{}{"target_ids": []interface{}{"target-3"}, "locations": []interface{}{"*"}},
+		},
+	})
+
+	route := resourceIBMAtrackerRouteExpand(d, "eu-de")
+	expected := [][]string{{"us-south", "global"}, {"eu-de", "global"}, {"*"}}
+	for i, rule := range route.Rules {
+		if !reflect.DeepEqual(rule.Locations, expected[i]) {
+			t.Errorf("rules.%d.locations = %v, expected %v", i, rule.Locations, expected[i])
+		}
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceIBMAtrackerRoute().Schema, map[string]interface{}{
+		"name": "tf_name_regional",
+		"rules": []interface{}{
+			map[string]interface{}{"target_ids": []interface{}{"target-1"}, "locations": []interface{}{"us-south"}},
+			map[string]interface{}{"target_ids": []interface{}{"target-2"}},
+		},
+	})
+
+	route = resourceIBMAtrackerRouteExpand(d, "eu-de")
+	expected = [][]string{{"us-south"}, {"eu-de"}}
+	for i, rule := range route.Rules {
+		if !reflect.DeepEqual(rule.Locations, expected[i]) {
+			t.Errorf("rules.%d.locations = %v, expected %v", i, rule.Locations, expected[i])
+		}
+	}
+}
+
+func testAccCheckIBMAtrackerRouteConfigBasic(name string, locations string) string {
 	return fmt.Sprintf(`
 
 
@@ -67,16 +106,16 @@ func testAccCheckIBMAtrackerRouteConfigBasic(name string, receiveGlobalEvents st
 
 		resource "ibm_atracker_route" "atracker_route" {
 			name = "%s"
-			receive_global_events = %s
 			rules {
 				target_ids = [ ibm_atracker_target.atracker_target.id ]
+				locations = [ %s ]
 			}
 		}
 
-	`, name, receiveGlobalEvents)
+	`, name, locations)
 }
 
-func testAccCheckIBMAtrackerRouteExists(n string, obj atrackerv1.Route) resource.TestCheckFunc {
+func testAccCheckIBMAtrackerRouteExists(n string, obj atrackerV2Route) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -84,16 +123,12 @@ func testAccCheckIBMAtrackerRouteExists(n string, obj atrackerv1.Route) resource
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		atrackerClient, err := testAccProvider.Meta().(ClientSession).AtrackerV1()
+		atrackerClient, err := testAccProvider.Meta().(ClientSession).AtrackerV2()
 		if err != nil {
 			return err
 		}
 
-		getRouteOptions := &atrackerv1.GetRouteOptions{}
-
-		getRouteOptions.SetID(rs.Primary.ID)
-
-		route, _, err := atrackerClient.GetRoute(getRouteOptions)
+		route, _, err := atrackerClient.GetRoute(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -104,7 +139,7 @@ func testAccCheckIBMAtrackerRouteExists(n string, obj atrackerv1.Route) resource
 }
 
 func testAccCheckIBMAtrackerRouteDestroy(s *terraform.State) error {
-	atrackerClient, err := testAccProvider.Meta().(ClientSession).AtrackerV1()
+	atrackerClient, err := testAccProvider.Meta().(ClientSession).AtrackerV2()
 	if err != nil {
 		return err
 	}
@@ -113,12 +148,8 @@ func testAccCheckIBMAtrackerRouteDestroy(s *terraform.State) error {
 			continue
 		}
 
-		getRouteOptions := &atrackerv1.GetRouteOptions{}
-
-		getRouteOptions.SetID(rs.Primary.ID)
-
 		// Try to find the key
-		_, response, err := atrackerClient.GetRoute(getRouteOptions)
+		_, response, err := atrackerClient.GetRoute(context.Background(), rs.Primary.ID)
 
 		if err == nil {
 			return fmt.Errorf("Activity Tracker Route still exists: %s", rs.Primary.ID)
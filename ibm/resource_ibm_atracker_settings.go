@@ -0,0 +1,160 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// The settings are a singleton of the account
+const atrackerSettingsID = "atracker_settings"
+
+func resourceIBMAtrackerSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMAtrackerSettingsUpdate,
+		ReadContext:   resourceIBMAtrackerSettingsRead,
+		UpdateContext: resourceIBMAtrackerSettingsUpdate,
+		DeleteContext: resourceIBMAtrackerSettingsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"metadata_region_primary": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The region where the Activity Tracker metadata, such as the targets and the routes, is stored.",
+			},
+			"metadata_region_backup": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The region where the backup of the Activity Tracker metadata is stored.",
+			},
+			"default_targets": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The IDs of the targets the events are sent to when no route matches them.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"permitted_target_regions": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The regions the targets can be created in, all the regions are permitted when it is empty.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"private_api_endpoint_only": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the Activity Tracker API can only be called through the private endpoint.",
+			},
+			"api_version": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the API of the settings.",
+			},
+			"message": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An optional message about the settings.",
+			},
+		},
+	}
+}
+
+func resourceIBMAtrackerSettingsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	settings := &atrackerV2Settings{
+		DefaultTargets:         expandStringList(d.Get("default_targets").([]interface{})),
+		PermittedTargetRegions: expandStringList(d.Get("permitted_target_regions").([]interface{})),
+		MetadataRegionPrimary:  core.StringPtr(d.Get("metadata_region_primary").(string)),
+		PrivateAPIEndpointOnly: core.BoolPtr(d.Get("private_api_endpoint_only").(bool)),
+	}
+	if backup, ok := d.GetOk("metadata_region_backup"); ok {
+		settings.MetadataRegionBackup = core.StringPtr(backup.(string))
+	}
+
+	_, response, err := atrackerClient.PutSettings(context, settings)
+	if err != nil {
+		log.Printf("[DEBUG] PutSettings failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutSettings failed %s\n%s", err, response))
+	}
+
+	d.SetId(atrackerSettingsID)
+
+	return resourceIBMAtrackerSettingsRead(context, d, meta)
+}
+
+func resourceIBMAtrackerSettingsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	settings, response, err := atrackerClient.GetSettings(context)
+	if err != nil {
+		log.Printf("[DEBUG] GetSettings failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSettings failed %s\n%s", err, response))
+	}
+
+	d.SetId(atrackerSettingsID)
+	if err = d.Set("metadata_region_primary", settings.MetadataRegionPrimary); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting metadata_region_primary: %s", err))
+	}
+	if err = d.Set("metadata_region_backup", settings.MetadataRegionBackup); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting metadata_region_backup: %s", err))
+	}
+	if err = d.Set("default_targets", settings.DefaultTargets); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting default_targets: %s", err))
+	}
+	if err = d.Set("permitted_target_regions", settings.PermittedTargetRegions); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting permitted_target_regions: %s", err))
+	}
+	if err = d.Set("private_api_endpoint_only", settings.PrivateAPIEndpointOnly); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting private_api_endpoint_only: %s", err))
+	}
+	if err = d.Set("api_version", intValue(settings.APIVersion)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting api_version: %s", err))
+	}
+	if err = d.Set("message", settings.Message); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting message: %s", err))
+	}
+
+	return nil
+}
+
+// resourceIBMAtrackerSettingsDelete removes the default targets, the permitted target regions and the private
+// endpoint restriction, the metadata region can't be removed from the account so it is kept
+func resourceIBMAtrackerSettingsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	settings := &atrackerV2Settings{
+		DefaultTargets:         []string{},
+		PermittedTargetRegions: []string{},
+		MetadataRegionPrimary:  core.StringPtr(d.Get("metadata_region_primary").(string)),
+		PrivateAPIEndpointOnly: core.BoolPtr(false),
+	}
+
+	_, response, err := atrackerClient.PutSettings(context, settings)
+	if err != nil {
+		log.Printf("[DEBUG] PutSettings failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutSettings failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
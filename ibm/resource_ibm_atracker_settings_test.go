@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMAtrackerSettingsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMAtrackerSettingsConfigBasic(`"us-south"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_atracker_settings.atracker_settings", "metadata_region_primary", "us-south"),
+					resource.TestCheckResourceAttr("ibm_atracker_settings.atracker_settings", "permitted_target_regions.#", "1"),
+					resource.TestCheckResourceAttr("ibm_atracker_settings.atracker_settings", "default_targets.#", "1"),
+					resource.TestCheckResourceAttr("ibm_atracker_settings.atracker_settings", "private_api_endpoint_only", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMAtrackerSettingsConfigBasic(`"us-south", "us-east"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_atracker_settings.atracker_settings", "permitted_target_regions.#", "2"),
+					resource.TestCheckResourceAttr("ibm_atracker_settings.atracker_settings", "permitted_target_regions.1", "us-east"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_atracker_settings.atracker_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMAtrackerSettingsConfigBasic(permittedTargetRegions string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_atracker_target" "atracker_target" {
+			name = "my-cos-target"
+			target_type = "cloud_object_storage"
+			region = "us-south"
+			cos_endpoint {
+				endpoint = "s3.private.us-east.cloud-object-storage.appdomain.cloud"
+				target_crn = "crn:v1:bluemix:public:cloud-object-storage:global:a/11111111111111111111111111111111:22222222-2222-2222-2222-222222222222::"
+				bucket = "my-atracker-bucket"
+				api_key = "xxxxxxxxxxxxxx"
+			}
+		}
+
+		resource "ibm_atracker_settings" "atracker_settings" {
+			metadata_region_primary = "us-south"
+			permitted_target_regions = [ %s ]
+			default_targets = [ ibm_atracker_target.atracker_target.id ]
+		}
+	`, permittedTargetRegions)
+}
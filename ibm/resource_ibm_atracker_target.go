@@ -9,10 +9,10 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/go-sdk-core/v5/core"
-	"github.com/IBM/platform-services-go-sdk/atrackerv1"
 )
 
 func resourceIBMAtrackerTarget() *schema.Resource {
@@ -23,6 +23,12 @@ func resourceIBMAtrackerTarget() *schema.Resource {
 		DeleteContext: resourceIBMAtrackerTargetDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMAtrackerTargetEndpointCustomizeDiff(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -38,11 +44,11 @@ func resourceIBMAtrackerTarget() *schema.Resource {
 				Description:  "The type of the target.",
 			},
 			"cos_endpoint": &schema.Schema{
-				Type:        schema.TypeList,
-				MinItems:    1,
-				MaxItems:    1,
-				Required:    true,
-				Description: "Property values for a Cloud Object Storage Endpoint.",
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: []string{"cos_endpoint", "logdna_endpoint", "eventstreams_endpoint"},
+				Description:  "Property values for a Cloud Object Storage Endpoint.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"endpoint": &schema.Schema{
@@ -61,15 +67,83 @@ func resourceIBMAtrackerTarget() *schema.Resource {
 							Description: "The bucket name under the Cloud Object Storage instance.",
 						},
 						"api_key": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							Sensitive:        true,
+							Description:      "The IAM API key that has writer access to the Cloud Object Storage instance. This credential is masked in the response. It is not needed when service_to_service_enabled is true.",
+							DiffSuppressFunc: applyOnce,
+						},
+						"service_to_service_enabled": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Determines if IBM Cloud Activity Tracker uses a service to service authorization to write to the Cloud Object Storage bucket instead of the API key.",
+						},
+					},
+				},
+			},
+			"logdna_endpoint": &schema.Schema{
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "Property values for a Logging instance (LogDNA) endpoint.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CRN of the Logging instance.",
+						},
+						"ingestion_key": &schema.Schema{
 							Type:             schema.TypeString,
 							Required:         true,
 							Sensitive:        true,
-							Description:      "The IAM API key that has writer access to the Cloud Object Storage instance. This credential is masked in the response.",
+							Description:      "The ingestion key of the Logging instance. This credential is masked in the response.",
 							DiffSuppressFunc: applyOnce,
 						},
 					},
 				},
 			},
+			"eventstreams_endpoint": &schema.Schema{
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "Property values for an Event Streams endpoint.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_crn": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CRN of the Event Streams instance.",
+						},
+						"brokers": &schema.Schema{
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "The Kafka brokers of the Event Streams instance.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"topic": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The topic the events are written to.",
+						},
+						"api_key": &schema.Schema{
+							Type:             schema.TypeString,
+							Required:         true,
+							Sensitive:        true,
+							Description:      "The IAM API key that has writer access to the Event Streams instance. This credential is masked in the response.",
+							DiffSuppressFunc: applyOnce,
+						},
+					},
+				},
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The region of the target, the target is created in the region of the Activity Tracker API endpoint when it is not set.",
+			},
 			"crn": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -78,11 +152,13 @@ func resourceIBMAtrackerTarget() *schema.Resource {
 			"encrypt_key": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
+				Deprecated:  "The encryption key is not returned by the Activity Tracker API anymore",
 				Description: "The encryption key that is used to encrypt events before Activity Tracker services buffer them on storage. This credential is masked in the response.",
 			},
 			"cos_write_status": &schema.Schema{
 				Type:        schema.TypeList,
 				Computed:    true,
+				Deprecated:  "use write_status instead",
 				Description: "The status of the write attempt with the provided cos_endpoint parameters.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -104,6 +180,30 @@ func resourceIBMAtrackerTarget() *schema.Resource {
 					},
 				},
 			},
+			"write_status": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status of the write attempt to the target with the provided endpoint parameters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status such as failed or success.",
+						},
+						"last_failure": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The timestamp of the failure.",
+						},
+						"reason_for_last_failure": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Detailed description of the cause of the failure.",
+						},
+					},
+				},
+			},
 			"created": &schema.Schema{
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -135,7 +235,7 @@ func resourceIBMAtrackerTargetValidator() *ResourceValidator {
 			ValidateFunctionIdentifier: ValidateAllowedStringValue,
 			Type:                       TypeString,
 			Required:                   true,
-			AllowedValues:              "cloud_object_storage",
+			AllowedValues:              "cloud_object_storage, logdna, event_streams",
 		},
 	)
 
@@ -143,59 +243,139 @@ func resourceIBMAtrackerTargetValidator() *ResourceValidator {
 	return &resourceValidator
 }
 
+// atrackerTargetEndpoints is the endpoint block that each target type is configured with
+var atrackerTargetEndpoints = map[string]string{
+	atrackerTargetTypeCloudObjectStorage: "cos_endpoint",
+	atrackerTargetTypeLogDNA:             "logdna_endpoint",
+	atrackerTargetTypeEventStreams:       "eventstreams_endpoint",
+}
+
+func resourceIBMAtrackerTargetEndpointCustomizeDiff(diff *schema.ResourceDiff) error {
+	targetType := diff.Get("target_type").(string)
+	endpoint, ok := atrackerTargetEndpoints[targetType]
+	if !ok {
+		return nil
+	}
+	if endpoints, ok := diff.GetOk(endpoint); !ok || len(endpoints.([]interface{})) == 0 {
+		return fmt.Errorf("%s must be set for a target of type %s", endpoint, targetType)
+	}
+	return nil
+}
+
 func resourceIBMAtrackerTargetCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(ClientSession).AtrackerV1()
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	createTargetOptions := &atrackerv1.CreateTargetOptions{}
-
-	createTargetOptions.SetName(d.Get("name").(string))
-	createTargetOptions.SetTargetType(d.Get("target_type").(string))
-	cosEndpoint := resourceIBMAtrackerTargetMapToCosEndpoint(d.Get("cos_endpoint.0").(map[string]interface{}))
-	createTargetOptions.SetCosEndpoint(&cosEndpoint)
+	target := resourceIBMAtrackerTargetExpand(d)
+	if region, ok := d.GetOk("region"); ok {
+		target.Region = core.StringPtr(region.(string))
+	}
 
-	target, response, err := atrackerClient.CreateTargetWithContext(context, createTargetOptions)
+	created, response, err := atrackerClient.CreateTarget(context, target)
 	if err != nil {
-		log.Printf("[DEBUG] CreateTargetWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("CreateTargetWithContext failed %s\n%s", err, response))
+		log.Printf("[DEBUG] CreateTarget failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateTarget failed %s\n%s", err, response))
 	}
 
-	d.SetId(*target.ID)
+	d.SetId(*created.ID)
 
-	return resourceIBMAtrackerTargetRead(context, d, meta)
+	return resourceIBMAtrackerTargetValidate(context, d, meta)
 }
 
-func resourceIBMAtrackerTargetMapToCosEndpoint(cosEndpointMap map[string]interface{}) atrackerv1.CosEndpoint {
-	cosEndpoint := atrackerv1.CosEndpoint{}
+func resourceIBMAtrackerTargetExpand(d *schema.ResourceData) *atrackerV2Target {
+	target := &atrackerV2Target{
+		Name:       core.StringPtr(d.Get("name").(string)),
+		TargetType: core.StringPtr(d.Get("target_type").(string)),
+	}
+	if _, ok := d.GetOk("cos_endpoint"); ok {
+		target.CosEndpoint = resourceIBMAtrackerTargetMapToCosEndpoint(d.Get("cos_endpoint.0").(map[string]interface{}))
+	}
+	if _, ok := d.GetOk("logdna_endpoint"); ok {
+		target.LogdnaEndpoint = resourceIBMAtrackerTargetMapToLogdnaEndpoint(d.Get("logdna_endpoint.0").(map[string]interface{}))
+	}
+	if _, ok := d.GetOk("eventstreams_endpoint"); ok {
+		target.EventstreamsEndpoint = resourceIBMAtrackerTargetMapToEventstreamsEndpoint(d.Get("eventstreams_endpoint.0").(map[string]interface{}))
+	}
+	return target
+}
+
+func resourceIBMAtrackerTargetMapToCosEndpoint(cosEndpointMap map[string]interface{}) *atrackerV2CosEndpoint {
+	cosEndpoint := &atrackerV2CosEndpoint{}
 
 	cosEndpoint.Endpoint = core.StringPtr(cosEndpointMap["endpoint"].(string))
 	cosEndpoint.TargetCRN = core.StringPtr(cosEndpointMap["target_crn"].(string))
 	cosEndpoint.Bucket = core.StringPtr(cosEndpointMap["bucket"].(string))
-	cosEndpoint.APIKey = core.StringPtr(cosEndpointMap["api_key"].(string))
+	if apiKey, ok := cosEndpointMap["api_key"].(string); ok && apiKey != "" {
+		cosEndpoint.APIKey = core.StringPtr(apiKey)
+	}
+	cosEndpoint.ServiceToServiceEnabled = core.BoolPtr(cosEndpointMap["service_to_service_enabled"].(bool))
 
 	return cosEndpoint
 }
 
-func resourceIBMAtrackerTargetRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(ClientSession).AtrackerV1()
+func resourceIBMAtrackerTargetMapToLogdnaEndpoint(logdnaEndpointMap map[string]interface{}) *atrackerV2LogdnaEndpoint {
+	logdnaEndpoint := &atrackerV2LogdnaEndpoint{}
+
+	logdnaEndpoint.TargetCRN = core.StringPtr(logdnaEndpointMap["target_crn"].(string))
+	logdnaEndpoint.IngestionKey = core.StringPtr(logdnaEndpointMap["ingestion_key"].(string))
+
+	return logdnaEndpoint
+}
+
+func resourceIBMAtrackerTargetMapToEventstreamsEndpoint(eventstreamsEndpointMap map[string]interface{}) *atrackerV2EventstreamsEndpoint {
+	eventstreamsEndpoint := &atrackerV2EventstreamsEndpoint{}
+
+	eventstreamsEndpoint.TargetCRN = core.StringPtr(eventstreamsEndpointMap["target_crn"].(string))
+	eventstreamsEndpoint.Brokers = expandStringList(eventstreamsEndpointMap["brokers"].([]interface{}))
+	eventstreamsEndpoint.Topic = core.StringPtr(eventstreamsEndpointMap["topic"].(string))
+	eventstreamsEndpoint.APIKey = core.StringPtr(eventstreamsEndpointMap["api_key"].(string))
+
+	return eventstreamsEndpoint
+}
+
+// resourceIBMAtrackerTargetValidate checks the write access to the target so that write_status has the result
+// of the new endpoint parameters, a failed check doesn't fail the apply
+func resourceIBMAtrackerTargetValidate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	getTargetOptions := &atrackerv1.GetTargetOptions{}
+	target, response, err := atrackerClient.ValidateTarget(context, d.Id())
+	if err != nil {
+		log.Printf("[DEBUG] ValidateTarget failed %s\n%s", err, response)
+	} else if target.WriteStatus != nil && target.WriteStatus.Status != nil && *target.WriteStatus.Status != "success" {
+		diags := resourceIBMAtrackerTargetRead(context, d, meta)
+		reason := ""
+		if target.WriteStatus.ReasonForLastFailure != nil {
+			reason = *target.WriteStatus.ReasonForLastFailure
+		}
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Activity Tracker can't write to target %s", d.Id()),
+			Detail:   reason,
+		})
+	}
+
+	return resourceIBMAtrackerTargetRead(context, d, meta)
+}
 
-	getTargetOptions.SetID(d.Id())
+func resourceIBMAtrackerTargetRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	target, response, err := atrackerClient.GetTargetWithContext(context, getTargetOptions)
+	target, response, err := atrackerClient.GetTarget(context, d.Id())
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
 			return nil
 		}
-		log.Printf("[DEBUG] GetTargetWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("GetTargetWithContext failed %s\n%s", err, response))
+		log.Printf("[DEBUG] GetTarget failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetTarget failed %s\n%s", err, response))
 	}
 
 	if err = d.Set("name", target.Name); err != nil {
@@ -204,104 +384,129 @@ func resourceIBMAtrackerTargetRead(context context.Context, d *schema.ResourceDa
 	if err = d.Set("target_type", target.TargetType); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting target_type: %s", err))
 	}
-	cosEndpointMap := resourceIBMAtrackerTargetCosEndpointToMap(*target.CosEndpoint)
-	if err = d.Set("cos_endpoint", []map[string]interface{}{cosEndpointMap}); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting cos_endpoint: %s", err))
+	if target.CosEndpoint != nil {
+		cosEndpointMap := resourceIBMAtrackerTargetCosEndpointToMap(*target.CosEndpoint)
+		if err = d.Set("cos_endpoint", []map[string]interface{}{cosEndpointMap}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting cos_endpoint: %s", err))
+		}
+	}
+	if target.LogdnaEndpoint != nil {
+		logdnaEndpointMap := resourceIBMAtrackerTargetLogdnaEndpointToMap(*target.LogdnaEndpoint)
+		if err = d.Set("logdna_endpoint", []map[string]interface{}{logdnaEndpointMap}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting logdna_endpoint: %s", err))
+		}
+	}
+	if target.EventstreamsEndpoint != nil {
+		eventstreamsEndpointMap := resourceIBMAtrackerTargetEventstreamsEndpointToMap(*target.EventstreamsEndpoint)
+		if err = d.Set("eventstreams_endpoint", []map[string]interface{}{eventstreamsEndpointMap}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting eventstreams_endpoint: %s", err))
+		}
+	}
+	if err = d.Set("region", target.Region); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting region: %s", err))
 	}
 	if err = d.Set("crn", target.CRN); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
 	}
-	if err = d.Set("encrypt_key", target.EncryptKey); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting encrypt_key: %s", err))
-	}
-	if target.CosWriteStatus != nil {
-		cosWriteStatusMap := resourceIBMAtrackerTargetCosWriteStatusToMap(*target.CosWriteStatus)
-		if err = d.Set("cos_write_status", []map[string]interface{}{cosWriteStatusMap}); err != nil {
-			return diag.FromErr(fmt.Errorf("Error setting cos_write_status: %s", err))
+	if target.WriteStatus != nil {
+		writeStatusMap := resourceIBMAtrackerTargetWriteStatusToMap(*target.WriteStatus)
+		if err = d.Set("write_status", []map[string]interface{}{writeStatusMap}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting write_status: %s", err))
+		}
+		if target.CosEndpoint != nil {
+			if err = d.Set("cos_write_status", []map[string]interface{}{writeStatusMap}); err != nil {
+				return diag.FromErr(fmt.Errorf("Error setting cos_write_status: %s", err))
+			}
 		}
 	}
-	if err = d.Set("created", dateTimeToString(target.Created)); err != nil {
+	if err = d.Set("created", dateTimeToString(target.CreatedAt)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting created: %s", err))
 	}
-	if err = d.Set("updated", dateTimeToString(target.Updated)); err != nil {
+	if err = d.Set("updated", dateTimeToString(target.UpdatedAt)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting updated: %s", err))
 	}
 
 	return nil
 }
 
-func resourceIBMAtrackerTargetCosEndpointToMap(cosEndpoint atrackerv1.CosEndpoint) map[string]interface{} {
+func resourceIBMAtrackerTargetCosEndpointToMap(cosEndpoint atrackerV2CosEndpoint) map[string]interface{} {
 	cosEndpointMap := map[string]interface{}{}
 
 	cosEndpointMap["endpoint"] = cosEndpoint.Endpoint
 	cosEndpointMap["target_crn"] = cosEndpoint.TargetCRN
 	cosEndpointMap["bucket"] = cosEndpoint.Bucket
 	cosEndpointMap["api_key"] = cosEndpoint.APIKey
+	if cosEndpoint.ServiceToServiceEnabled != nil {
+		cosEndpointMap["service_to_service_enabled"] = cosEndpoint.ServiceToServiceEnabled
+	}
 
 	return cosEndpointMap
 }
 
-func resourceIBMAtrackerTargetCosWriteStatusToMap(cosWriteStatus atrackerv1.CosWriteStatus) map[string]interface{} {
-	cosWriteStatusMap := map[string]interface{}{}
+func resourceIBMAtrackerTargetLogdnaEndpointToMap(logdnaEndpoint atrackerV2LogdnaEndpoint) map[string]interface{} {
+	logdnaEndpointMap := map[string]interface{}{}
 
-	if cosWriteStatus.Status != nil {
-		cosWriteStatusMap["status"] = cosWriteStatus.Status
+	logdnaEndpointMap["target_crn"] = logdnaEndpoint.TargetCRN
+	logdnaEndpointMap["ingestion_key"] = logdnaEndpoint.IngestionKey
+
+	return logdnaEndpointMap
+}
+
+func resourceIBMAtrackerTargetEventstreamsEndpointToMap(eventstreamsEndpoint atrackerV2EventstreamsEndpoint) map[string]interface{} {
+	eventstreamsEndpointMap := map[string]interface{}{}
+
+	eventstreamsEndpointMap["target_crn"] = eventstreamsEndpoint.TargetCRN
+	eventstreamsEndpointMap["brokers"] = eventstreamsEndpoint.Brokers
+	eventstreamsEndpointMap["topic"] = eventstreamsEndpoint.Topic
+	eventstreamsEndpointMap["api_key"] = eventstreamsEndpoint.APIKey
+
+	return eventstreamsEndpointMap
+}
+
+func resourceIBMAtrackerTargetWriteStatusToMap(writeStatus atrackerV2WriteStatus) map[string]interface{} {
+	writeStatusMap := map[string]interface{}{}
+
+	if writeStatus.Status != nil {
+		writeStatusMap["status"] = writeStatus.Status
 	}
-	if cosWriteStatus.LastFailure != nil {
-		cosWriteStatusMap["last_failure"] = cosWriteStatus.LastFailure.String()
+	if writeStatus.LastFailure != nil {
+		writeStatusMap["last_failure"] = writeStatus.LastFailure.String()
 	}
-	if cosWriteStatus.ReasonForLastFailure != nil {
-		cosWriteStatusMap["reason_for_last_failure"] = cosWriteStatus.ReasonForLastFailure
+	if writeStatus.ReasonForLastFailure != nil {
+		writeStatusMap["reason_for_last_failure"] = writeStatus.ReasonForLastFailure
 	}
 
-	return cosWriteStatusMap
+	return writeStatusMap
 }
 
 func resourceIBMAtrackerTargetUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(ClientSession).AtrackerV1()
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	replaceTargetOptions := &atrackerv1.ReplaceTargetOptions{}
-
-	replaceTargetOptions.SetID(d.Id())
-
-	hasChange := false
-
-	if d.HasChange("name") || d.HasChange("cos_endpoint") || d.HasChange("target_type") {
-		replaceTargetOptions.SetTargetType(d.Get("target_type").(string))
-		replaceTargetOptions.SetName(d.Get("name").(string))
-		cosEndpoint := resourceIBMAtrackerTargetMapToCosEndpoint(d.Get("cos_endpoint.0").(map[string]interface{}))
-		replaceTargetOptions.SetCosEndpoint(&cosEndpoint)
-		hasChange = true
-	}
-
-	if hasChange {
-		_, response, err := atrackerClient.ReplaceTargetWithContext(context, replaceTargetOptions)
+	if d.HasChange("name") || d.HasChange("cos_endpoint") || d.HasChange("logdna_endpoint") || d.HasChange("eventstreams_endpoint") {
+		_, response, err := atrackerClient.UpdateTarget(context, d.Id(), resourceIBMAtrackerTargetExpand(d))
 		if err != nil {
-			log.Printf("[DEBUG] ReplaceTargetWithContext failed %s\n%s", err, response)
-			return diag.FromErr(fmt.Errorf("ReplaceTargetWithContext failed %s\n%s", err, response))
+			log.Printf("[DEBUG] UpdateTarget failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateTarget failed %s\n%s", err, response))
 		}
+		return resourceIBMAtrackerTargetValidate(context, d, meta)
 	}
 
 	return resourceIBMAtrackerTargetRead(context, d, meta)
 }
 
 func resourceIBMAtrackerTargetDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	atrackerClient, err := meta.(ClientSession).AtrackerV1()
+	atrackerClient, err := meta.(ClientSession).AtrackerV2()
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	deleteTargetOptions := &atrackerv1.DeleteTargetOptions{}
-
-	deleteTargetOptions.SetID(d.Id())
-
-	_, response, err := atrackerClient.DeleteTargetWithContext(context, deleteTargetOptions)
+	response, err := atrackerClient.DeleteTarget(context, d.Id())
 	if err != nil {
-		log.Printf("[DEBUG] DeleteTargetWithContext failed %s\n%s", err, response)
-		return diag.FromErr(fmt.Errorf("DeleteTargetWithContext failed %s\n%s", err, response))
+		log.Printf("[DEBUG] DeleteTarget failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteTarget failed %s\n%s", err, response))
 	}
 
 	d.SetId("")
@@ -4,18 +4,18 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-
-	"github.com/IBM/platform-services-go-sdk/atrackerv1"
 )
 
 func TestAccIBMAtrackerTargetBasic(t *testing.T) {
-	var conf atrackerv1.Target
+	var conf atrackerV2Target
 	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
 	targetType := "cloud_object_storage"
 	nameUpdate := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
@@ -31,6 +31,8 @@ func TestAccIBMAtrackerTargetBasic(t *testing.T) {
 					testAccCheckIBMAtrackerTargetExists("ibm_atracker_target.atracker_target", conf),
 					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "name", name),
 					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "target_type", targetType),
+					resource.TestCheckResourceAttrSet("ibm_atracker_target.atracker_target", "region"),
+					resource.TestCheckResourceAttrSet("ibm_atracker_target.atracker_target", "write_status.0.status"),
 				),
 			},
 
@@ -50,6 +52,64 @@ func TestAccIBMAtrackerTargetBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMAtrackerTargetLogdna(t *testing.T) {
+	var conf atrackerV2Target
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMAtrackerTargetDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMAtrackerTargetConfigLogdna(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMAtrackerTargetExists("ibm_atracker_target.atracker_target", conf),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "name", name),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "target_type", "logdna"),
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "region", "us-south"),
+					resource.TestCheckResourceAttrSet("ibm_atracker_target.atracker_target", "write_status.0.status"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMAtrackerTargetConfigLogdna(nameUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_atracker_target.atracker_target", "name", nameUpdate),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMAtrackerTargetMismatchedEndpoint(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckIBMAtrackerTargetConfigBasic("tf_name_mismatch", "logdna"),
+				ExpectError: regexp.MustCompile("logdna_endpoint must be set for a target of type logdna"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMAtrackerTargetConfigLogdna(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_atracker_target" "atracker_target" {
+			name = "%s"
+			target_type = "logdna"
+			region = "us-south"
+			logdna_endpoint {
+				target_crn = "crn:v1:bluemix:public:logdna:us-south:a/11111111111111111111111111111111:22222222-2222-2222-2222-222222222222::"
+				ingestion_key = "xxxxxxxxxxxxxx"
+			}
+		}
+	`, name)
+}
+
 func testAccCheckIBMAtrackerTargetConfigBasic(name string, targetType string) string {
 	return fmt.Sprintf(`
 
@@ -66,7 +126,7 @@ func testAccCheckIBMAtrackerTargetConfigBasic(name string, targetType string) st
 	`, name, targetType)
 }
 
-func testAccCheckIBMAtrackerTargetExists(n string, obj atrackerv1.Target) resource.TestCheckFunc {
+func testAccCheckIBMAtrackerTargetExists(n string, obj atrackerV2Target) resource.TestCheckFunc {
 
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -74,16 +134,12 @@ func testAccCheckIBMAtrackerTargetExists(n string, obj atrackerv1.Target) resour
 			return fmt.Errorf("Not found: %s", n)
 		}
 
-		atrackerClient, err := testAccProvider.Meta().(ClientSession).AtrackerV1()
+		atrackerClient, err := testAccProvider.Meta().(ClientSession).AtrackerV2()
 		if err != nil {
 			return err
 		}
 
-		getTargetOptions := &atrackerv1.GetTargetOptions{}
-
-		getTargetOptions.SetID(rs.Primary.ID)
-
-		target, _, err := atrackerClient.GetTarget(getTargetOptions)
+		target, _, err := atrackerClient.GetTarget(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return err
 		}
@@ -94,7 +150,7 @@ func testAccCheckIBMAtrackerTargetExists(n string, obj atrackerv1.Target) resour
 }
 
 func testAccCheckIBMAtrackerTargetDestroy(s *terraform.State) error {
-	atrackerClient, err := testAccProvider.Meta().(ClientSession).AtrackerV1()
+	atrackerClient, err := testAccProvider.Meta().(ClientSession).AtrackerV2()
 	if err != nil {
 		return err
 	}
@@ -103,12 +159,8 @@ func testAccCheckIBMAtrackerTargetDestroy(s *terraform.State) error {
 			continue
 		}
 
-		getTargetOptions := &atrackerv1.GetTargetOptions{}
-
-		getTargetOptions.SetID(rs.Primary.ID)
-
 		// Try to find the key
-		_, response, err := atrackerClient.GetTarget(getTargetOptions)
+		_, response, err := atrackerClient.GetTarget(context.Background(), rs.Primary.ID)
 
 		if err == nil {
 			return fmt.Errorf("Activity Tracker Target still exists: %s", rs.Primary.ID)
@@ -0,0 +1,372 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMCbrRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCbrRuleCreate,
+		ReadContext:   resourceIBMCbrRuleRead,
+		UpdateContext: resourceIBMCbrRuleUpdate,
+		DeleteContext: resourceIBMCbrRuleDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the rule.",
+			},
+			"contexts": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The contexts this rule applies to, a request is allowed when it matches any of the contexts.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": &schema.Schema{
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "The attributes of the context, a request matches the context when it matches all of its attributes.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: InvokeValidator("ibm_cbr_rule", "context_attribute_name"),
+										Description:  "The attribute name.",
+									},
+									"value": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The attribute value, for example the id of a zone for the networkZoneId attribute.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"resources": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The resources this rule apply to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": &schema.Schema{
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Description: "The resource attributes.",
+							Elem: &schema.Resource{
+								Schema: cbrRuleResourceAttributeSchema(),
+							},
+						},
+						"tags": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The optional resource tags.",
+							Elem: &schema.Resource{
+								Schema: cbrRuleResourceAttributeSchema(),
+							},
+						},
+					},
+				},
+			},
+			"enforcement_mode": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				ValidateFunc: InvokeValidator("ibm_cbr_rule", "enforcement_mode"),
+				Description:  "The rule enforcement mode, 'enabled' enforces the rule, 'report' only reports the requests that the rule would deny, and 'disabled' turns off the rule.",
+			},
+			"crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rule CRN.",
+			},
+			"href": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The href link to the resource.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the resource was created.",
+			},
+			"created_by_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAM ID of the user or service which created the resource.",
+			},
+			"last_modified_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last time the resource was modified.",
+			},
+			"last_modified_by_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAM ID of the user or service which modified the resource.",
+			},
+			"version": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the rule, a change of the rule outside of Terraform makes the next update fail.",
+			},
+		},
+	}
+}
+
+func cbrRuleResourceAttributeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The attribute name, for example accountId, serviceName, serviceInstance or resourceGroupId.",
+		},
+		"value": &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The attribute value.",
+		},
+		"operator": &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The attribute operator.",
+		},
+	}
+}
+
+func resourceIBMCbrRuleValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "context_attribute_name",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "networkZoneId, endpointType",
+		},
+		ValidateSchema{
+			Identifier:                 "enforcement_mode",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "enabled, disabled, report",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_cbr_rule", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func expandCbrRuleAttributes(attributes []interface{}) []cbrRuleAttribute {
+	result := make([]cbrRuleAttribute, 0, len(attributes))
+	for _, a := range attributes {
+		attribute := a.(map[string]interface{})
+		item := cbrRuleAttribute{
+			Name:  core.StringPtr(attribute["name"].(string)),
+			Value: core.StringPtr(attribute["value"].(string)),
+		}
+		if v, ok := attribute["operator"].(string); ok && v != "" {
+			item.Operator = core.StringPtr(v)
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func flattenCbrRuleAttributes(attributes []cbrRuleAttribute, withOperator bool) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(attributes))
+	for _, attribute := range attributes {
+		m := map[string]interface{}{
+			"name":  attribute.Name,
+			"value": attribute.Value,
+		}
+		if withOperator {
+			m["operator"] = attribute.Operator
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func expandCbrRule(d *schema.ResourceData) *cbrRule {
+	rule := &cbrRule{
+		Contexts:        []cbrRuleContext{},
+		Resources:       []cbrRuleResource{},
+		EnforcementMode: core.StringPtr(d.Get("enforcement_mode").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		rule.Description = core.StringPtr(v.(string))
+	}
+	for _, c := range d.Get("contexts").([]interface{}) {
+		ruleContext := c.(map[string]interface{})
+		rule.Contexts = append(rule.Contexts, cbrRuleContext{
+			Attributes: expandCbrRuleAttributes(ruleContext["attributes"].([]interface{})),
+		})
+	}
+	for _, r := range d.Get("resources").([]interface{}) {
+		ruleResource := r.(map[string]interface{})
+		rule.Resources = append(rule.Resources, cbrRuleResource{
+			Attributes: expandCbrRuleAttributes(ruleResource["attributes"].([]interface{})),
+			Tags:       expandCbrRuleAttributes(ruleResource["tags"].([]interface{})),
+		})
+	}
+	return rule
+}
+
+func flattenCbrRuleContexts(contexts []cbrRuleContext) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(contexts))
+	for _, ruleContext := range contexts {
+		result = append(result, map[string]interface{}{
+			"attributes": flattenCbrRuleAttributes(ruleContext.Attributes, false),
+		})
+	}
+	return result
+}
+
+func flattenCbrRuleResources(resources []cbrRuleResource) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(resources))
+	for _, ruleResource := range resources {
+		result = append(result, map[string]interface{}{
+			"attributes": flattenCbrRuleAttributes(ruleResource.Attributes, true),
+			"tags":       flattenCbrRuleAttributes(ruleResource.Tags, true),
+		})
+	}
+	return result
+}
+
+func resourceIBMCbrRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule, response, err := cbrClient.CreateRule(context, expandCbrRule(d))
+	if err != nil {
+		log.Printf("[DEBUG] CreateRule failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateRule failed %s\n%s", err, response))
+	}
+
+	d.SetId(*rule.ID)
+
+	return resourceIBMCbrRuleRead(context, d, meta)
+}
+
+func resourceIBMCbrRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule, response, err := cbrClient.GetRule(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetRule failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetRule failed %s\n%s", err, response))
+	}
+
+	if err = setCbrRuleAttributes(d, rule); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("version", response.Headers.Get("ETag")); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting version: %s", err))
+	}
+
+	return nil
+}
+
+func setCbrRuleAttributes(d *schema.ResourceData, rule *cbrRule) error {
+	if err := d.Set("description", rule.Description); err != nil {
+		return fmt.Errorf("Error setting description: %s", err)
+	}
+	if err := d.Set("contexts", flattenCbrRuleContexts(rule.Contexts)); err != nil {
+		return fmt.Errorf("Error setting contexts: %s", err)
+	}
+	if err := d.Set("resources", flattenCbrRuleResources(rule.Resources)); err != nil {
+		return fmt.Errorf("Error setting resources: %s", err)
+	}
+	if rule.EnforcementMode != nil {
+		if err := d.Set("enforcement_mode", rule.EnforcementMode); err != nil {
+			return fmt.Errorf("Error setting enforcement_mode: %s", err)
+		}
+	}
+	if err := d.Set("crn", rule.CRN); err != nil {
+		return fmt.Errorf("Error setting crn: %s", err)
+	}
+	if err := d.Set("href", rule.Href); err != nil {
+		return fmt.Errorf("Error setting href: %s", err)
+	}
+	if err := d.Set("created_at", rule.CreatedAt); err != nil {
+		return fmt.Errorf("Error setting created_at: %s", err)
+	}
+	if err := d.Set("created_by_id", rule.CreatedByID); err != nil {
+		return fmt.Errorf("Error setting created_by_id: %s", err)
+	}
+	if err := d.Set("last_modified_at", rule.LastModifiedAt); err != nil {
+		return fmt.Errorf("Error setting last_modified_at: %s", err)
+	}
+	if err := d.Set("last_modified_by_id", rule.LastModifiedByID); err != nil {
+		return fmt.Errorf("Error setting last_modified_by_id: %s", err)
+	}
+	return nil
+}
+
+func resourceIBMCbrRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("description") || d.HasChange("contexts") || d.HasChange("resources") || d.HasChange("enforcement_mode") {
+		response, err := cbrClient.ReplaceRule(context, d.Id(), d.Get("version").(string), expandCbrRule(d))
+		if err != nil {
+			if response != nil && response.StatusCode == 412 {
+				return diag.FromErr(fmt.Errorf("Rule %s was changed outside of Terraform since it was last read, refresh the state and apply again", d.Id()))
+			}
+			log.Printf("[DEBUG] ReplaceRule failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ReplaceRule failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMCbrRuleRead(context, d, meta)
+}
+
+func resourceIBMCbrRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := cbrClient.DeleteRule(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteRule failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteRule failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCbrRuleBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-cbr-zone-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_cbr_rule.rule"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCbrRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCbrRuleConfig(name, "report"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enforcement_mode", "report"),
+					resource.TestCheckResourceAttr(resourceName, "contexts.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "contexts.0.attributes.0.value", "ibm_cbr_zone.zone", "id"),
+					resource.TestCheckResourceAttr(resourceName, "resources.0.attributes.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCbrRuleConfig(name, "enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enforcement_mode", "enabled"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCbrRuleDestroy(s *terraform.State) error {
+	cbrClient, err := testAccProvider.Meta().(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cbr_rule" {
+			continue
+		}
+
+		_, response, err := cbrClient.GetRule(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Rule still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for rule (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMCbrRuleConfig(name, enforcementMode string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cbr_zone" "zone" {
+			name = "%s"
+			addresses {
+				type  = "subnet"
+				value = "169.23.56.0/24"
+			}
+		}
+
+		resource "ibm_cbr_rule" "rule" {
+			description      = "Rule created by terraform acceptance tests"
+			enforcement_mode = "%s"
+
+			contexts {
+				attributes {
+					name  = "networkZoneId"
+					value = ibm_cbr_zone.zone.id
+				}
+			}
+
+			resources {
+				attributes {
+					name  = "accountId"
+					value = ibm_cbr_zone.zone.account_id
+				}
+				attributes {
+					name  = "serviceName"
+					value = "kms"
+				}
+			}
+		}
+	`, name, enforcementMode)
+}
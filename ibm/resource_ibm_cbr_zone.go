@@ -0,0 +1,477 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMCbrZone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCbrZoneCreate,
+		ReadContext:   resourceIBMCbrZoneRead,
+		UpdateContext: resourceIBMCbrZoneUpdate,
+		DeleteContext: resourceIBMCbrZoneDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return resourceIBMCbrZoneValidateAddresses(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the zone.",
+			},
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The id of the account owning this zone, defaults to the account of the provider.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the zone.",
+			},
+			"addresses": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The list of addresses in the zone.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: InvokeValidator("ibm_cbr_zone", "type"),
+							Description:  "The type of address.",
+						},
+						"value": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The IP address, IP range, subnet or VPC CRN, depending on the type of the address.",
+						},
+						"ref": &schema.Schema{
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Optional:    true,
+							Description: "A service reference value, only for addresses of type serviceRef.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The id of the account owning the service.",
+									},
+									"service_type": &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The service type.",
+									},
+									"service_name": &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The service name.",
+									},
+									"service_instance": &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The service instance.",
+									},
+									"location": &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The location.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"excluded": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of excluded addresses in the zone. Only addresses of type ipAddress, ipRange, and subnet can be excluded.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: InvokeValidator("ibm_cbr_zone", "excluded_type"),
+							Description:  "The type of address.",
+						},
+						"value": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IP address, IP range or subnet.",
+						},
+					},
+				},
+			},
+			"crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The zone CRN.",
+			},
+			"address_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of addresses in the zone.",
+			},
+			"excluded_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of excluded addresses in the zone.",
+			},
+			"href": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The href link to the resource.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the resource was created.",
+			},
+			"created_by_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAM ID of the user or service which created the resource.",
+			},
+			"last_modified_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last time the resource was modified.",
+			},
+			"last_modified_by_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "IAM ID of the user or service which modified the resource.",
+			},
+			"version": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the zone, a change of the zone outside of Terraform makes the next update fail.",
+			},
+		},
+	}
+}
+
+func resourceIBMCbrZoneValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "ipAddress, ipRange, subnet, vpc, serviceRef",
+		},
+		ValidateSchema{
+			Identifier:                 "excluded_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "ipAddress, ipRange, subnet",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_cbr_zone", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// resourceIBMCbrZoneValidateAddresses checks the value of each address against its type, the schema can't
+// validate a value that depends on a sibling attribute
+func resourceIBMCbrZoneValidateAddresses(diff *schema.ResourceDiff) error {
+	for _, key := range []string{"addresses", "excluded"} {
+		for i, a := range diff.Get(key).([]interface{}) {
+			address, ok := a.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			addressType := address["type"].(string)
+			value := address["value"].(string)
+			hasRef := false
+			if ref, ok := address["ref"].([]interface{}); ok && len(ref) > 0 {
+				hasRef = true
+			}
+			if err := validateCbrAddress(fmt.Sprintf("%s.%d", key, i), addressType, value, hasRef); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateCbrAddress(k, addressType, value string, hasRef bool) error {
+	if addressType == cbrAddressTypeServiceRef {
+		if !hasRef {
+			return fmt.Errorf("%s.ref is required for an address of type %s", k, addressType)
+		}
+		if value != "" {
+			return fmt.Errorf("%s.value can't be set for an address of type %s", k, addressType)
+		}
+		return nil
+	}
+	if hasRef {
+		return fmt.Errorf("%s.ref can only be set for an address of type %s", k, cbrAddressTypeServiceRef)
+	}
+	// An empty value is not known yet at plan time
+	if value == "" {
+		return nil
+	}
+
+	var errs []error
+	switch addressType {
+	case cbrAddressTypeIPAddress:
+		_, errs = validateIP(value, k+".value")
+		if len(errs) > 0 {
+			if _, cidrErrs := validateIPorCIDR()(value, k+".value"); len(cidrErrs) == 0 {
+				return fmt.Errorf("%s.value %s is a subnet, use type %s for it", k, value, cbrAddressTypeSubnet)
+			}
+		}
+	case cbrAddressTypeIPRange:
+		bounds := strings.Split(value, "-")
+		if len(bounds) != 2 {
+			return fmt.Errorf("%s.value %s must be an IP range in the form of <first address>-<last address>", k, value)
+		}
+		for _, bound := range bounds {
+			if _, boundErrs := validateIP(bound, k+".value"); len(boundErrs) > 0 {
+				errs = append(errs, boundErrs...)
+			}
+		}
+	case cbrAddressTypeSubnet:
+		_, errs = validateCIDR(value, k+".value")
+	case cbrAddressTypeVPC:
+		if !strings.HasPrefix(value, "crn:") {
+			return fmt.Errorf("%s.value %s must be the CRN of a VPC", k, value)
+		}
+	}
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func expandCbrZone(d *schema.ResourceData, accountID string) *cbrZone {
+	zone := &cbrZone{
+		Name:      core.StringPtr(d.Get("name").(string)),
+		AccountID: core.StringPtr(accountID),
+		Addresses: []cbrAddress{},
+		Excluded:  []cbrAddress{},
+	}
+	if v, ok := d.GetOk("description"); ok {
+		zone.Description = core.StringPtr(v.(string))
+	}
+	for _, a := range d.Get("addresses").([]interface{}) {
+		zone.Addresses = append(zone.Addresses, expandCbrAddress(a.(map[string]interface{})))
+	}
+	for _, a := range d.Get("excluded").([]interface{}) {
+		zone.Excluded = append(zone.Excluded, expandCbrAddress(a.(map[string]interface{})))
+	}
+	return zone
+}
+
+func expandCbrAddress(address map[string]interface{}) cbrAddress {
+	result := cbrAddress{
+		Type: core.StringPtr(address["type"].(string)),
+	}
+	if v, ok := address["value"].(string); ok && v != "" {
+		result.Value = core.StringPtr(v)
+	}
+	if refs, ok := address["ref"].([]interface{}); ok && len(refs) > 0 && refs[0] != nil {
+		ref := refs[0].(map[string]interface{})
+		result.Ref = &cbrServiceRef{
+			AccountID: core.StringPtr(ref["account_id"].(string)),
+		}
+		if v := ref["service_type"].(string); v != "" {
+			result.Ref.ServiceType = core.StringPtr(v)
+		}
+		if v := ref["service_name"].(string); v != "" {
+			result.Ref.ServiceName = core.StringPtr(v)
+		}
+		if v := ref["service_instance"].(string); v != "" {
+			result.Ref.ServiceInstance = core.StringPtr(v)
+		}
+		if v := ref["location"].(string); v != "" {
+			result.Ref.Location = core.StringPtr(v)
+		}
+	}
+	return result
+}
+
+func flattenCbrAddresses(addresses []cbrAddress, withRef bool) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(addresses))
+	for _, address := range addresses {
+		m := map[string]interface{}{
+			"type":  address.Type,
+			"value": address.Value,
+		}
+		if withRef {
+			refs := []map[string]interface{}{}
+			if address.Ref != nil {
+				refs = append(refs, map[string]interface{}{
+					"account_id":       address.Ref.AccountID,
+					"service_type":     address.Ref.ServiceType,
+					"service_name":     address.Ref.ServiceName,
+					"service_instance": address.Ref.ServiceInstance,
+					"location":         address.Ref.Location,
+				})
+			}
+			m["ref"] = refs
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func resourceIBMCbrZoneCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.userAccount
+	}
+
+	zone, response, err := cbrClient.CreateZone(context, expandCbrZone(d, accountID))
+	if err != nil {
+		log.Printf("[DEBUG] CreateZone failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateZone failed %s\n%s", err, response))
+	}
+
+	d.SetId(*zone.ID)
+
+	return resourceIBMCbrZoneRead(context, d, meta)
+}
+
+func resourceIBMCbrZoneRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zone, response, err := cbrClient.GetZone(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetZone failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetZone failed %s\n%s", err, response))
+	}
+
+	if err = setCbrZoneAttributes(d, zone); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("version", response.Headers.Get("ETag")); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting version: %s", err))
+	}
+
+	return nil
+}
+
+func setCbrZoneAttributes(d *schema.ResourceData, zone *cbrZone) error {
+	if err := d.Set("name", zone.Name); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
+	}
+	if err := d.Set("account_id", zone.AccountID); err != nil {
+		return fmt.Errorf("Error setting account_id: %s", err)
+	}
+	if err := d.Set("description", zone.Description); err != nil {
+		return fmt.Errorf("Error setting description: %s", err)
+	}
+	if err := d.Set("addresses", flattenCbrAddresses(zone.Addresses, true)); err != nil {
+		return fmt.Errorf("Error setting addresses: %s", err)
+	}
+	if err := d.Set("excluded", flattenCbrAddresses(zone.Excluded, false)); err != nil {
+		return fmt.Errorf("Error setting excluded: %s", err)
+	}
+	if err := d.Set("crn", zone.CRN); err != nil {
+		return fmt.Errorf("Error setting crn: %s", err)
+	}
+	if err := d.Set("address_count", zone.AddressCount); err != nil {
+		return fmt.Errorf("Error setting address_count: %s", err)
+	}
+	if err := d.Set("excluded_count", zone.ExcludedCount); err != nil {
+		return fmt.Errorf("Error setting excluded_count: %s", err)
+	}
+	if err := d.Set("href", zone.Href); err != nil {
+		return fmt.Errorf("Error setting href: %s", err)
+	}
+	if err := d.Set("created_at", zone.CreatedAt); err != nil {
+		return fmt.Errorf("Error setting created_at: %s", err)
+	}
+	if err := d.Set("created_by_id", zone.CreatedByID); err != nil {
+		return fmt.Errorf("Error setting created_by_id: %s", err)
+	}
+	if err := d.Set("last_modified_at", zone.LastModifiedAt); err != nil {
+		return fmt.Errorf("Error setting last_modified_at: %s", err)
+	}
+	if err := d.Set("last_modified_by_id", zone.LastModifiedByID); err != nil {
+		return fmt.Errorf("Error setting last_modified_by_id: %s", err)
+	}
+	return nil
+}
+
+func resourceIBMCbrZoneUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("addresses") || d.HasChange("excluded") {
+		response, err := cbrClient.ReplaceZone(context, d.Id(), d.Get("version").(string), expandCbrZone(d, d.Get("account_id").(string)))
+		if err != nil {
+			if response != nil && response.StatusCode == 412 {
+				return diag.FromErr(fmt.Errorf("Zone %s was changed outside of Terraform since it was last read, refresh the state and apply again", d.Id()))
+			}
+			log.Printf("[DEBUG] ReplaceZone failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ReplaceZone failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMCbrZoneRead(context, d, meta)
+}
+
+func resourceIBMCbrZoneDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := cbrClient.DeleteZone(context, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteZone failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteZone failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
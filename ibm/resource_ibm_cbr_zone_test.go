@@ -0,0 +1,140 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCbrZoneBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-cbr-zone-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_cbr_zone.zone"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCbrZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCbrZoneConfig(name, "169.23.56.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "addresses.1.value", "169.23.56.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "excluded.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "crn"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCbrZoneConfig(name, "169.23.57.0/24"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "addresses.1.value", "169.23.57.0/24"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMCbrZoneInvalidAddress(t *testing.T) {
+	name := fmt.Sprintf("tf-cbr-zone-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMCbrZoneConfig(name, "169.23.56.1"),
+				ExpectError: regexp.MustCompile("addresses.1.value"),
+			},
+		},
+	})
+}
+
+func TestValidateCbrAddress(t *testing.T) {
+	cases := []struct {
+		addressType string
+		value       string
+		hasRef      bool
+		valid       bool
+	}{
+		{cbrAddressTypeIPAddress, "169.23.56.1", false, true},
+		{cbrAddressTypeIPAddress, "169.23.56.0/24", false, false},
+		{cbrAddressTypeIPRange, "169.23.56.1-169.23.56.10", false, true},
+		{cbrAddressTypeIPRange, "169.23.56.1", false, false},
+		{cbrAddressTypeSubnet, "169.23.56.0/24", false, true},
+		{cbrAddressTypeSubnet, "169.23.56.1", false, false},
+		{cbrAddressTypeVPC, "crn:v1:bluemix:public:is:us-south:a/12ab34cd::vpc:r006-1234", false, true},
+		{cbrAddressTypeVPC, "r006-1234", false, false},
+		{cbrAddressTypeServiceRef, "", true, true},
+		{cbrAddressTypeServiceRef, "", false, false},
+		{cbrAddressTypeSubnet, "169.23.56.0/24", true, false},
+		{cbrAddressTypeSubnet, "", false, true},
+	}
+	for _, c := range cases {
+		err := validateCbrAddress("addresses.0", c.addressType, c.value, c.hasRef)
+		if c.valid && err != nil {
+			t.Errorf("%s %q: unexpected error %s", c.addressType, c.value, err)
+		}
+		if !c.valid && err == nil {
+			t.Errorf("%s %q: expected an error", c.addressType, c.value)
+		}
+	}
+}
+
+func testAccCheckIBMCbrZoneDestroy(s *terraform.State) error {
+	cbrClient, err := testAccProvider.Meta().(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cbr_zone" {
+			continue
+		}
+
+		_, response, err := cbrClient.GetZone(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Zone still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for zone (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMCbrZoneConfig(name, subnet string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cbr_zone" "zone" {
+			name        = "%s"
+			description = "Zone created by terraform acceptance tests"
+
+			addresses {
+				type  = "ipRange"
+				value = "169.23.22.0-169.23.22.255"
+			}
+			addresses {
+				type  = "subnet"
+				value = "%s"
+			}
+
+			excluded {
+				type  = "ipAddress"
+				value = "169.23.22.10"
+			}
+		}
+	`, name, subnet)
+}
@@ -4,9 +4,12 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
@@ -21,6 +24,11 @@ func resourceIBMCertificateManagerImport() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		Delete:   resourceIBMCertificateManagerDelete,
 		Exists:   resourceIBMCertificateManagerExists,
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceCertificateManagerRenewalCustomizeDiff(diff)
+			},
+		),
 		Schema: map[string]*schema.Schema{
 			"certificate_manager_instance_id": {
 				Type:        schema.TypeString,
@@ -77,6 +85,17 @@ func resourceIBMCertificateManagerImport() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"renew_within_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateAllowedRangeInt(1, 365),
+				Description:  "The certificate is reimported with data when it expires within the given number of days",
+			},
+			"renewal_reason": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reason of the planned renewal of the certificate",
+			},
 		},
 	}
 }
@@ -148,6 +167,7 @@ func resourceIBMCertificateManagerGet(d *schema.ResourceData, meta interface{})
 	d.Set("has_previous", certificatedata.HasPrevious)
 	d.Set("key_algorithm", certificatedata.KeyAlgorithm)
 	d.Set("algorithm", certificatedata.Algorithm)
+	d.Set("renewal_reason", "")
 
 	return nil
 }
@@ -169,6 +189,9 @@ func resourceIBMCertificateManagerUpdate(d *schema.ResourceData, meta interface{
 			return importCertError
 		}
 	}
+	if certificateManagerRenewalDue(d) && !d.HasChange("data") {
+		log.Printf("[WARN] %s, update data with the renewed certificate to reimport it", d.Get("renewal_reason").(string))
+	}
 	if d.HasChange("data") {
 		importData := models.Data{}
 		if certificateimpdata, ok := d.GetOk("data"); ok && certificateimpdata != nil {
@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const certificateManagerChannelIDSeparator = ":channel:"
+
+func resourceIBMCertificateManagerNotificationChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCertificateManagerNotificationChannelCreate,
+		ReadContext:   resourceIBMCertificateManagerNotificationChannelRead,
+		UpdateContext: resourceIBMCertificateManagerNotificationChannelUpdate,
+		DeleteContext: resourceIBMCertificateManagerNotificationChannelDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_manager_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Certificate manager instance ID",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"slack", "webhook", "event_notifications"}),
+				Description:  "The type of the notification channel, slack, webhook or event_notifications",
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The Slack webhook URL, the URL of the webhook or the CRN of the Event Notifications instance the notifications are sent to",
+			},
+			"expiry_thresholds": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validateAllowedRangeInt(1, 365)},
+				Description: "The numbers of days before the expiry of a certificate on which notifications are sent to the channel",
+			},
+			"is_active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "The notifications are sent to the channel when it is active",
+			},
+			"test_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Sends a test notification to the channel when it is created",
+			},
+			"channel_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the notification channel",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the notification channel",
+			},
+		},
+	}
+}
+
+func certificateManagerChannelIDParts(id string) (string, string, error) {
+	i := strings.LastIndex(id, certificateManagerChannelIDSeparator)
+	if i <= 0 || i+len(certificateManagerChannelIDSeparator) == len(id) {
+		return "", "", fmt.Errorf("Incorrect ID %s: Id should be a combination of instanceID%schannelID", id, certificateManagerChannelIDSeparator)
+	}
+	return id[:i], id[i+len(certificateManagerChannelIDSeparator):], nil
+}
+
+func expandCertificateManagerNotificationChannel(d *schema.ResourceData) certificateManagerNotificationChannel {
+	channel := certificateManagerNotificationChannel{
+		Type:     d.Get("type").(string),
+		Endpoint: d.Get("endpoint").(string),
+		IsActive: d.Get("is_active").(bool),
+	}
+	for _, days := range d.Get("expiry_thresholds").(*schema.Set).List() {
+		channel.ExpiryThresholds = append(channel.ExpiryThresholds, days.(int))
+	}
+	sort.Ints(channel.ExpiryThresholds)
+	return channel
+}
+
+func resourceIBMCertificateManagerNotificationChannelCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cmService, err := meta.(ClientSession).CertificateManagerAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := certificateManagerREST(cmService)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := d.Get("certificate_manager_instance_id").(string)
+	channel := expandCertificateManagerNotificationChannel(d)
+	created, err := createCertificateManagerChannel(client, instanceID, channel)
+	if err != nil {
+		return diag.Errorf("Error creating notification channel: %s", err)
+	}
+	d.SetId(instanceID + certificateManagerChannelIDSeparator + created.ID)
+
+	if d.Get("test_on_create").(bool) {
+		message, err := testCertificateManagerChannel(client, instanceID, created.ID)
+		if err != nil {
+			return diag.Errorf("Error testing notification channel %s: %s", created.ID, err)
+		}
+		if message != "" {
+			return append(resourceIBMCertificateManagerNotificationChannelRead(context, d, meta), diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Test notification of channel %s: %s", created.ID, message),
+			})
+		}
+	}
+
+	return resourceIBMCertificateManagerNotificationChannelRead(context, d, meta)
+}
+
+func resourceIBMCertificateManagerNotificationChannelRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cmService, err := meta.(ClientSession).CertificateManagerAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := certificateManagerREST(cmService)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, channelID, err := certificateManagerChannelIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	channel, err := getCertificateManagerChannel(client, instanceID, channelID)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading notification channel %s: %s", channelID, err)
+	}
+
+	d.Set("certificate_manager_instance_id", instanceID)
+	d.Set("channel_id", channelID)
+	d.Set("type", channel.Type)
+	d.Set("is_active", channel.IsActive)
+	d.Set("version", channel.Version)
+	if len(channel.ExpiryThresholds) > 0 {
+		d.Set("expiry_thresholds", channel.ExpiryThresholds)
+	}
+	// The service masks the endpoint of the channels
+	if channel.Endpoint != "" && !strings.Contains(channel.Endpoint, "*") {
+		d.Set("endpoint", channel.Endpoint)
+	}
+
+	return nil
+}
+
+func resourceIBMCertificateManagerNotificationChannelUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cmService, err := meta.(ClientSession).CertificateManagerAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := certificateManagerREST(cmService)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, channelID, err := certificateManagerChannelIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("endpoint") || d.HasChange("is_active") || d.HasChange("expiry_thresholds") {
+		channel := expandCertificateManagerNotificationChannel(d)
+		if err := updateCertificateManagerChannel(client, instanceID, channelID, channel); err != nil {
+			return diag.Errorf("Error updating notification channel %s: %s", channelID, err)
+		}
+	}
+
+	return resourceIBMCertificateManagerNotificationChannelRead(context, d, meta)
+}
+
+func resourceIBMCertificateManagerNotificationChannelDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cmService, err := meta.(ClientSession).CertificateManagerAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := certificateManagerREST(cmService)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, channelID, err := certificateManagerChannelIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := deleteCertificateManagerChannel(client, instanceID, channelID); err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); !ok || apiErr.StatusCode() != 404 {
+			return diag.Errorf("Error deleting notification channel %s: %s", channelID, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
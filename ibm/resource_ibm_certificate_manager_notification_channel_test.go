@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCertificateManagerNotificationChannel_Basic(t *testing.T) {
+	cmsName := fmt.Sprintf("tf-acc-test1-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCertificateManagerNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCertificateManagerNotificationChannelConfig(cmsName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_certificate_manager_notification_channel.channel", "type", "webhook"),
+					resource.TestCheckResourceAttr("ibm_certificate_manager_notification_channel.channel", "is_active", "true"),
+					resource.TestCheckResourceAttrSet("ibm_certificate_manager_notification_channel.channel", "channel_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCertificateManagerNotificationChannelConfig(cmsName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_certificate_manager_notification_channel.channel", "is_active", "false"),
+				),
+			},
+			{
+				ResourceName:            "ibm_certificate_manager_notification_channel.channel",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint", "test_on_create"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCertificateManagerNotificationChannelDestroy(s *terraform.State) error {
+	cmService, err := testAccProvider.Meta().(ClientSession).CertificateManagerAPI()
+	if err != nil {
+		return err
+	}
+	client, err := certificateManagerREST(cmService)
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_certificate_manager_notification_channel" {
+			continue
+		}
+		instanceID, channelID, err := certificateManagerChannelIDParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, err = getCertificateManagerChannel(client, instanceID, channelID)
+		if err == nil {
+			return fmt.Errorf("Notification channel still exists: %s", rs.Primary.ID)
+		}
+		if apiErr, ok := err.(bmxerror.RequestFailure); !ok || apiErr.StatusCode() != 404 {
+			return fmt.Errorf("Error checking if notification channel (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMCertificateManagerNotificationChannelConfig(cmsName string, active bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "cm" {
+		name     = "%s"
+		location = "us-south"
+		service  = "cloudcerts"
+		plan     = "free"
+	}
+	resource "ibm_certificate_manager_notification_channel" "channel" {
+		certificate_manager_instance_id = ibm_resource_instance.cm.id
+		type                            = "webhook"
+		endpoint                        = "https://example.com/certificates/notifications"
+		is_active                       = %t
+	}
+	`, cmsName, active)
+}
+
+func TestExpandCertificateManagerNotificationChannel(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceIBMCertificateManagerNotificationChannel().Schema, map[string]interface{}{
+		"certificate_manager_instance_id": "crn:v1:bluemix:public:cloudcerts:us-south:a/1:2::",
+		"type":                            "event_notifications",
+		"endpoint":                        "crn:v1:bluemix:public:event-notifications:us-south:a/1:3::",
+		"expiry_thresholds":               []interface{}{7, 30, 1},
+	})
+
+	channel := expandCertificateManagerNotificationChannel(d)
+	if channel.Type != "event_notifications" || !channel.IsActive {
+		t.Errorf("channel = %+v, expected an active event_notifications channel", channel)
+	}
+	if !reflect.DeepEqual(channel.ExpiryThresholds, []int{1, 7, 30}) {
+		t.Errorf("expiry_thresholds = %v, expected [1 7 30]", channel.ExpiryThresholds)
+	}
+}
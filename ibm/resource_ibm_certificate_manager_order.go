@@ -4,11 +4,14 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -28,6 +31,11 @@ func resourceIBMCertificateManagerOrder() *schema.Resource {
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceCertificateManagerRenewalCustomizeDiff(diff)
+			},
+		),
 		Schema: map[string]*schema.Schema{
 			"certificate_manager_instance_id": {
 				Type:        schema.TypeString,
@@ -126,10 +134,56 @@ func resourceIBMCertificateManagerOrder() *schema.Resource {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+			"renew_within_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateAllowedRangeInt(1, 365),
+				Description:  "The certificate is renewed when it expires within the given number of days",
+			},
+			"renewal_reason": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reason of the planned renewal of the certificate",
+			},
 		},
 	}
 }
 
+// certificateManagerRenewalReason returns why a certificate expiring on expiresOn (epoch milliseconds) has to be
+// renewed, it is empty when the certificate doesn't expire within days
+func certificateManagerRenewalReason(expiresOn int64, days int, now time.Time) string {
+	if expiresOn <= 0 || days <= 0 {
+		return ""
+	}
+	expiry := time.Unix(0, expiresOn*int64(time.Millisecond)).UTC()
+	if expiry.After(now.AddDate(0, 0, days)) {
+		return ""
+	}
+	if !expiry.After(now) {
+		return fmt.Sprintf("The certificate expired on %s", expiry.Format(time.RFC3339))
+	}
+	return fmt.Sprintf("The certificate expires on %s, within the renewal threshold of %d days", expiry.Format(time.RFC3339), days)
+}
+
+// resourceCertificateManagerRenewalCustomizeDiff plans the renewal of the certificates expiring within
+// renew_within_days, the reason is never kept in the state so the renewal is planned again on every plan until
+// expires_on moves past the threshold
+func resourceCertificateManagerRenewalCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	reason := certificateManagerRenewalReason(int64(diff.Get("expires_on").(int)), diff.Get("renew_within_days").(int), time.Now())
+	if reason != "" {
+		return diff.SetNew("renewal_reason", reason)
+	}
+	return nil
+}
+
+// certificateManagerRenewalDue reports whether the plan renews the certificate because of renew_within_days
+func certificateManagerRenewalDue(d *schema.ResourceData) bool {
+	return d.HasChange("renewal_reason") && d.Get("renewal_reason").(string) != ""
+}
+
 func resourceIBMCertificateManagerOrderCertificate(d *schema.ResourceData, meta interface{}) error {
 
 	cmService, err := meta.(ClientSession).CertificateManagerAPI()
@@ -201,6 +255,7 @@ func resourceIBMCertificateManagerRead(d *schema.ResourceData, meta interface{})
 	d.Set("issuer", certificatedata.Issuer)
 	d.Set("has_previous", certificatedata.HasPrevious)
 	d.Set("auto_renew_enabled", certificatedata.OrderPolicy.AutoRenewEnabled)
+	d.Set("renewal_reason", "")
 
 	if certificatedata.IssuanceInfo != nil {
 		issuanceinfo := map[string]interface{}{}
@@ -231,7 +286,11 @@ func resourceIBMCertificateManagerRenew(d *schema.ResourceData, meta interface{}
 	certID := d.Id()
 	client := cmService.Certificate()
 
-	if d.Get("renew_certificate").(bool) == true {
+	renewalDue := certificateManagerRenewalDue(d)
+	if renewalDue {
+		log.Printf("[INFO] Renewing certificate %s: %s", certID, d.Get("renewal_reason").(string))
+	}
+	if d.Get("renew_certificate").(bool) == true || renewalDue {
 		rotateKeys := d.Get("rotate_keys").(bool)
 		payload := models.CertificateRenewData{RotateKeys: rotateKeys}
 
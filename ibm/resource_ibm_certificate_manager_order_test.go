@@ -195,3 +195,23 @@ func testAccCheckIBMCMOrderExists(n string, obj models.CertificateInfo) resource
 		return nil
 	}
 }
+
+func TestCertificateManagerRenewalReason(t *testing.T) {
+	now := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	ms := func(t time.Time) int64 { return t.UnixNano() / int64(time.Millisecond) }
+
+	if reason := certificateManagerRenewalReason(ms(now.AddDate(0, 0, 45)), 30, now); reason != "" {
+		t.Errorf("expected no renewal outside of the threshold, got %q", reason)
+	}
+	if reason := certificateManagerRenewalReason(ms(now.AddDate(0, 0, 45)), 0, now); reason != "" {
+		t.Errorf("expected no renewal without threshold, got %q", reason)
+	}
+	expected := "The certificate expires on 2021-09-21T00:00:00Z, within the renewal threshold of 30 days"
+	if reason := certificateManagerRenewalReason(ms(now.AddDate(0, 0, 20)), 30, now); reason != expected {
+		t.Errorf("expected %q, got %q", expected, reason)
+	}
+	expected = "The certificate expired on 2021-08-31T00:00:00Z"
+	if reason := certificateManagerRenewalReason(ms(now.AddDate(0, 0, -1)), 30, now); reason != expected {
+		t.Errorf("expected %q, got %q", expected, reason)
+	}
+}
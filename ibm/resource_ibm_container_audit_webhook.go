@@ -0,0 +1,256 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	refreshMasterAction = "refresh"
+)
+
+func resourceIBMContainerAuditWebhook() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerAuditWebhookCreate,
+		Read:     resourceIBMContainerAuditWebhookRead,
+		Update:   resourceIBMContainerAuditWebhookUpdate,
+		Delete:   resourceIBMContainerAuditWebhookDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster name or ID",
+			},
+			"audit_server": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The URL of the remote audit webhook server that receives the Kubernetes API server audit logs.",
+			},
+			"ca_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The base64 encoded CA certificate of the audit webhook server.",
+			},
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The base64 encoded client certificate that is used to authenticate with the audit webhook server.",
+			},
+			"client_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded client key that is used to authenticate with the audit webhook server.",
+			},
+			"resource_group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: applyOnce,
+				Description:      "ID of the resource group.",
+			},
+		},
+	}
+}
+
+func resourceIBMContainerAuditWebhookCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+
+	err := updateContainerAuditWebhook(cluster, d, meta)
+	if err != nil {
+		return err
+	}
+	d.SetId(cluster)
+
+	err = refreshContainerClusterMaster(cluster, d, meta)
+	if err != nil {
+		return err
+	}
+
+	return resourceIBMContainerAuditWebhookRead(d, meta)
+}
+
+func updateContainerAuditWebhook(cluster string, d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	resourceGroup, err := containerClusterResourceGroup(cluster, d, meta)
+	if err != nil {
+		return err
+	}
+
+	updateAuditWebhookOptions := &kubernetesserviceapiv1.UpdateAuditWebhookOptions{}
+	updateAuditWebhookOptions.SetIdOrName(cluster)
+	updateAuditWebhookOptions.SetXAuthResourceGroup(resourceGroup)
+	updateAuditWebhookOptions.SetAuditServer(d.Get("audit_server").(string))
+	if v, ok := d.GetOk("ca_certificate"); ok {
+		updateAuditWebhookOptions.SetCaCertificate(v.(string))
+	}
+	if v, ok := d.GetOk("client_certificate"); ok {
+		updateAuditWebhookOptions.SetClientCertificate(v.(string))
+	}
+	if v, ok := d.GetOk("client_key"); ok {
+		updateAuditWebhookOptions.SetClientKey(v.(string))
+	}
+
+	response, err := csClient.UpdateAuditWebhook(updateAuditWebhookOptions)
+	if err != nil {
+		return fmt.Errorf("Error updating the audit webhook of cluster %s: %s\n%s", cluster, err, response)
+	}
+	return nil
+}
+
+func resourceIBMContainerAuditWebhookRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	resourceGroup, err := containerClusterResourceGroup(d.Id(), d, meta)
+	if err != nil {
+		return err
+	}
+
+	getAuditWebhookOptions := &kubernetesserviceapiv1.GetAuditWebhookOptions{}
+	getAuditWebhookOptions.SetIdOrName(d.Id())
+	getAuditWebhookOptions.SetXAuthResourceGroup(resourceGroup)
+
+	webhook, response, err := csClient.GetAuditWebhook(getAuditWebhookOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving the audit webhook of cluster %s: %s\n%s", d.Id(), err, response)
+	}
+	if webhook.AuditServer == nil || *webhook.AuditServer == "" {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster", d.Id())
+	d.Set("audit_server", webhook.AuditServer)
+	d.Set("ca_certificate", webhook.CaCertificate)
+	d.Set("client_certificate", webhook.ClientCertificate)
+	// The client key is not returned by the API
+	if webhook.ClientKey != nil && *webhook.ClientKey != "" {
+		d.Set("client_key", webhook.ClientKey)
+	}
+	d.Set("resource_group_id", resourceGroup)
+
+	return nil
+}
+
+func resourceIBMContainerAuditWebhookUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("audit_server") || d.HasChange("ca_certificate") || d.HasChange("client_certificate") || d.HasChange("client_key") {
+		err := updateContainerAuditWebhook(d.Id(), d, meta)
+		if err != nil {
+			return err
+		}
+		err = refreshContainerClusterMaster(d.Id(), d, meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMContainerAuditWebhookRead(d, meta)
+}
+
+func resourceIBMContainerAuditWebhookDelete(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	resourceGroup, err := containerClusterResourceGroup(d.Id(), d, meta)
+	if err != nil {
+		return err
+	}
+
+	deleteAuditWebhookOptions := &kubernetesserviceapiv1.DeleteAuditWebhookOptions{}
+	deleteAuditWebhookOptions.SetIdOrName(d.Id())
+	deleteAuditWebhookOptions.SetXAuthResourceGroup(resourceGroup)
+
+	response, err := csClient.DeleteAuditWebhook(deleteAuditWebhookOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting the audit webhook of cluster %s: %s\n%s", d.Id(), err, response)
+	}
+
+	err = refreshContainerClusterMaster(d.Id(), d, meta)
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// refreshContainerClusterMaster restarts the Kubernetes master to apply the API server configuration and
+// waits until the master is ready again
+func refreshContainerClusterMaster(cluster string, d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	resourceGroup, err := containerClusterResourceGroup(cluster, d, meta)
+	if err != nil {
+		return err
+	}
+
+	handleMasterAPIServerOptions := &kubernetesserviceapiv1.HandleMasterAPIServerOptions{}
+	handleMasterAPIServerOptions.SetIdOrName(cluster)
+	handleMasterAPIServerOptions.SetXAuthResourceGroup(resourceGroup)
+	handleMasterAPIServerOptions.SetAction(refreshMasterAction)
+
+	response, err := csClient.HandleMasterAPIServer(handleMasterAPIServerOptions)
+	if err != nil {
+		return fmt.Errorf("Error refreshing the master of cluster %s: %s\n%s", cluster, err, response)
+	}
+
+	log.Printf("Waiting for master of cluster (%s) to be available.", cluster)
+	_, err = waitForVpcClusterMasterAvailable(d, meta)
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for master of cluster (%s) to become ready: %s", cluster, err)
+	}
+	return nil
+}
+
+// containerClusterResourceGroup returns the configured resource group, or the resource group of the cluster
+// when none is configured. The cluster APIs of the v1 service require the resource group header.
+func containerClusterResourceGroup(cluster string, d *schema.ResourceData, meta interface{}) (string, error) {
+	if rg, ok := d.GetOk("resource_group_id"); ok {
+		return rg.(string), nil
+	}
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return "", err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return "", err
+	}
+	cls, err := csClient.Clusters().GetCluster(cluster, targetEnv)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving cluster %s: %s", cluster, err)
+	}
+	return cls.ResourceGroupID, nil
+}
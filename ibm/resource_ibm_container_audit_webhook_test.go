@@ -0,0 +1,49 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerAuditWebhook_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckContainerCluster(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerAuditWebhookBasic("https://audit.example.com/webhook"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_audit_webhook.webhook", "audit_server", "https://audit.example.com/webhook"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_audit_webhook.webhook", "resource_group_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerAuditWebhookBasic("https://audit.example.com/v2/webhook"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_audit_webhook.webhook", "audit_server", "https://audit.example.com/v2/webhook"),
+				),
+			},
+			{
+				ResourceName:      "ibm_container_audit_webhook.webhook",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerAuditWebhookBasic(server string) string {
+	return fmt.Sprintf(`
+resource "ibm_container_audit_webhook" "webhook" {
+  cluster      = "%s"
+  audit_server = "%s"
+}`, containerClusterName, server)
+}
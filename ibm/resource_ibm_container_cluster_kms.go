@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+func resourceIBMContainerClusterKms() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerClusterKmsCreate,
+		Read:     resourceIBMContainerClusterKmsRead,
+		Update:   resourceIBMContainerClusterKmsUpdate,
+		Delete:   resourceIBMContainerClusterKmsDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster name or ID",
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the KMS instance to use to encrypt the cluster.",
+			},
+			"crk_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the customer root key. Changing the key rotates the root key that encrypts the cluster secrets.",
+			},
+			"private_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Specify this option to use the KMS private service endpoint.",
+			},
+			"key_protect_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether KMS encryption is enabled on the cluster.",
+			},
+			"resource_group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: applyOnce,
+				Description:      "ID of the resource group.",
+			},
+		},
+	}
+}
+
+func resourceIBMContainerClusterKmsCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+
+	err := enableContainerClusterKms(cluster, d, meta)
+	if err != nil {
+		return err
+	}
+	d.SetId(cluster)
+
+	_, err = waitForVpcClusterMasterAvailable(d, meta)
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for master of cluster (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceIBMContainerClusterKmsRead(d, meta)
+}
+
+func enableContainerClusterKms(cluster string, d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+
+	kmsConfig := v2.KmsEnableReq{
+		Cluster:         cluster,
+		Kms:             d.Get("instance_id").(string),
+		Crk:             d.Get("crk_id").(string),
+		PrivateEndpoint: d.Get("private_endpoint").(bool),
+	}
+	targetEnv := v2.ClusterHeader{}
+	if rg, ok := d.GetOk("resource_group_id"); ok {
+		targetEnv.ResourceGroup = rg.(string)
+	}
+
+	err = csClient.Kms().EnableKms(kmsConfig, targetEnv)
+	if err != nil {
+		log.Printf(
+			"An error occured during EnableKms (cluster: %s) error: %s", cluster, err)
+		return err
+	}
+	return nil
+}
+
+func resourceIBMContainerClusterKmsRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+
+	cls, err := csClient.Clusters().GetCluster(d.Id(), targetEnv)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving cluster %s: %s", d.Id(), err)
+	}
+
+	d.Set("cluster", d.Id())
+	d.Set("key_protect_enabled", cls.Features.KeyProtectEnabled)
+	d.Set("resource_group_id", cls.ResourceGroupID)
+
+	kmsConfig, response, err := getContainerClusterKmsConfig(d.Id(), cls.ResourceGroupID, meta)
+	if err != nil {
+		// The configured instance and root key are kept when the KMS configuration can't be read
+		log.Printf("[WARN] Error retrieving the KMS configuration of cluster %s: %s\n%s", d.Id(), err, response)
+		return nil
+	}
+	if kmsConfig.InstanceID != "" {
+		d.Set("instance_id", kmsConfig.InstanceID)
+	}
+	if kmsConfig.CrkID != "" {
+		d.Set("crk_id", kmsConfig.CrkID)
+	}
+
+	return nil
+}
+
+// containerClusterKmsConfig is the KMS provider configuration of a cluster
+type containerClusterKmsConfig struct {
+	InstanceID string `json:"instanceID"`
+	CrkID      string `json:"crkID"`
+}
+
+// getContainerClusterKmsConfig reads the KMS provider configuration of a cluster, the SDK only has the call that
+// creates it
+func getContainerClusterKmsConfig(cluster, resourceGroup string, meta interface{}) (*containerClusterKmsConfig, *core.DetailedResponse, error) {
+	csClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	builder := core.NewRequestBuilder(core.GET)
+	_, err = builder.ResolveRequestURL(csClient.Service.Options.URL, `/v1/clusters/{idOrName}/kms`, map[string]string{"idOrName": cluster})
+	if err != nil {
+		return nil, nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if resourceGroup != "" {
+		builder.AddHeader("X-Auth-Resource-Group", resourceGroup)
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kmsConfig := &containerClusterKmsConfig{}
+	response, err := csClient.Service.Request(request, kmsConfig)
+	if err != nil {
+		return nil, response, err
+	}
+	return kmsConfig, response, nil
+}
+
+func resourceIBMContainerClusterKmsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("instance_id") || d.HasChange("crk_id") || d.HasChange("private_endpoint") {
+		err := enableContainerClusterKms(d.Id(), d, meta)
+		if err != nil {
+			return err
+		}
+		_, err = waitForVpcClusterMasterAvailable(d, meta)
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for master of cluster (%s) to become ready: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIBMContainerClusterKmsRead(d, meta)
+}
+
+// KMS encryption can't be disabled once it is enabled on a cluster, so the resource is only removed from the state
+func resourceIBMContainerClusterKmsDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] KMS encryption can't be disabled on cluster %s, removing the resource from the state only", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerClusterKms_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-cluster-kms-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckContainerCluster(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterKmsBasic(name, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_cluster_kms.kms", "key_protect_enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_container_cluster_kms.kms", "crk_id", "ibm_kms_key.test", "key_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerClusterKmsBasic(name, "rotated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_cluster_kms.kms", "key_protect_enabled", "true"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_container_cluster_kms.kms", "crk_id", "ibm_kms_key.rotated", "key_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterKmsBasic(name, key string) string {
+	return fmt.Sprintf(`
+resource "ibm_resource_instance" "kms_instance" {
+  name     = "%[1]s"
+  service  = "kms"
+  plan     = "tiered-pricing"
+  location = "%[3]s"
+}
+
+resource "ibm_kms_key" "test" {
+  instance_id  = ibm_resource_instance.kms_instance.guid
+  key_name     = "%[1]s"
+  standard_key = false
+  force_delete = true
+}
+
+resource "ibm_kms_key" "rotated" {
+  instance_id  = ibm_resource_instance.kms_instance.guid
+  key_name     = "%[1]s-rotated"
+  standard_key = false
+  force_delete = true
+}
+
+resource "ibm_container_cluster_kms" "kms" {
+  cluster          = "%[2]s"
+  instance_id      = ibm_resource_instance.kms_instance.guid
+  crk_id           = ibm_kms_key.%[4]s.key_id
+  private_endpoint = false
+}`, name, containerClusterName, csRegion, key)
+}
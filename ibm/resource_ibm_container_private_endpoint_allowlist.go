@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceIBMContainerPrivateEndpointAllowlist manages the allowlist of the private cloud service endpoint of classic and
+// VPC clusters, the public cloud service endpoint is restricted by ibm_container_public_endpoint_allowlist
+func resourceIBMContainerPrivateEndpointAllowlist() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerPrivateEndpointAllowlistCreate,
+		Read:     resourceIBMContainerPrivateEndpointAllowlistRead,
+		Update:   resourceIBMContainerPrivateEndpointAllowlistUpdate,
+		Delete:   resourceIBMContainerPrivateEndpointAllowlistDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster name or ID",
+			},
+			"subnets": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateCIDR},
+				Set:         schema.HashString,
+				Description: "The subnets, in CIDR notation, that are allowed to access the private cloud service endpoint of the cluster master.",
+			},
+			"system_subnets": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The subnets that are added to the allowlist by the service and that can't be removed.",
+			},
+			"resource_group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: applyOnce,
+				Description:      "ID of the resource group.",
+			},
+		},
+	}
+}
+
+func resourceIBMContainerPrivateEndpointAllowlistCreate(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	cluster := d.Get("cluster").(string)
+	resourceGroup, err := containerClusterResourceGroup(cluster, d, meta)
+	if err != nil {
+		return err
+	}
+
+	enableClusterACLsOptions := &kubernetesserviceapiv1.EnableClusterACLsOptions{}
+	enableClusterACLsOptions.SetIdOrName(cluster)
+	enableClusterACLsOptions.SetXAuthResourceGroup(resourceGroup)
+	response, err := csClient.EnableClusterACLs(enableClusterACLsOptions)
+	if err != nil {
+		return fmt.Errorf("Error enabling the private service endpoint allowlist of cluster %s: %s\n%s", cluster, err, response)
+	}
+	d.SetId(cluster)
+
+	err = addContainerClusterACLs(cluster, resourceGroup, expandStringList(d.Get("subnets").(*schema.Set).List()), meta)
+	if err != nil {
+		return err
+	}
+
+	_, err = waitForVpcClusterMasterAvailable(d, meta)
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for master of cluster (%s) to become ready: %s", d.Id(), err)
+	}
+
+	return resourceIBMContainerPrivateEndpointAllowlistRead(d, meta)
+}
+
+func resourceIBMContainerPrivateEndpointAllowlistRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	resourceGroup, err := containerClusterResourceGroup(d.Id(), d, meta)
+	if err != nil {
+		return err
+	}
+
+	getClusterACLsOptions := &kubernetesserviceapiv1.GetClusterACLsOptions{}
+	getClusterACLsOptions.SetIdOrName(d.Id())
+	getClusterACLsOptions.SetXAuthResourceGroup(resourceGroup)
+
+	acls, response, err := csClient.GetClusterACLs(getClusterACLsOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving the private service endpoint allowlist of cluster %s: %s\n%s", d.Id(), err, response)
+	}
+	if acls.DesiredCSEACLList == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster", d.Id())
+	d.Set("subnets", newStringSet(schema.HashString, acls.DesiredCSEACLList.CustomAclEntries))
+	d.Set("system_subnets", acls.DesiredCSEACLList.SystemAclEntries)
+	d.Set("resource_group_id", resourceGroup)
+
+	return nil
+}
+
+func resourceIBMContainerPrivateEndpointAllowlistUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("subnets") {
+		csClient, err := meta.(ClientSession).SatelliteClientSession()
+		if err != nil {
+			return err
+		}
+		resourceGroup, err := containerClusterResourceGroup(d.Id(), d, meta)
+		if err != nil {
+			return err
+		}
+
+		o, n := d.GetChange("subnets")
+		remove := expandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+		add := expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+
+		if len(add) > 0 {
+			err = addContainerClusterACLs(d.Id(), resourceGroup, add, meta)
+			if err != nil {
+				return err
+			}
+		}
+		if len(remove) > 0 {
+			removeClusterACLsOptions := &kubernetesserviceapiv1.RemoveClusterACLsOptions{}
+			removeClusterACLsOptions.SetIdOrName(d.Id())
+			removeClusterACLsOptions.SetXAuthResourceGroup(resourceGroup)
+			removeClusterACLsOptions.SetAclList(remove)
+			response, err := csClient.RemoveClusterACLs(removeClusterACLsOptions)
+			if err != nil {
+				return fmt.Errorf("Error removing subnets from the private service endpoint allowlist of cluster %s: %s\n%s", d.Id(), err, response)
+			}
+		}
+
+		_, err = waitForVpcClusterMasterAvailable(d, meta)
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for master of cluster (%s) to become ready: %s", d.Id(), err)
+		}
+	}
+
+	return resourceIBMContainerPrivateEndpointAllowlistRead(d, meta)
+}
+
+func resourceIBMContainerPrivateEndpointAllowlistDelete(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	resourceGroup, err := containerClusterResourceGroup(d.Id(), d, meta)
+	if err != nil {
+		return err
+	}
+
+	if subnets := expandStringList(d.Get("subnets").(*schema.Set).List()); len(subnets) > 0 {
+		removeClusterACLsOptions := &kubernetesserviceapiv1.RemoveClusterACLsOptions{}
+		removeClusterACLsOptions.SetIdOrName(d.Id())
+		removeClusterACLsOptions.SetXAuthResourceGroup(resourceGroup)
+		removeClusterACLsOptions.SetAclList(subnets)
+		response, err := csClient.RemoveClusterACLs(removeClusterACLsOptions)
+		if err != nil {
+			return fmt.Errorf("Error removing subnets from the private service endpoint allowlist of cluster %s: %s\n%s", d.Id(), err, response)
+		}
+	}
+
+	disableClusterACLsOptions := &kubernetesserviceapiv1.DisableClusterACLsOptions{}
+	disableClusterACLsOptions.SetIdOrName(d.Id())
+	disableClusterACLsOptions.SetXAuthResourceGroup(resourceGroup)
+	response, err := csClient.DisableClusterACLs(disableClusterACLsOptions)
+	if err != nil {
+		return fmt.Errorf("Error disabling the private service endpoint allowlist of cluster %s: %s\n%s", d.Id(), err, response)
+	}
+
+	_, err = waitForVpcClusterMasterAvailable(d, meta)
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for master of cluster (%s) to become ready: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func addContainerClusterACLs(cluster, resourceGroup string, subnets []string, meta interface{}) error {
+	csClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	addClusterACLsOptions := &kubernetesserviceapiv1.AddClusterACLsOptions{}
+	addClusterACLsOptions.SetIdOrName(cluster)
+	addClusterACLsOptions.SetXAuthResourceGroup(resourceGroup)
+	addClusterACLsOptions.SetAclList(subnets)
+	response, err := csClient.AddClusterACLs(addClusterACLsOptions)
+	if err != nil {
+		return fmt.Errorf("Error adding subnets to the private service endpoint allowlist of cluster %s: %s\n%s", cluster, err, response)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerPrivateEndpointAllowlist_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckContainerCluster(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerPrivateEndpointAllowlistBasic(`"10.10.10.0/24"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_private_endpoint_allowlist.allowlist", "subnets.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerPrivateEndpointAllowlistBasic(`"10.10.20.0/24", "10.10.30.0/24"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_private_endpoint_allowlist.allowlist", "subnets.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_container_private_endpoint_allowlist.allowlist",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerPrivateEndpointAllowlistBasic(subnets string) string {
+	return fmt.Sprintf(`
+resource "ibm_container_private_endpoint_allowlist" "allowlist" {
+  cluster = "%s"
+  subnets = [%s]
+}`, containerClusterName, subnets)
+}
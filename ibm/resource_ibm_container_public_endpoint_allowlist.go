@@ -0,0 +1,281 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const containerCbrServiceName = "containers-kubernetes"
+
+// resourceIBMContainerPublicEndpointAllowlist restricts the public cloud service endpoint of a cluster master with a
+// context based restrictions zone that holds the subnets and a rule that limits public access to that zone
+func resourceIBMContainerPublicEndpointAllowlist() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMContainerPublicEndpointAllowlistCreate,
+		ReadContext:   resourceIBMContainerPublicEndpointAllowlistRead,
+		UpdateContext: resourceIBMContainerPublicEndpointAllowlistUpdate,
+		DeleteContext: resourceIBMContainerPublicEndpointAllowlistDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMContainerPublicEndpointAllowlistImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster name or ID",
+			},
+			"subnets": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateCIDR},
+				Set:         schema.HashString,
+				Description: "The subnets, in CIDR notation, that are allowed to access the public cloud service endpoint of the cluster master.",
+			},
+			"resource_group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: applyOnce,
+				Description:      "ID of the resource group.",
+			},
+			"zone_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the context based restrictions zone that holds the subnets.",
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the context based restrictions rule that restricts the public service endpoint.",
+			},
+		},
+	}
+}
+
+func expandContainerPublicEndpointZone(d *schema.ResourceData, clusterID, accountID string) *cbrZone {
+	zone := &cbrZone{
+		Name:        core.StringPtr(fmt.Sprintf("%s-public-endpoint", clusterID)),
+		AccountID:   core.StringPtr(accountID),
+		Description: core.StringPtr(fmt.Sprintf("Public service endpoint allowlist of cluster %s", clusterID)),
+		Addresses:   []cbrAddress{},
+	}
+	for _, subnet := range expandStringList(d.Get("subnets").(*schema.Set).List()) {
+		zone.Addresses = append(zone.Addresses, cbrAddress{
+			Type:  core.StringPtr(cbrAddressTypeSubnet),
+			Value: core.StringPtr(subnet),
+		})
+	}
+	return zone
+}
+
+// expandContainerPublicEndpointRule limits the public endpoint to the zone, the second context keeps the private
+// endpoint open because a rule denies every request that matches none of its contexts
+func expandContainerPublicEndpointRule(clusterID, accountID, zoneID string) *cbrRule {
+	return &cbrRule{
+		Description: core.StringPtr(fmt.Sprintf("Public service endpoint allowlist of cluster %s", clusterID)),
+		Contexts: []cbrRuleContext{
+			{
+				Attributes: []cbrRuleAttribute{
+					{Name: core.StringPtr("networkZoneId"), Value: core.StringPtr(zoneID)},
+					{Name: core.StringPtr("endpointType"), Value: core.StringPtr("public")},
+				},
+			},
+			{
+				Attributes: []cbrRuleAttribute{
+					{Name: core.StringPtr("endpointType"), Value: core.StringPtr("private")},
+				},
+			},
+		},
+		Resources: []cbrRuleResource{
+			{
+				Attributes: []cbrRuleAttribute{
+					{Name: core.StringPtr("accountId"), Value: core.StringPtr(accountID)},
+					{Name: core.StringPtr("serviceName"), Value: core.StringPtr(containerCbrServiceName)},
+					{Name: core.StringPtr("serviceInstance"), Value: core.StringPtr(clusterID)},
+				},
+			},
+		},
+		EnforcementMode: core.StringPtr("enabled"),
+	}
+}
+
+func resourceIBMContainerPublicEndpointAllowlistCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cluster := d.Get("cluster").(string)
+	cls, err := csClient.Clusters().GetCluster(cluster, targetEnv)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error retrieving cluster %s: %s", cluster, err))
+	}
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zone, response, err := cbrClient.CreateZone(context, expandContainerPublicEndpointZone(d, cls.ID, userDetails.userAccount))
+	if err != nil {
+		log.Printf("[DEBUG] CreateZone failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating the public service endpoint allowlist zone of cluster %s: %s\n%s", cluster, err, response))
+	}
+
+	rule, response, err := cbrClient.CreateRule(context, expandContainerPublicEndpointRule(cls.ID, userDetails.userAccount, *zone.ID))
+	if err != nil {
+		log.Printf("[DEBUG] CreateRule failed %s\n%s", err, response)
+		if _, zoneErr := cbrClient.DeleteZone(context, *zone.ID); zoneErr != nil {
+			log.Printf("[WARN] Error deleting zone %s after the rule could not be created: %s", *zone.ID, zoneErr)
+		}
+		return diag.FromErr(fmt.Errorf("Error creating the public service endpoint allowlist rule of cluster %s: %s\n%s", cluster, err, response))
+	}
+
+	d.SetId(cls.ID)
+	d.Set("zone_id", zone.ID)
+	d.Set("rule_id", rule.ID)
+	d.Set("resource_group_id", cls.ResourceGroupID)
+
+	_, err = waitForVpcClusterMasterAvailable(d, meta)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for master of cluster (%s) to become ready: %s", d.Id(), err))
+	}
+
+	return resourceIBMContainerPublicEndpointAllowlistRead(context, d, meta)
+}
+
+func resourceIBMContainerPublicEndpointAllowlistRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, response, err := cbrClient.GetRule(context, d.Get("rule_id").(string))
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetRule failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error retrieving the public service endpoint allowlist rule of cluster %s: %s\n%s", d.Id(), err, response))
+	}
+
+	zone, response, err := cbrClient.GetZone(context, d.Get("zone_id").(string))
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetZone failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error retrieving the public service endpoint allowlist zone of cluster %s: %s\n%s", d.Id(), err, response))
+	}
+
+	subnets := []string{}
+	for _, address := range zone.Addresses {
+		if address.Value != nil {
+			subnets = append(subnets, *address.Value)
+		}
+	}
+
+	d.Set("subnets", newStringSet(schema.HashString, subnets))
+
+	return nil
+}
+
+func resourceIBMContainerPublicEndpointAllowlistUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("subnets") {
+		cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		zoneID := d.Get("zone_id").(string)
+
+		// The zone is replaced as a whole, the version of the zone is read first so that it can be sent as If-Match
+		zone, response, err := cbrClient.GetZone(context, zoneID)
+		if err != nil {
+			log.Printf("[DEBUG] GetZone failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error retrieving the public service endpoint allowlist zone of cluster %s: %s\n%s", d.Id(), err, response))
+		}
+		response, err = cbrClient.ReplaceZone(context, zoneID, response.Headers.Get("ETag"), expandContainerPublicEndpointZone(d, d.Id(), *zone.AccountID))
+		if err != nil {
+			log.Printf("[DEBUG] ReplaceZone failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating the public service endpoint allowlist zone of cluster %s: %s\n%s", d.Id(), err, response))
+		}
+
+		_, err = waitForVpcClusterMasterAvailable(d, meta)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(
+				"Error waiting for master of cluster (%s) to become ready: %s", d.Id(), err))
+		}
+	}
+
+	return resourceIBMContainerPublicEndpointAllowlistRead(context, d, meta)
+}
+
+func resourceIBMContainerPublicEndpointAllowlistDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).ContextBasedRestrictionsV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The rule references the zone, so it is deleted first
+	response, err := cbrClient.DeleteRule(context, d.Get("rule_id").(string))
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteRule failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting the public service endpoint allowlist rule of cluster %s: %s\n%s", d.Id(), err, response))
+	}
+	response, err = cbrClient.DeleteZone(context, d.Get("zone_id").(string))
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteZone failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting the public service endpoint allowlist zone of cluster %s: %s\n%s", d.Id(), err, response))
+	}
+
+	_, err = waitForVpcClusterMasterAvailable(d, meta)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"Error waiting for master of cluster (%s) to become ready: %s", d.Id(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMContainerPublicEndpointAllowlistImport takes an ID of the form <cluster_id>/<zone_id>/<rule_id>
+func resourceIBMContainerPublicEndpointAllowlistImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := sepIdParts(d.Id(), "/")
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Incorrect ID %s: ID should be a combination of clusterID/zoneID/ruleID", d.Id())
+	}
+	d.SetId(parts[0])
+	d.Set("cluster", parts[0])
+	d.Set("zone_id", parts[1])
+	d.Set("rule_id", parts[2])
+	return []*schema.ResourceData{d}, nil
+}
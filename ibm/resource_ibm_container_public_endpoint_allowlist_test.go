@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMContainerPublicEndpointAllowlist_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckContainerCluster(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerPublicEndpointAllowlistBasic(`"169.60.0.0/24"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_public_endpoint_allowlist.allowlist", "subnets.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_public_endpoint_allowlist.allowlist", "zone_id"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_public_endpoint_allowlist.allowlist", "rule_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerPublicEndpointAllowlistBasic(`"169.60.0.0/24", "169.61.10.0/28"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_public_endpoint_allowlist.allowlist", "subnets.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_public_endpoint_allowlist.allowlist",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cluster", "resource_group_id"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["ibm_container_public_endpoint_allowlist.allowlist"]
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.ID, rs.Primary.Attributes["zone_id"], rs.Primary.Attributes["rule_id"]), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerPublicEndpointAllowlistBasic(subnets string) string {
+	return fmt.Sprintf(`
+resource "ibm_container_public_endpoint_allowlist" "allowlist" {
+  cluster = "%s"
+  subnets = [%s]
+}`, containerClusterName, subnets)
+}
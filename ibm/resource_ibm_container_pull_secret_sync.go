@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	defaultPullSecretName      = "all-icr-io"
+	defaultPullSecretNamespace = "default"
+)
+
+func resourceIBMContainerPullSecretSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMContainerPullSecretSyncCreate,
+		Read:   resourceIBMContainerPullSecretSyncRead,
+		Update: resourceIBMContainerPullSecretSyncUpdate,
+		Delete: resourceIBMContainerPullSecretSyncDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster name or ID",
+			},
+			"secret_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     defaultPullSecretName,
+				Description: "The name of the image pull secret to sync.",
+			},
+			"source_namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     defaultPullSecretNamespace,
+				Description: "The namespace that contains the image pull secret that is created by the service.",
+			},
+			"namespaces": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The namespaces to sync the pull secret to. If not set, the secret is synced to all namespaces.",
+			},
+			"exclude_namespaces": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The namespaces that are excluded when the secret is synced to all namespaces.",
+			},
+			"delete_on_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Deletes the synced copies of the secret when the resource is destroyed.",
+			},
+			"in_sync": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether all target namespaces contain an up to date copy of the secret. Set by the provider, a drift is corrected on the next apply.",
+			},
+			"synced_namespaces": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The namespaces that contain an up to date copy of the secret.",
+			},
+			"resource_group_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: applyOnce,
+				Description:      "ID of the resource group.",
+			},
+		},
+	}
+}
+
+func resourceIBMContainerPullSecretSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+
+	err := syncContainerPullSecret(cluster, d, meta)
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, d.Get("source_namespace").(string), d.Get("secret_name").(string)))
+
+	return resourceIBMContainerPullSecretSyncRead(d, meta)
+}
+
+func resourceIBMContainerPullSecretSyncRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 3 {
+		return fmt.Errorf("Incorrect ID %s: ID should be a combination of cluster/sourceNamespace/secretName", d.Id())
+	}
+	cluster, sourceNamespace, secretName := parts[0], parts[1], parts[2]
+
+	kube, err := newContainerKubeClient(cluster, d, meta)
+	if err != nil {
+		return err
+	}
+	source, err := kube.getSecret(sourceNamespace, secretName)
+	if err != nil {
+		return err
+	}
+	if source == nil {
+		log.Printf("[WARN] Pull secret %s is not found in namespace %s of cluster %s", secretName, sourceNamespace, cluster)
+		d.SetId("")
+		return nil
+	}
+	targets, err := containerPullSecretTargets(kube, d)
+	if err != nil {
+		return err
+	}
+
+	synced := make([]string, 0, len(targets))
+	for _, namespace := range targets {
+		secret, err := kube.getSecret(namespace, secretName)
+		if err != nil {
+			return err
+		}
+		if secret != nil && secret.Type == source.Type && reflect.DeepEqual(secret.Data, source.Data) {
+			synced = append(synced, namespace)
+		}
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("source_namespace", sourceNamespace)
+	d.Set("secret_name", secretName)
+	d.Set("synced_namespaces", synced)
+	d.Set("in_sync", len(synced) == len(targets))
+
+	return nil
+}
+
+func resourceIBMContainerPullSecretSyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("namespaces") || d.HasChange("exclude_namespaces") || d.HasChange("in_sync") {
+		err := syncContainerPullSecret(d.Get("cluster").(string), d, meta)
+		if err != nil {
+			return err
+		}
+	}
+	return resourceIBMContainerPullSecretSyncRead(d, meta)
+}
+
+func resourceIBMContainerPullSecretSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	if d.Get("delete_on_destroy").(bool) {
+		cluster := d.Get("cluster").(string)
+		kube, err := newContainerKubeClient(cluster, d, meta)
+		if err != nil {
+			return err
+		}
+		for _, namespace := range expandStringList(d.Get("synced_namespaces").([]interface{})) {
+			if namespace == d.Get("source_namespace").(string) {
+				continue
+			}
+			err = kube.deleteSecret(namespace, d.Get("secret_name").(string))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// syncContainerPullSecret copies the pull secret from the source namespace to every target namespace
+// that doesn't contain an up to date copy of it
+func syncContainerPullSecret(cluster string, d *schema.ResourceData, meta interface{}) error {
+	sourceNamespace := d.Get("source_namespace").(string)
+	secretName := d.Get("secret_name").(string)
+
+	kube, err := newContainerKubeClient(cluster, d, meta)
+	if err != nil {
+		return err
+	}
+
+	var source *kubeSecret
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		source, err = kube.getSecret(sourceNamespace, secretName)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if source == nil {
+			// The service creates the pull secret asynchronously after the cluster is created
+			return resource.RetryableError(fmt.Errorf("Pull secret %s is not yet available in namespace %s of cluster %s", secretName, sourceNamespace, cluster))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	targets, err := containerPullSecretTargets(kube, d)
+	if err != nil {
+		return err
+	}
+	for _, namespace := range targets {
+		existing, err := kube.getSecret(namespace, secretName)
+		if err != nil {
+			return err
+		}
+		secret := kubeSecret{
+			APIVersion: "v1",
+			Kind:       "Secret",
+			Type:       source.Type,
+			Data:       source.Data,
+		}
+		secret.Metadata.Name = secretName
+		secret.Metadata.Namespace = namespace
+		if existing == nil {
+			err = kube.createSecret(namespace, secret)
+		} else if existing.Type != source.Type || !reflect.DeepEqual(existing.Data, source.Data) {
+			secret.Metadata.ResourceVersion = existing.Metadata.ResourceVersion
+			err = kube.updateSecret(namespace, secret)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// containerPullSecretTargets returns the namespaces that the secret is synced to, the source namespace excluded
+func containerPullSecretTargets(kube *containerKubeClient, d *schema.ResourceData) ([]string, error) {
+	sourceNamespace := d.Get("source_namespace").(string)
+	var namespaces []string
+	if v, ok := d.GetOk("namespaces"); ok && v.(*schema.Set).Len() > 0 {
+		namespaces = expandStringList(v.(*schema.Set).List())
+	} else {
+		var err error
+		namespaces, err = kube.listNamespaces()
+		if err != nil {
+			return nil, err
+		}
+	}
+	excluded := map[string]bool{sourceNamespace: true}
+	if v, ok := d.GetOk("exclude_namespaces"); ok {
+		for _, namespace := range expandStringList(v.(*schema.Set).List()) {
+			excluded[namespace] = true
+		}
+	}
+
+	targets := make([]string, 0, len(namespaces))
+	for _, namespace := range namespaces {
+		if !excluded[namespace] {
+			targets = append(targets, namespace)
+		}
+	}
+	sort.Strings(targets)
+	return targets, nil
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerPullSecretSync_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckContainerCluster(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerPullSecretSyncBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_pull_secret_sync.sync", "secret_name", "all-icr-io"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_pull_secret_sync.sync", "in_sync", "true"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_pull_secret_sync.sync", "synced_namespaces.#"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerPullSecretSyncNamespaces(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_pull_secret_sync.sync", "synced_namespaces.#", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_pull_secret_sync.sync", "synced_namespaces.0", "kube-public"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerPullSecretSyncBasic() string {
+	return fmt.Sprintf(`
+resource "ibm_container_pull_secret_sync" "sync" {
+  cluster            = "%s"
+  exclude_namespaces = ["kube-system"]
+}`, containerClusterName)
+}
+
+func testAccCheckIBMContainerPullSecretSyncNamespaces() string {
+	return fmt.Sprintf(`
+resource "ibm_container_pull_secret_sync" "sync" {
+  cluster           = "%s"
+  namespaces        = ["kube-public"]
+  delete_on_destroy = true
+}`, containerClusterName)
+}
@@ -593,6 +593,11 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 				"An error occured during EnableKms (cluster: %s) error: %s", d.Id(), err)
 			return err
 		}
+		_, err = waitForVpcClusterMasterAvailable(d, meta)
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for master of cluster (%s) to become ready: %s", d.Id(), err)
+		}
 
 	}
 
@@ -677,14 +682,14 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 					if waitForWorkerUpdate {
 						//1. wait for worker node to delete
-						_, deleteError := waitForWorkerNodetoDelete(d, meta, targetEnv, worker.ID)
+						_, deleteError := waitForWorkerNodetoDelete(d, meta, targetEnv, clusterID, worker.ID)
 						if deleteError != nil {
 							d.Set("patch_version", nil)
 							return fmt.Errorf("[ERROR] Worker node - %s is failed to replace", worker.ID)
 						}
 
 						//2. wait for new workerNode
-						_, newWorkerError := waitForNewWorker(d, meta, targetEnv, workersCount)
+						_, newWorkerError := waitForNewWorker(d, meta, targetEnv, clusterID, workersCount)
 						if newWorkerError != nil {
 							d.Set("patch_version", nil)
 							return fmt.Errorf("[ERROR] Failed to spawn new worker node")
@@ -1272,14 +1277,13 @@ func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID
 	}
 }
 
-func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
+func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, clusterID, workerID string) (interface{}, error) {
 
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
 	}
 
-	clusterID := d.Id()
 	deleteStateConf := &resource.StateChangeConf{
 		Pending: []string{workerDeletePending},
 		Target:  []string{workerDeleteState},
@@ -1301,13 +1305,12 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 	return deleteStateConf.WaitForState()
 }
 
-func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
+func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, clusterID string, workersCount int) (interface{}, error) {
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
 	}
 
-	clusterID := d.Id()
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"creating"},
 		Target:  []string{"created"},
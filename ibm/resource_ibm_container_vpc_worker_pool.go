@@ -32,6 +32,7 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
@@ -78,6 +79,13 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 				},
 			},
 
+			"drain_workers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Cordon and drain the workers of a zone before they are removed when a zone is removed or migrated to a new subnet",
+			},
+
 			"labels": {
 				Type:        schema.TypeMap,
 				Optional:    true,
@@ -140,8 +148,8 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 			"entitlement": {
 				Type:             schema.TypeString,
 				Optional:         true,
-				DiffSuppressFunc: applyOnce,
-				Description:      "Entitlement option reduces additional OCP Licence cost in Openshift Clusters",
+				DiffSuppressFunc: suppressUnknownVpcWorkerPoolEntitlement,
+				Description:      "Entitlement option reduces additional OCP Licence cost in Openshift Clusters. Changing the entitlement replaces the workers of the pool",
 			},
 			ResourceControllerURL: {
 				Type:        schema.TypeString,
@@ -168,42 +176,46 @@ func resourceContainerVPCWorkerPoolValidator() *ResourceValidator {
 
 func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interface{}) error {
 
-	wpClient, err := meta.(ClientSession).VpcContainerAPI()
+	clusterNameorID := d.Get("cluster").(string)
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return err
 	}
 
-	clusterNameorID := d.Get("cluster").(string)
-	var zonei []interface{}
-
-	zone := []v2.Zone{}
+	workerPoolID, err := createVpcWorkerPool(d, meta, d.Get("worker_pool_name").(string), d.Get("entitlement").(string), expandVpcWorkerPoolZones(d.Get("zones").(*schema.Set).List()), targetEnv)
+	if err != nil {
+		return err
+	}
 
-	if res, ok := d.GetOk("zones"); ok {
-		zonei = res.(*schema.Set).List()
-		for _, e := range zonei {
-			r, _ := e.(map[string]interface{})
-			zoneParam := v2.Zone{
-				ID:       r["name"].(string),
-				SubnetID: r["subnet_id"].(string),
-			}
-			zone = append(zone, zoneParam)
-		}
+	d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, workerPoolID))
 
+	//wait for workerpool availability
+	_, err = WaitForWorkerPoolAvailable(d, meta, clusterNameorID, workerPoolID, d.Timeout(schema.TimeoutCreate), targetEnv)
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
 	}
 
-	// for _, e := range d.Get("zones").(*schema.Set).List() {
-	// 	value := e.(map[string]interface{})
-	// 	id := value["id"].(string)
-	// 	subnetid := value["subnet_id"].(string)
+	return resourceIBMContainerVpcWorkerPoolUpdate(d, meta)
+}
 
-	// }
+// createVpcWorkerPool creates a worker pool with the given name, entitlement and zones from the configuration of the resource
+func createVpcWorkerPool(d *schema.ResourceData, meta interface{}, name, entitlement string, zone []v2.Zone, targetEnv v2.ClusterTargetHeader) (string, error) {
+	wpClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return "", err
+	}
+
+	clusterNameorID := d.Get("cluster").(string)
 
 	workerPoolConfig := v2.WorkerPoolConfig{
-		Name:        d.Get("worker_pool_name").(string),
+		Name:        name,
 		VpcID:       d.Get("vpc_id").(string),
 		Flavor:      d.Get("flavor").(string),
 		WorkerCount: d.Get("worker_count").(int),
 		Zones:       zone,
+		// Entitlement option reduces the OCP licence cost of the workers, it can only be set when the pool is created
+		Entitlement: entitlement,
 	}
 
 	if l, ok := d.GetOk("labels"); ok {
@@ -218,36 +230,360 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 		Cluster:          clusterNameorID,
 	}
 
-	// Update workerpoolConfig with Entitlement option if provided
-	if v, ok := d.GetOk("entitlement"); ok {
-		workerPoolConfig.Entitlement = v.(string)
+	res, err := wpClient.WorkerPools().CreateWorkerPool(params, targetEnv)
+	if err != nil {
+		return "", err
+	}
+	return res.ID, nil
+}
+
+func expandVpcWorkerPoolZones(zones []interface{}) []v2.Zone {
+	zone := []v2.Zone{}
+	for _, e := range zones {
+		r, _ := e.(map[string]interface{})
+		zoneParam := v2.Zone{
+			ID:       r["name"].(string),
+			SubnetID: r["subnet_id"].(string),
+		}
+		zone = append(zone, zoneParam)
 	}
+	return zone
+}
 
-	workerPoolsAPI := wpClient.WorkerPools()
+// suppressUnknownVpcWorkerPoolEntitlement suppresses the diff of the entitlement of an existing pool whose state has
+// none, the API doesn't return the entitlement so it isn't known for imported pools or pools created by older versions
+func suppressUnknownVpcWorkerPoolEntitlement(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == ""
+}
+
+// replaceVpcWorkerPoolEntitlement rolls the workers of the pool to the new entitlement. The entitlement of a pool
+// can't be updated, so a temporary pool with the new entitlement takes over the workload while the pool is
+// recreated with its original name.
+func replaceVpcWorkerPoolEntitlement(d *schema.ResourceData, meta interface{}) error {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	clusterNameOrID := parts[0]
+	workerPoolID := parts[1]
+	workerPoolName := d.Get("worker_pool_name").(string)
+	entitlement := d.Get("entitlement").(string)
+	zones := expandVpcWorkerPoolZones(d.Get("zones").(*schema.Set).List())
 	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return err
 	}
 
-	res, err := workerPoolsAPI.CreateWorkerPool(params, targetEnv)
+	surgePoolID, err := createVpcWorkerPoolAndWait(d, meta, workerPoolName+"-entitlement", entitlement, zones, targetEnv)
 	if err != nil {
 		return err
 	}
+	err = deleteVpcWorkerPoolAndWait(d, meta, clusterNameOrID, workerPoolID, targetEnv)
+	if err != nil {
+		return err
+	}
+	newWorkerPoolID, err := createVpcWorkerPoolAndWait(d, meta, workerPoolName, entitlement, zones, targetEnv)
+	if err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("%s/%s", clusterNameOrID, newWorkerPoolID))
 
-	d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, res.ID))
+	return deleteVpcWorkerPoolAndWait(d, meta, clusterNameOrID, surgePoolID, targetEnv)
+}
 
-	//wait for workerpool availability
-	_, err = WaitForWorkerPoolAvailable(d, meta, clusterNameorID, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
+// createVpcWorkerPoolAndWait creates a worker pool with the flavor, worker count, labels and taints of the resource
+// and waits until its workers are deployed
+func createVpcWorkerPoolAndWait(d *schema.ResourceData, meta interface{}, name, entitlement string, zones []v2.Zone, targetEnv v2.ClusterTargetHeader) (string, error) {
+	wpClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return "", err
+	}
+	clusterNameOrID := d.Get("cluster").(string)
+
+	workers, err := wpClient.Workers().ListWorkers(clusterNameOrID, false, targetEnv)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+
+	log.Printf("[INFO] Creating worker pool %s of cluster %s", name, clusterNameOrID)
+	id, err := createVpcWorkerPool(d, meta, name, entitlement, zones, targetEnv)
+	if err != nil {
+		return "", fmt.Errorf("Error creating worker pool %s of cluster %s: %s", name, clusterNameOrID, err)
+	}
+	_, err = waitForNewWorker(d, meta, targetEnv, clusterNameOrID, len(workers)+len(zones)*d.Get("worker_count").(int))
+	if err != nil {
+		return "", fmt.Errorf("Error waiting for the workers of worker pool (%s) of cluster (%s) to be created: %s", name, clusterNameOrID, err)
+	}
+	_, err = WaitForWorkerPoolAvailable(d, meta, clusterNameOrID, id, d.Timeout(schema.TimeoutUpdate), targetEnv)
+	if err != nil {
+		return "", fmt.Errorf("Error waiting for worker pool (%s) of cluster (%s) to become ready: %s", name, clusterNameOrID, err)
+	}
+	if _, ok := d.GetOk("taints"); ok {
+		err = wpClient.WorkerPools().UpdateWorkerPoolTaints(expandWorkerPoolTaints(d, meta, clusterNameOrID, id), targetEnv)
+		if err != nil {
+			return "", fmt.Errorf("[ERROR] Error updating the taints of worker pool %s: %s", name, err)
+		}
+	}
+	return id, nil
+}
+
+// deleteVpcWorkerPoolAndWait drains the workers of the pool when drain_workers is set, deletes the pool and
+// waits until its workers are deleted
+func deleteVpcWorkerPoolAndWait(d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolID string, targetEnv v2.ClusterTargetHeader) error {
+	wpClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	workers, err := wpClient.Workers().ListByWorkerPool(clusterNameOrID, workerPoolID, false, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers of worker pool %s: %s", workerPoolID, err)
+	}
+	err = drainVpcWorkers(d, meta, clusterNameOrID, workers)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting worker pool %s of cluster %s", workerPoolID, clusterNameOrID)
+	err = wpClient.WorkerPools().DeleteWorkerPool(clusterNameOrID, workerPoolID, targetEnv)
+	if err != nil {
+		return fmt.Errorf("Error deleting worker pool %s of cluster %s: %s", workerPoolID, clusterNameOrID, err)
+	}
+	_, err = WaitForVpcWorkerDelete(clusterNameOrID, workerPoolID, meta, d.Timeout(schema.TimeoutUpdate), targetEnv)
+	if err != nil {
+		return fmt.Errorf("Error waiting for removing workers of worker pool (%s) of cluster (%s): %s", workerPoolID, clusterNameOrID, err)
+	}
+	return nil
+}
+
+// updateVpcWorkerPoolZones rebalances the worker pool across the changed zones. New zones are added first so
+// that the capacity of the pool doesn't drop, then the zones whose subnet changed are migrated and finally the
+// removed zones are drained and deleted.
+func updateVpcWorkerPoolZones(d *schema.ResourceData, meta interface{}) error {
+	clusterID := d.Get("cluster").(string)
+	workerPoolName := d.Get("worker_pool_name").(string)
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+
+	oldList, newList := d.GetChange("zones")
+	if oldList == nil {
+		oldList = new(schema.Set)
+	}
+	if newList == nil {
+		newList = new(schema.Set)
+	}
+	oldZones := oldList.(*schema.Set)
+	newZones := newList.(*schema.Set)
+	remove := expandVpcWorkerPoolZones(oldZones.Difference(newZones).List())
+	add := expandVpcWorkerPoolZones(newZones.Difference(oldZones).List())
+
+	removed := make(map[string]v2.Zone, len(remove))
+	for _, zone := range remove {
+		removed[zone.ID] = zone
+	}
+	migrate := []v2.Zone{}
+	for _, zone := range add {
+		if _, ok := removed[zone.ID]; ok {
+			migrate = append(migrate, zone)
+			delete(removed, zone.ID)
+			continue
+		}
+
+		workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+		}
+		err = addVpcWorkerPoolZone(d, meta, clusterID, workerPoolName, zone, targetEnv)
+		if err != nil {
+			return err
+		}
+		_, err = waitForNewWorker(d, meta, targetEnv, clusterID, len(workers)+d.Get("worker_count").(int))
+		if err != nil {
+			return fmt.Errorf("Error waiting for the workers of zone %s of worker pool (%s) to be created: %s", zone.ID, workerPoolName, err)
+		}
+		_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutUpdate), targetEnv)
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+		}
+	}
+
+	for _, zone := range migrate {
+		err = migrateVpcWorkerPoolZone(d, meta, clusterID, workerPoolName, zone, targetEnv)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, zone := range remove {
+		if _, ok := removed[zone.ID]; !ok {
+			continue
+		}
+		err = removeVpcWorkerPoolZone(d, meta, clusterID, workerPoolName, zone.ID, targetEnv)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migrateVpcWorkerPoolZone moves the workers of a zone to a new subnet. The subnet of a zone can't be changed in
+// place, so a temporary pool in the new subnet takes over the workload of the zone while the zone is removed and
+// added again with the new subnet.
+func migrateVpcWorkerPoolZone(d *schema.ResourceData, meta interface{}, clusterID, workerPoolName string, zone v2.Zone, targetEnv v2.ClusterTargetHeader) error {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	log.Printf("[INFO] Migrating zone %s of worker pool %s of cluster %s to subnet %s", zone.ID, workerPoolName, clusterID, zone.SubnetID)
+
+	surgePoolID, err := createVpcWorkerPoolAndWait(d, meta, fmt.Sprintf("%s-%s", workerPoolName, zone.ID), d.Get("entitlement").(string), []v2.Zone{zone}, targetEnv)
+	if err != nil {
+		return err
+	}
+
+	err = removeVpcWorkerPoolZone(d, meta, clusterID, workerPoolName, zone.ID, targetEnv)
+	if err != nil {
+		return err
+	}
+
+	workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+	err = addVpcWorkerPoolZone(d, meta, clusterID, workerPoolName, zone, targetEnv)
+	if err != nil {
+		return err
+	}
+	_, err = waitForNewWorker(d, meta, targetEnv, clusterID, len(workers)+d.Get("worker_count").(int))
+	if err != nil {
+		return fmt.Errorf("Error waiting for the workers of zone %s of worker pool (%s) to be created: %s", zone.ID, workerPoolName, err)
+	}
+	_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutUpdate), targetEnv)
 	if err != nil {
 		return fmt.Errorf(
 			"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
 	}
 
-	return resourceIBMContainerVpcWorkerPoolUpdate(d, meta)
+	return deleteVpcWorkerPoolAndWait(d, meta, clusterID, surgePoolID, targetEnv)
+}
+
+func addVpcWorkerPoolZone(d *schema.ResourceData, meta interface{}, clusterID, workerPoolName string, zone v2.Zone, targetEnv v2.ClusterTargetHeader) error {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	zoneParam := v2.WorkerPoolZone{
+		Cluster:      clusterID,
+		Id:           zone.ID,
+		SubnetID:     zone.SubnetID,
+		WorkerPoolID: workerPoolName,
+	}
+	err = csClient.WorkerPools().CreateWorkerPoolZone(zoneParam, targetEnv)
+	if err != nil {
+		return fmt.Errorf("Error adding zone to conatiner vpc cluster: %s", err)
+	}
+	return nil
+}
+
+// removeVpcWorkerPoolZone drains the workers of the zone when drain_workers is set, removes the zone from the
+// pool and waits until each of its workers is deleted
+func removeVpcWorkerPoolZone(d *schema.ResourceData, meta interface{}, clusterID, workerPoolName, zone string, targetEnv v2.ClusterTargetHeader) error {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	poolWorkers, err := csClient.Workers().ListByWorkerPool(clusterID, workerPoolName, false, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers of worker pool %s: %s", workerPoolName, err)
+	}
+	workers := []v2.Worker{}
+	for _, worker := range poolWorkers {
+		if worker.Location == zone {
+			workers = append(workers, worker)
+		}
+	}
+	err = drainVpcWorkers(d, meta, clusterID, workers)
+	if err != nil {
+		return err
+	}
+
+	ClusterClient, err := meta.(ClientSession).ContainerAPI()
+	if err != nil {
+		return err
+	}
+	Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
+	err = ClusterClient.WorkerPools().RemoveZone(clusterID, zone, workerPoolName, Env)
+	if err != nil {
+		return fmt.Errorf("Error deleting zone to conatiner vpc cluster: %s", err)
+	}
+	for _, worker := range workers {
+		_, err = waitForWorkerNodetoDelete(d, meta, targetEnv, clusterID, worker.ID)
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for deleting worker (%s) of worker pool (%s) of cluster (%s): %s", worker.ID, workerPoolName, clusterID, err)
+		}
+	}
+	_, err = WaitForV2WorkerZoneDeleted(clusterID, workerPoolName, zone, meta, d.Timeout(schema.TimeoutDelete), targetEnv)
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPoolName, clusterID, err)
+	}
+	return nil
+}
+
+// drainVpcWorkers cordons the Kubernetes nodes of the workers and evicts their pods when drain_workers is set.
+// The nodes of VPC workers are named after the primary IP address of the worker.
+func drainVpcWorkers(d *schema.ResourceData, meta interface{}, clusterID string, workers []v2.Worker) error {
+	if !d.Get("drain_workers").(bool) || len(workers) == 0 {
+		return nil
+	}
+	kube, err := newContainerKubeClient(clusterID, d, meta)
+	if err != nil {
+		return err
+	}
+
+	nodes := make([]string, 0, len(workers))
+	for _, worker := range workers {
+		for _, network := range worker.NetworkInterfaces {
+			if network.Primary {
+				nodes = append(nodes, network.IpAddress)
+			}
+		}
+	}
+	for _, node := range nodes {
+		log.Printf("[INFO] Cordoning node %s of cluster %s", node, clusterID)
+		err = kube.cordonNode(node)
+		if err != nil {
+			return err
+		}
+	}
+	for _, node := range nodes {
+		log.Printf("[INFO] Draining node %s of cluster %s", node, clusterID)
+		err = kube.drainNode(node, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 
+	// The pool is recreated from the configuration, so the other changes are applied with the replacement
+	if d.HasChange("entitlement") && !d.IsNewResource() {
+		err := replaceVpcWorkerPoolEntitlement(d, meta)
+		if err != nil {
+			return err
+		}
+		return resourceIBMContainerVpcWorkerPoolRead(d, meta)
+	}
+
 	if d.HasChange("labels") && !d.IsNewResource() {
 		clusterNameOrID := d.Get("cluster").(string)
 		workerPoolName := d.Get("worker_pool_name").(string)
@@ -316,67 +652,10 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 	}
 
 	if d.HasChange("zones") && !d.IsNewResource() {
-		clusterID := d.Get("cluster").(string)
-		workerPoolName := d.Get("worker_pool_name").(string)
-		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		err := updateVpcWorkerPoolZones(d, meta)
 		if err != nil {
 			return err
 		}
-		oldList, newList := d.GetChange("zones")
-		if oldList == nil {
-			oldList = new(schema.Set)
-		}
-		if newList == nil {
-			newList = new(schema.Set)
-		}
-		os := oldList.(*schema.Set)
-		ns := newList.(*schema.Set)
-		remove := os.Difference(ns).List()
-		add := ns.Difference(os).List()
-		if len(add) > 0 {
-			csClient, err := meta.(ClientSession).VpcContainerAPI()
-			if err != nil {
-				return err
-			}
-			for _, zone := range add {
-				newZone := zone.(map[string]interface{})
-				zoneParam := v2.WorkerPoolZone{
-					Cluster:      clusterID,
-					Id:           newZone["name"].(string),
-					SubnetID:     newZone["subnet_id"].(string),
-					WorkerPoolID: workerPoolName,
-				}
-				err = csClient.WorkerPools().CreateWorkerPoolZone(zoneParam, targetEnv)
-				if err != nil {
-					return fmt.Errorf("Error adding zone to conatiner vpc cluster: %s", err)
-				}
-				_, err = WaitForWorkerPoolAvailable(d, meta, clusterID, workerPoolName, d.Timeout(schema.TimeoutCreate), targetEnv)
-				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
-				}
-
-			}
-		}
-		if len(remove) > 0 {
-			for _, zone := range remove {
-				oldZone := zone.(map[string]interface{})
-				ClusterClient, err := meta.(ClientSession).ContainerAPI()
-				if err != nil {
-					return err
-				}
-				Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
-				err = ClusterClient.WorkerPools().RemoveZone(clusterID, oldZone["name"].(string), workerPoolName, Env)
-				if err != nil {
-					return fmt.Errorf("Error deleting zone to conatiner vpc cluster: %s", err)
-				}
-				_, err = WaitForV2WorkerZoneDeleted(clusterID, workerPoolName, oldZone["name"].(string), meta, d.Timeout(schema.TimeoutDelete), targetEnv)
-				if err != nil {
-					return fmt.Errorf(
-						"Error waiting for deleting workers of worker pool (%s) of cluster (%s):  %s", workerPoolName, clusterID, err)
-				}
-			}
-		}
 	}
 	return resourceIBMContainerVpcWorkerPoolRead(d, meta)
 }
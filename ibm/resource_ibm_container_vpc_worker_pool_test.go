@@ -203,3 +203,91 @@ func testAccCheckIBMVpcContainerWorkerPoolUpdate(name string) string {
 	}
 		`, name)
 }
+
+func TestAccIBMContainerVpcClusterWorkerPoolZoneMigration(t *testing.T) {
+
+	name := fmt.Sprintf("tf-vpc-worker-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMVpcContainerWorkerPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolZoneMigration(name, "subnet2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "zones.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_container_vpc_worker_pool.test_pool", "zones.0.subnet_id", "ibm_is_subnet.subnet2", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMVpcContainerWorkerPoolZoneMigration(name, "subnet3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "zones.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"ibm_container_vpc_worker_pool.test_pool", "zones.0.subnet_id", "ibm_is_subnet.subnet3", "id"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "worker_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMVpcContainerWorkerPoolZoneMigration(name, subnet string) string {
+	return fmt.Sprintf(`
+	provider "ibm" {
+		region="eu-de"
+	}
+	data "ibm_resource_group" "resource_group" {
+		is_default=true
+	}
+	resource "ibm_is_vpc" "vpc" {
+	  name = "%[1]s"
+	}
+	resource "ibm_is_subnet" "subnet1" {
+	  name                     = "%[1]s-1"
+	  vpc                      = ibm_is_vpc.vpc.id
+	  zone                     = "eu-de-1"
+	  total_ipv4_address_count = 256
+	}
+	resource "ibm_is_subnet" "subnet2" {
+	  name                     = "%[1]s-2"
+	  vpc                      = ibm_is_vpc.vpc.id
+	  zone                     = "eu-de-2"
+	  total_ipv4_address_count = 256
+	}
+	resource "ibm_is_subnet" "subnet3" {
+	  name                     = "%[1]s-3"
+	  vpc                      = ibm_is_vpc.vpc.id
+	  zone                     = "eu-de-2"
+	  total_ipv4_address_count = 256
+	}
+	resource "ibm_container_vpc_cluster" "cluster" {
+	  name              = "%[1]s"
+	  vpc_id            = ibm_is_vpc.vpc.id
+	  flavor            = "cx2.2x4"
+	  worker_count      = 1
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  wait_till         = "MasterNodeReady"
+	  zones {
+		subnet_id = ibm_is_subnet.subnet1.id
+		name      = "eu-de-1"
+	  }
+	}
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+	  cluster           = ibm_container_vpc_cluster.cluster.id
+	  worker_pool_name  = "%[1]s"
+	  flavor            = "cx2.2x4"
+	  vpc_id            = ibm_is_vpc.vpc.id
+	  worker_count      = 1
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  zones {
+		name      = "eu-de-2"
+		subnet_id = ibm_is_subnet.%[2]s.id
+	  }
+	}
+		`, name, subnet)
+}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
@@ -257,6 +259,166 @@ func resourceIBMCOSBucket() *schema.Resource {
 					},
 				},
 			},
+			"noncurrent_version_expiration": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1000,
+				Description: "Enable configuration noncurrent_version_expiration to COS Bucket to delete noncurrent versions of objects after a defined period of time",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Unique identifier for the rule. Set Rule ID for cos bucket",
+						},
+						"enable": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Enable or disable the noncurrent version expiration rule for a bucket",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The rule applies to any objects with keys that match this prefix",
+						},
+						"noncurrent_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateAllowedRangeInt(1, 3650),
+							Description:  "Specifies the number of days an object is noncurrent before the version is deleted.",
+						},
+					},
+				},
+			},
+			"abort_incomplete_multipart_upload_days": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1000,
+				Description: "Enable configuration abort_incomplete_multipart_upload_days to COS Bucket to stop incomplete multipart uploads and delete their parts after a defined period of time",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Unique identifier for the rule. Set Rule ID for cos bucket",
+						},
+						"enable": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Enable or disable the abort incomplete multipart upload rule for a bucket",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The rule applies to any objects with keys that match this prefix",
+						},
+						"days_after_initiation": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validateAllowedRangeInt(1, 3650),
+							Description:  "Specifies the number of days after the start of an upload when the incomplete upload is stopped.",
+						},
+					},
+				},
+			},
+			"object_lock_configuration": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"retention_rule"},
+				Description:   "Enable Object Lock on the COS Bucket to store objects in a write-once-read-many model. Object Lock needs object versioning, and it can't be disabled once it is enabled, so removing the block keeps the configuration of the bucket.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_lock_enabled": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Enabled",
+							ValidateFunc: validateAllowedStringValue([]string{"Enabled"}),
+							Description:  "Enable Object Lock on the bucket, the only value is Enabled",
+						},
+						"object_lock_rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The default retention of new objects in the bucket",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_retention": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"mode": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													Default:      "COMPLIANCE",
+													ValidateFunc: validateAllowedStringValue([]string{"COMPLIANCE"}),
+													Description:  "The retention mode of new objects, the only mode is COMPLIANCE",
+												},
+												"days": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Description: "The number of days that new objects are retained",
+												},
+												"years": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Description: "The number of years that new objects are retained",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    100,
+				Description: "Cross-origin resource sharing (CORS) rules that allow web applications on other domains to access the objects of the COS Bucket",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The headers that are allowed in a preflight request",
+						},
+						"allowed_methods": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateAllowedStringValue([]string{"GET", "PUT", "HEAD", "POST", "DELETE"})},
+							Description: "The HTTP methods that an origin is allowed to run, GET, PUT, HEAD, POST or DELETE",
+						},
+						"allowed_origins": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The origins that are allowed to access the bucket",
+						},
+						"expose_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The headers in the response that customers are able to access from their applications",
+						},
+						"max_age_seconds": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The time in seconds that a browser caches the preflight response",
+						},
+					},
+				},
+			},
 			"retention_rule": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -425,6 +587,111 @@ func expireRuleList(expireList []interface{}) []*s3.LifecycleRule {
 	return rules
 }
 
+func noncurrentVersionExpirationRuleList(noncurrentList []interface{}) []*cosLifecycleRule {
+	var rules []*cosLifecycleRule
+	for _, l := range noncurrentList {
+		noncurrentMap, _ := l.(map[string]interface{})
+		status := "Disabled"
+		if noncurrentMap["enable"].(bool) {
+			status = "Enabled"
+		}
+		rule := &cosLifecycleRule{
+			ID:     aws.String(noncurrentMap["rule_id"].(string)),
+			Status: aws.String(status),
+			Filter: &s3.LifecycleRuleFilter{
+				Prefix: aws.String(noncurrentMap["prefix"].(string)),
+			},
+			NoncurrentVersionExpiration: &cosNoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int64(int64(noncurrentMap["noncurrent_days"].(int))),
+			},
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func abortIncompleteMultipartUploadRuleList(multipartList []interface{}) []*cosLifecycleRule {
+	var rules []*cosLifecycleRule
+	for _, l := range multipartList {
+		multipartMap, _ := l.(map[string]interface{})
+		status := "Disabled"
+		if multipartMap["enable"].(bool) {
+			status = "Enabled"
+		}
+		rule := &cosLifecycleRule{
+			ID:     aws.String(multipartMap["rule_id"].(string)),
+			Status: aws.String(status),
+			Filter: &s3.LifecycleRuleFilter{
+				Prefix: aws.String(multipartMap["prefix"].(string)),
+			},
+			AbortIncompleteMultipartUpload: &cosAbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(multipartMap["days_after_initiation"].(int))),
+			},
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func expandCosObjectLockConfiguration(objectLockList []interface{}) *cosObjectLockConfiguration {
+	// Object Lock can't be disabled, a configuration without a rule only removes the default retention
+	config := &cosObjectLockConfiguration{
+		ObjectLockEnabled: aws.String("Enabled"),
+	}
+	if len(objectLockList) == 0 || objectLockList[0] == nil {
+		return config
+	}
+	objectLockMap := objectLockList[0].(map[string]interface{})
+	if ruleList := objectLockMap["object_lock_rule"].([]interface{}); len(ruleList) > 0 && ruleList[0] != nil {
+		retentionList := ruleList[0].(map[string]interface{})["default_retention"].([]interface{})
+		if len(retentionList) > 0 && retentionList[0] != nil {
+			retentionMap := retentionList[0].(map[string]interface{})
+			retention := &cosDefaultRetention{
+				Mode: aws.String(retentionMap["mode"].(string)),
+			}
+			if days := retentionMap["days"].(int); days > 0 {
+				retention.Days = aws.Int64(int64(days))
+			}
+			if years := retentionMap["years"].(int); years > 0 {
+				retention.Years = aws.Int64(int64(years))
+			}
+			config.Rule = &cosObjectLockRule{DefaultRetention: retention}
+		}
+	}
+	return config
+}
+
+func expandCosCORSRules(corsList []interface{}) []*s3.CORSRule {
+	var rules []*s3.CORSRule
+	for _, l := range corsList {
+		corsMap, _ := l.(map[string]interface{})
+		rule := &s3.CORSRule{
+			AllowedHeaders: aws.StringSlice(expandStringList(corsMap["allowed_headers"].([]interface{}))),
+			AllowedMethods: aws.StringSlice(expandStringList(corsMap["allowed_methods"].([]interface{}))),
+			AllowedOrigins: aws.StringSlice(expandStringList(corsMap["allowed_origins"].([]interface{}))),
+			ExposeHeaders:  aws.StringSlice(expandStringList(corsMap["expose_headers"].([]interface{}))),
+		}
+		if maxAge := corsMap["max_age_seconds"].(int); maxAge > 0 {
+			rule.MaxAgeSeconds = aws.Int64(int64(maxAge))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// cosConfigurationNotFound returns whether the error is a missing bucket configuration, or the
+// bucket firewall denied to read it.
+func cosConfigurationNotFound(err error, firewall bool) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case "NoSuchLifecycleConfiguration", "NoSuchCORSConfiguration", "NoSuchWebsiteConfiguration",
+			"ObjectLockConfigurationNotFoundError", "ReplicationConfigurationNotFoundError", "NoSuchObjectLockConfiguration":
+			return true
+		}
+	}
+	return firewall && strings.Contains(err.Error(), "AccessDenied: Access Denied")
+}
+
 func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(ClientSession).BluemixSession()
@@ -463,26 +730,38 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
 
-	//// Update  the lifecycle (Archive or Expire)
-	if d.HasChange("archive_rule") || d.HasChange("expire_rule") {
+	//// Update  the lifecycle (Archive, Expire, Noncurrent version expiration or Abort incomplete multipart upload)
+	if d.HasChanges("archive_rule", "expire_rule", "noncurrent_version_expiration", "abort_incomplete_multipart_upload_days") {
 		var archive, archive_ok = d.GetOk("archive_rule")
 		var expire, expire_ok = d.GetOk("expire_rule")
-		var rules []*s3.LifecycleRule
-		if archive_ok || expire_ok {
+		var noncurrent, noncurrent_ok = d.GetOk("noncurrent_version_expiration")
+		var multipart, multipart_ok = d.GetOk("abort_incomplete_multipart_upload_days")
+		var rules []*cosLifecycleRule
+		if archive_ok || expire_ok || noncurrent_ok || multipart_ok {
 			if expire_ok {
-				rules = append(rules, expireRuleList(expire.([]interface{}))...)
+				for _, rule := range expireRuleList(expire.([]interface{})) {
+					rules = append(rules, cosLifecycleRuleFromS3(rule))
+				}
 			}
 			if archive_ok {
-				rules = append(rules, archiveRuleList(archive.([]interface{}))...)
+				for _, rule := range archiveRuleList(archive.([]interface{})) {
+					rules = append(rules, cosLifecycleRuleFromS3(rule))
+				}
+			}
+			if noncurrent_ok {
+				rules = append(rules, noncurrentVersionExpirationRuleList(noncurrent.([]interface{}))...)
+			}
+			if multipart_ok {
+				rules = append(rules, abortIncompleteMultipartUploadRuleList(multipart.([]interface{}))...)
 			}
 
-			lInput := &s3.PutBucketLifecycleConfigurationInput{
+			lInput := &cosPutBucketLifecycleConfigurationInput{
 				Bucket: aws.String(bucketName),
-				LifecycleConfiguration: &s3.LifecycleConfiguration{
+				LifecycleConfiguration: &cosLifecycleConfiguration{
 					Rules: rules,
 				},
 			}
-			_, err := s3Client.PutBucketLifecycleConfiguration(lInput)
+			err := cosPutBucketLifecycleConfiguration(context.Background(), s3Client, lInput)
 			if err != nil {
 				return fmt.Errorf("failed to update the archive rule on COS bucket %s, %v", bucketName, err)
 			}
@@ -586,6 +865,41 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	//update the object lock, it needs object versioning
+	if d.HasChange("object_lock_configuration") {
+		input := &cosPutObjectLockConfigurationInput{
+			Bucket:                  aws.String(bucketName),
+			ObjectLockConfiguration: expandCosObjectLockConfiguration(d.Get("object_lock_configuration").([]interface{})),
+		}
+		err := cosPutObjectLockConfiguration(context.Background(), s3Client, input)
+		if err != nil {
+			return fmt.Errorf("failed to update the object lock configuration on COS bucket %s, %v", bucketName, err)
+		}
+	}
+
+	//update the CORS rules
+	if d.HasChange("cors_rule") {
+		if cors, ok := d.GetOk("cors_rule"); ok {
+			input := &s3.PutBucketCorsInput{
+				Bucket: aws.String(bucketName),
+				CORSConfiguration: &s3.CORSConfiguration{
+					CORSRules: expandCosCORSRules(cors.([]interface{})),
+				},
+			}
+			_, err := s3Client.PutBucketCors(input)
+			if err != nil {
+				return fmt.Errorf("failed to update the CORS rules on COS bucket %s, %v", bucketName, err)
+			}
+		} else {
+			_, err := s3Client.DeleteBucketCors(&s3.DeleteBucketCorsInput{
+				Bucket: aws.String(bucketName),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to delete the CORS rules on COS bucket %s, %v", bucketName, err)
+			}
+		}
+	}
+
 	sess, err := meta.(ClientSession).CosConfigV1API()
 	if err != nil {
 		return err
@@ -815,25 +1129,37 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	// Read the lifecycle configuration (archive & expiration)
 
-	gInput := &s3.GetBucketLifecycleConfigurationInput{
+	gInput := &cosGetBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucketName),
 	}
 
-	lifecycleptr, err := s3Client.GetBucketLifecycleConfiguration(gInput)
+	lifecycleptr, err := cosGetBucketLifecycleConfiguration(context.Background(), s3Client, gInput)
 
 	if (err != nil && !strings.Contains(err.Error(), "NoSuchLifecycleConfiguration: The lifecycle configuration does not exist")) && (err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied")) {
 		return err
 	}
 
 	if lifecycleptr != nil {
-		archiveRules := archiveRuleGet(lifecycleptr.Rules)
-		expireRules := expireRuleGet(lifecycleptr.Rules)
+		var s3Rules []*s3.LifecycleRule
+		for _, rule := range lifecycleptr.Rules {
+			s3Rules = append(s3Rules, rule.s3Rule())
+		}
+		archiveRules := archiveRuleGet(s3Rules)
+		expireRules := expireRuleGet(s3Rules)
+		noncurrentRules := noncurrentVersionExpirationRuleGet(lifecycleptr.Rules)
+		multipartRules := abortIncompleteMultipartUploadRuleGet(lifecycleptr.Rules)
 		if len(archiveRules) > 0 {
 			d.Set("archive_rule", archiveRules)
 		}
 		if len(expireRules) > 0 {
 			d.Set("expire_rule", expireRules)
 		}
+		if len(noncurrentRules) > 0 {
+			d.Set("noncurrent_version_expiration", noncurrentRules)
+		}
+		if len(multipartRules) > 0 {
+			d.Set("abort_incomplete_multipart_upload_days", multipartRules)
+		}
 	}
 
 	// Read retention rule
@@ -870,6 +1196,32 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("object_versioning", nil)
 		}
 	}
+
+	firewall := bucketPtr != nil && bucketPtr.Firewall != nil
+
+	// Read Object Lock
+	objectLockPtr, err := cosGetObjectLockConfiguration(context.Background(), s3Client, &cosBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil && !cosConfigurationNotFound(err, firewall) {
+		return fmt.Errorf("failed to read the object lock configuration of COS bucket %s, %v", bucketName, err)
+	}
+	if err == nil && objectLockPtr != nil {
+		d.Set("object_lock_configuration", flattenCosObjectLockConfiguration(objectLockPtr.ObjectLockConfiguration))
+	}
+
+	// Read CORS rules
+	corsPtr, err := s3Client.GetBucketCors(&s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil && !cosConfigurationNotFound(err, firewall) {
+		return fmt.Errorf("failed to read the CORS rules of COS bucket %s, %v", bucketName, err)
+	}
+	if err == nil && corsPtr != nil {
+		d.Set("cors_rule", flattenCosCORSRules(corsPtr.CORSRules))
+	} else if err != nil && !firewall {
+		d.Set("cors_rule", nil)
+	}
 	return nil
 }
 
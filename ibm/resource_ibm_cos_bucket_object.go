@@ -6,11 +6,17 @@ package ibm
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
+	"mime"
+	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"time"
@@ -22,10 +28,16 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// cosObjectBodyMaxSize is the largest object whose body is saved in the state
+const cosObjectBodyMaxSize = 1024 * 1024
+
 func resourceIBMCOSBucketObject() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIBMCOSBucketObjectCreate,
@@ -34,6 +46,12 @@ func resourceIBMCOSBucketObject() *schema.Resource {
 		DeleteContext: resourceIBMCOSBucketObjectDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMCOSBucketObjectContentHashDiff(diff)
+			},
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -75,6 +93,11 @@ func resourceIBMCOSBucketObject() *schema.Resource {
 				ConflictsWith: []string{"content", "content_base64"},
 				Description:   "COS object content file path",
 			},
+			"content_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 hexdigest of the local object content, a new hash uploads the object again",
+			},
 			"content_length": {
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -82,8 +105,9 @@ func resourceIBMCOSBucketObject() *schema.Resource {
 			},
 			"content_type": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
-				Description: "COS object content type",
+				Description: "COS object content type, detected from the extension of the content file if not set",
 			},
 			"endpoint_type": {
 				Type:         schema.TypeString,
@@ -93,10 +117,11 @@ func resourceIBMCOSBucketObject() *schema.Resource {
 				Default:      "public",
 			},
 			"etag": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: "COS object MD5 hexdigest",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: suppressCosMultipartEtag,
+				Description:      "COS object MD5 hexdigest",
 			},
 			"key": {
 				Type:        schema.TypeString,
@@ -124,41 +149,181 @@ func resourceIBMCOSBucketObject() *schema.Resource {
 				Computed:    true,
 				Description: "Access the object using an SQL Query instance.The reference url is used to perform queries against objects storing structured data.",
 			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validateAllowedRangeInt(5, 5120),
+				Description:  "The size in MiB of the parts of a multipart upload, objects larger than a part are uploaded in parts",
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validateAllowedRangeInt(1, 100),
+				Description:  "The number of parts of a multipart upload that are uploaded in parallel",
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateCosObjectMetadataKeys,
+				Description:  "User metadata of the COS object, the keys must be lowercase",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags of the COS object",
+			},
+			"server_side_encryption": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"AES256", "aws:kms"}),
+				Description:  "The server-side encryption of the COS object: AES256 or aws:kms",
+			},
+			"kms_key_crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The CRN of the Key Protect or Hyper Protect Crypto Services root key that encrypts the COS object",
+			},
+			"website_redirect": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Redirect requests for the COS object to another object in the bucket or to an external URL",
+			},
+			"object_lock_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"object_lock_retain_until_date"},
+				ValidateFunc: validateAllowedStringValue([]string{"COMPLIANCE"}),
+				Description:  "The Object Lock retention mode of the COS object: COMPLIANCE",
+			},
+			"object_lock_retain_until_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"object_lock_mode"},
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "The date in RFC3339 format until the COS object is retained, it can be extended but not shortened",
+			},
+			"object_lock_legal_hold_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"ON", "OFF"}),
+				Description:  "The Object Lock legal hold status of the COS object: ON or OFF",
+			},
 		},
 	}
 }
 
-func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
-
-	bucketLocation := d.Get("bucket_location").(string)
-	endpointType := d.Get("endpoint_type").(string)
+// suppressCosMultipartEtag ignores the etag argument once the object is uploaded in parts, the etag
+// of a multipart upload is not the MD5 of the object and changes are detected by content_hash.
+func suppressCosMultipartEtag(k, old, new string, d *schema.ResourceData) bool {
+	return strings.Contains(old, "-")
+}
 
-	bxSession, err := m.(ClientSession).BluemixSession()
-	if err != nil {
-		return diag.FromErr(err)
+func validateCosObjectMetadataKeys(v interface{}, k string) (ws []string, errors []error) {
+	for key := range v.(map[string]interface{}) {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf("%q: metadata key %q must be lowercase", k, key))
+		}
 	}
+	return
+}
 
-	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+// resourceIBMCOSBucketObjectContentHashDiff hashes the local content so that a change of the content
+// file plans a new upload without reading the object back into the state.
+func resourceIBMCOSBucketObjectContentHashDiff(diff *schema.ResourceDiff) error {
+	for _, k := range []string{"content", "content_base64", "content_file"} {
+		if !diff.NewValueKnown(k) {
+			return diff.SetNewComputed("content_hash")
+		}
+	}
+	contentFile := diff.Get("content_file").(string)
+	if contentFile != "" {
+		if _, err := os.Stat(contentFile); os.IsNotExist(err) {
+			// The file may be created by another resource during the apply
+			return diff.SetNewComputed("content_hash")
+		}
+	}
+	hash, err := cosObjectContentHash(diff.Get("content").(string), diff.Get("content_base64").(string), contentFile)
 	if err != nil {
-		return diag.FromErr(err)
+		return err
+	}
+	oldHash := diff.Get("content_hash").(string)
+	if hash == oldHash {
+		return nil
 	}
+	if err := diff.SetNew("content_hash", hash); err != nil {
+		return err
+	}
+	if diff.Id() != "" && oldHash != "" {
+		for _, k := range []string{"content_length", "last_modified", "version_id"} {
+			if err := diff.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
 
-	objectKey := d.Get("key").(string)
+// cosObjectContentHash returns the SHA256 hexdigest of the object content, which is read from the
+// first of content, content_base64 and content_file that is set.
+func cosObjectContentHash(content, contentBase64, contentFile string) (string, error) {
+	h := sha256.New()
+	switch {
+	case content != "":
+		h.Write([]byte(content))
+	case contentBase64 != "":
+		contentRaw, err := base64.StdEncoding.DecodeString(contentBase64)
+		if err != nil {
+			return "", fmt.Errorf("error decoding content_base64: %s", err)
+		}
+		h.Write(contentRaw)
+	case contentFile != "":
+		file, err := os.Open(contentFile)
+		if err != nil {
+			return "", fmt.Errorf("error opening COS object file (%s): %s", contentFile, err)
+		}
+		defer file.Close()
+		if _, err := io.Copy(h, file); err != nil {
+			return "", fmt.Errorf("error reading COS object file (%s): %s", contentFile, err)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
 
-	// This check is to make sure new create does not
-	// overwrite objects that is not managed by Terraform
-	exists, err := objectExists(s3Client, bucketName, objectKey)
+// cosObjectContentType returns the MIME type of a file from its extension, or from its first bytes
+// if the extension is unknown. Files that can't be detected are uploaded as binary data.
+func cosObjectContentType(path string) string {
+	if contentType := mime.TypeByExtension(filepath.Ext(path)); contentType != "" {
+		return contentType
+	}
+	file, err := os.Open(path)
 	if err != nil {
-		return diag.FromErr(err)
+		return "application/octet-stream"
 	}
-	if exists {
-		return diag.FromErr(fmt.Errorf("error COS bucket (%s) object (%s) already exists", bucketName, objectKey))
+	defer file.Close()
+	buf := make([]byte, 512)
+	n, _ := io.ReadFull(file, buf)
+	return http.DetectContentType(buf[:n])
+}
+
+// expandCosObjectTags returns the tags as the URL encoded query of the Tagging header
+func expandCosObjectTags(tags map[string]interface{}) string {
+	values := url.Values{}
+	for k, v := range tags {
+		values.Set(k, v.(string))
 	}
+	return values.Encode()
+}
 
-	var body io.ReadSeeker
+// uploadCOSObject uploads the object content, an object larger than the part size is uploaded in
+// parts by concurrent requests.
+func uploadCOSObject(ctx context.Context, s3Client *s3.S3, d *schema.ResourceData, bucketName, objectKey string) error {
+	var body io.Reader
 
 	if v, ok := d.GetOk("content"); ok {
 		content := v.(string)
@@ -167,14 +332,14 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 		content := v.(string)
 		contentRaw, err := base64.StdEncoding.DecodeString(content)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error decoding content_base64: %s", err))
+			return fmt.Errorf("error decoding content_base64: %s", err)
 		}
 		body = bytes.NewReader(contentRaw)
 	} else if v, ok := d.GetOk("content_file"); ok {
 		path := v.(string)
 		file, err := os.Open(path)
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error opening COS object file (%s): %s", path, err))
+			return fmt.Errorf("error opening COS object file (%s): %s", path, err)
 		}
 
 		body = file
@@ -184,21 +349,125 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
 			}
 		}()
+	} else {
+		body = bytes.NewReader([]byte{})
 	}
 
-	putInput := &s3.PutObjectInput{
+	uploadInput := &s3manager.UploadInput{
 		Bucket: aws.String(bucketName),
 		Key:    aws.String(objectKey),
 		Body:   body,
 	}
+	if v, ok := d.GetOk("content_type"); ok {
+		uploadInput.ContentType = aws.String(v.(string))
+	} else if v, ok := d.GetOk("content_file"); ok {
+		uploadInput.ContentType = aws.String(cosObjectContentType(v.(string)))
+	}
+	if v, ok := d.GetOk("metadata"); ok {
+		uploadInput.Metadata = aws.StringMap(expandStringMap(v.(map[string]interface{})))
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		uploadInput.Tagging = aws.String(expandCosObjectTags(v.(map[string]interface{})))
+	}
+	if v, ok := d.GetOk("kms_key_crn"); ok {
+		uploadInput.ServerSideEncryption = aws.String("aws:kms")
+		uploadInput.SSEKMSKeyId = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		uploadInput.ServerSideEncryption = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("website_redirect"); ok {
+		uploadInput.WebsiteRedirectLocation = aws.String(v.(string))
+	}
+
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = int64(d.Get("part_size").(int)) * 1024 * 1024
+		u.Concurrency = d.Get("concurrency").(int)
+	})
+	if _, err := uploader.UploadWithContext(ctx, uploadInput); err != nil {
+		return fmt.Errorf("error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return nil
+}
+
+// putCOSObjectLock sets the Object Lock retention and legal hold of the object
+func putCOSObjectLock(ctx context.Context, s3Client *s3.S3, d *schema.ResourceData, bucketName, objectKey string) error {
+	if d.HasChanges("object_lock_mode", "object_lock_retain_until_date") {
+		if v, ok := d.GetOk("object_lock_retain_until_date"); ok {
+			retainUntilDate, _ := time.Parse(time.RFC3339, v.(string))
+			err := cosPutObjectRetention(ctx, s3Client, &cosPutObjectRetentionInput{
+				Bucket: aws.String(bucketName),
+				Key:    aws.String(objectKey),
+				Retention: &cosObjectLockRetention{
+					Mode:            aws.String(d.Get("object_lock_mode").(string)),
+					RetainUntilDate: aws.Time(retainUntilDate),
+				},
+			})
+			if err != nil {
+				return fmt.Errorf("error putting retention of COS bucket (%s) object (%s): %s", bucketName, objectKey, err)
+			}
+		} else {
+			log.Printf("[WARN] The retention of COS bucket (%s) object (%s) can't be removed, it expires at the retain until date", bucketName, objectKey)
+		}
+	}
+	if d.HasChange("object_lock_legal_hold_status") {
+		status := d.Get("object_lock_legal_hold_status").(string)
+		if status == "" {
+			status = "OFF"
+		}
+		err := cosPutObjectLegalHold(ctx, s3Client, &cosPutObjectLegalHoldInput{
+			Bucket:    aws.String(bucketName),
+			Key:       aws.String(objectKey),
+			LegalHold: &cosObjectLockLegalHold{Status: aws.String(status)},
+		})
+		if err != nil {
+			return fmt.Errorf("error putting legal hold of COS bucket (%s) object (%s): %s", bucketName, objectKey, err)
+		}
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	objectKey := d.Get("key").(string)
+
+	// This check is to make sure new create does not
+	// overwrite objects that is not managed by Terraform
+	exists, err := objectExists(s3Client, bucketName, objectKey)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if exists {
+		return diag.FromErr(fmt.Errorf("error COS bucket (%s) object (%s) already exists", bucketName, objectKey))
+	}
 
-	if _, err := s3Client.PutObject(putInput); err != nil {
-		return diag.FromErr(fmt.Errorf("error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+	if err := uploadCOSObject(ctx, s3Client, d, bucketName, objectKey); err != nil {
+		return diag.FromErr(err)
 	}
 
 	objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
 	d.SetId(objectID)
 
+	if err := putCOSObjectLock(ctx, s3Client, d, bucketName, objectKey); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceIBMCOSBucketObjectRead(ctx, d, m)
 }
 
@@ -249,7 +518,16 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 		d.Set("last_modified", "")
 	}
 
-	if isContentTypeAllowed(out.ContentType) {
+	metadata := make(map[string]string, len(out.Metadata))
+	for k, v := range out.Metadata {
+		metadata[strings.ToLower(k)] = aws.StringValue(v)
+	}
+	d.Set("metadata", metadata)
+	d.Set("server_side_encryption", out.ServerSideEncryption)
+	d.Set("kms_key_crn", out.SSEKMSKeyId)
+	d.Set("website_redirect", out.WebsiteRedirectLocation)
+
+	if isContentTypeAllowed(out.ContentType) && aws.Int64Value(out.ContentLength) <= cosObjectBodyMaxSize {
 		getInput := s3.GetObjectInput{
 			Bucket: aws.String(bucketName),
 			Key:    aws.String(objectKey),
@@ -274,7 +552,51 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 			contentType = aws.StringValue(out.ContentType)
 		}
 
-		log.Printf("[INFO] Ignoring body of COS bucket (%s) object (%s) with Content-Type %q", bucketName, objectKey, contentType)
+		log.Printf("[INFO] Ignoring body of COS bucket (%s) object (%s) with Content-Type %q and length %d", bucketName, objectKey, contentType, aws.Int64Value(out.ContentLength))
+		d.Set("body", "")
+	}
+
+	tagging, err := s3Client.GetObjectTaggingWithContext(ctx, &s3.GetObjectTaggingInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed getting tags of COS bucket (%s) object (%s): %w", bucketName, objectKey, err))
+	}
+	tags := make(map[string]string, len(tagging.TagSet))
+	for _, tag := range tagging.TagSet {
+		tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	d.Set("tags", tags)
+
+	// The Object Lock settings are only read when they are managed, objects in buckets without
+	// Object Lock have no retention or legal hold.
+	if _, ok := d.GetOk("object_lock_mode"); ok {
+		retention, err := cosGetObjectRetention(ctx, s3Client, &cosObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+		if err != nil && !cosConfigurationNotFound(err, false) {
+			return diag.FromErr(fmt.Errorf("failed getting retention of COS bucket (%s) object (%s): %w", bucketName, objectKey, err))
+		}
+		if err == nil && retention.Retention != nil {
+			d.Set("object_lock_mode", retention.Retention.Mode)
+			if retention.Retention.RetainUntilDate != nil {
+				d.Set("object_lock_retain_until_date", retention.Retention.RetainUntilDate.Format(time.RFC3339))
+			}
+		}
+	}
+	if _, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+		legalHold, err := cosGetObjectLegalHold(ctx, s3Client, &cosObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+		if err != nil && !cosConfigurationNotFound(err, false) {
+			return diag.FromErr(fmt.Errorf("failed getting legal hold of COS bucket (%s) object (%s): %w", bucketName, objectKey, err))
+		}
+		if err == nil && legalHold.LegalHold != nil {
+			d.Set("object_lock_legal_hold_status", legalHold.LegalHold.Status)
+		}
 	}
 
 	d.Set("key", objectKey)
@@ -284,66 +606,63 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if d.HasChanges("content", "content_base64", "content_file", "etag") {
-		bucketCRN := d.Get("bucket_crn").(string)
-		bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-		instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
 
-		bucketLocation := d.Get("bucket_location").(string)
-		endpointType := d.Get("endpoint_type").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
 
-		bxSession, err := m.(ClientSession).BluemixSession()
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	bxSession, err := m.(ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-		s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-		var body io.ReadSeeker
+	objectKey := d.Get("key").(string)
 
-		if v, ok := d.GetOk("content"); ok {
-			content := v.(string)
-			body = bytes.NewReader([]byte(content))
-		} else if v, ok := d.GetOk("content_base64"); ok {
-			content := v.(string)
-			contentRaw, err := base64.StdEncoding.DecodeString(content)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("error decoding content_base64: %s", err))
-			}
-			body = bytes.NewReader(contentRaw)
-		} else if v, ok := d.GetOk("content_file"); ok {
-			path := v.(string)
-			file, err := os.Open(path)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("error opening COS object file (%s): %s", path, err))
-			}
+	// Objects created by an older provider or imported have no content hash yet, the first hash is
+	// recorded without uploading the object again.
+	oldHash, _ := d.GetChange("content_hash")
+	contentChanged := d.HasChange("content_hash") && oldHash.(string) != ""
 
-			body = file
-			defer func() {
-				err := file.Close()
-				if err != nil {
-					log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-				}
-			}()
+	// The metadata, encryption and redirect of an object are only set by an upload
+	if contentChanged || d.HasChanges("content", "content_base64", "content_file", "etag", "content_type", "metadata", "server_side_encryption", "kms_key_crn", "website_redirect") {
+		if err := uploadCOSObject(ctx, s3Client, d, bucketName, objectKey); err != nil {
+			return diag.FromErr(err)
 		}
 
-		objectKey := d.Get("key").(string)
-
-		putInput := &s3.PutObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
-			Body:   body,
+		objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
+		d.SetId(objectID)
+	} else if d.HasChange("tags") {
+		var err error
+		if tags := d.Get("tags").(map[string]interface{}); len(tags) > 0 {
+			tagging := &s3.Tagging{}
+			for k, v := range tags {
+				tagging.TagSet = append(tagging.TagSet, &s3.Tag{Key: aws.String(k), Value: aws.String(v.(string))})
+			}
+			_, err = s3Client.PutObjectTaggingWithContext(ctx, &s3.PutObjectTaggingInput{
+				Bucket:  aws.String(bucketName),
+				Key:     aws.String(objectKey),
+				Tagging: tagging,
+			})
+		} else {
+			_, err = s3Client.DeleteObjectTaggingWithContext(ctx, &s3.DeleteObjectTaggingInput{
+				Bucket: aws.String(bucketName),
+				Key:    aws.String(objectKey),
+			})
 		}
-
-		if _, err := s3Client.PutObject(putInput); err != nil {
-			return diag.FromErr(fmt.Errorf("error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error putting tags of COS bucket (%s) object (%s): %s", bucketName, objectKey, err))
 		}
+	}
 
-		objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
-		d.SetId(objectID)
+	if err := putCOSObjectLock(ctx, s3Client, d, bucketName, objectKey); err != nil {
+		return diag.FromErr(err)
 	}
 
 	return resourceIBMCOSBucketObjectRead(ctx, d, m)
@@ -368,6 +687,18 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 	}
 	objectKey := d.Get("key").(string)
 
+	// A legal hold prevents the deletion of the object, it is released before a forced delete
+	if d.Get("object_lock_legal_hold_status").(string) == "ON" && d.Get("force_delete").(bool) {
+		err := cosPutObjectLegalHold(ctx, s3Client, &cosPutObjectLegalHoldInput{
+			Bucket:    aws.String(bucketName),
+			Key:       aws.String(objectKey),
+			LegalHold: &cosObjectLockLegalHold{Status: aws.String("OFF")},
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error releasing legal hold of COS bucket (%s) object (%s): %s", bucketName, objectKey, err))
+		}
+	}
+
 	if _, ok := d.GetOk("version_id"); ok {
 		err = deleteAllCOSObjectVersions(s3Client, bucketName, objectKey, d.Get("force_delete").(bool), false)
 	} else {
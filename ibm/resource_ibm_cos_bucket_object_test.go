@@ -4,9 +4,12 @@
 package ibm
 
 import (
+	"crypto/rand"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -66,6 +69,88 @@ func TestAccIBMCOSBucketObject_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCOSBucketObject_multipart(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := cosCRN
+	objectFile := filepath.Join(t.TempDir(), "object.bin")
+	testAccWriteCOSObjectFile(t, objectFile, 12*1024*1024)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCOS(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile, "build"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_length", "12582912"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_type", "application/octet-stream"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "body", ""),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "metadata.owner", "build"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "tags.env", "test"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "website_redirect", "/index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_object.testacc", "content_hash"),
+				),
+			},
+			{
+				PreConfig: func() { testAccWriteCOSObjectFile(t, objectFile, 11*1024*1024) },
+				Config:    testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile, "release"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_length", "11534336"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "metadata.owner", "release"),
+				),
+			},
+		},
+	})
+}
+
+func TestCosObjectContentHash(t *testing.T) {
+	objectFile := filepath.Join(t.TempDir(), "object.txt")
+	if err := ioutil.WriteFile(objectFile, []byte("Acceptance Testing"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	// SHA256 of "Acceptance Testing"
+	expected := "65deb26287e893fc10223490972cf964d06961ee74e45f3843d391f0b5157b4b"
+	hashes := map[string][3]string{
+		"content":        {"Acceptance Testing", "", ""},
+		"content_base64": {"", base64.StdEncoding.EncodeToString([]byte("Acceptance Testing")), ""},
+		"content_file":   {"", "", objectFile},
+	}
+	for source, args := range hashes {
+		hash, err := cosObjectContentHash(args[0], args[1], args[2])
+		if err != nil {
+			t.Fatalf("hashing %s failed: %s", source, err)
+		}
+		if hash != expected {
+			t.Errorf("unexpected hash %s of %s", hash, source)
+		}
+	}
+	if _, err := cosObjectContentHash("", "not base64", ""); err == nil {
+		t.Error("expected an error for invalid content_base64")
+	}
+	if contentType := cosObjectContentType("site/index.html"); contentType != "text/html; charset=utf-8" {
+		t.Errorf("unexpected content type %s", contentType)
+	}
+	if contentType := cosObjectContentType("build/artifact"); contentType != "application/octet-stream" {
+		t.Errorf("unexpected content type %s", contentType)
+	}
+	page := filepath.Join(t.TempDir(), "index")
+	if err := ioutil.WriteFile(page, []byte("<!DOCTYPE html><html></html>"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if contentType := cosObjectContentType(page); contentType != "text/html; charset=utf-8" {
+		t.Errorf("unexpected content type %s of a file without extension", contentType)
+	}
+}
+
+func testAccWriteCOSObjectFile(t *testing.T, path string, size int) {
+	content := make([]byte, size)
+	if _, err := rand.Read(content); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, content, os.FileMode(0600)); err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testAccIBMCOSBucketObjectConfig_plaintext(name string, instanceCRN string, objectBody string) string {
 	return fmt.Sprintf(`
 		resource "ibm_cos_bucket" "testacc" {
@@ -113,3 +198,28 @@ func testAccIBMCOSBucketObjectConfig_file(name string, instanceCRN string, objec
 			content_file	  = "%[3]s"
 		}`, name, instanceCRN, objectFile)
 }
+
+func testAccIBMCOSBucketObjectConfig_multipart(name string, instanceCRN string, objectFile string, owner string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn       = ibm_cos_bucket.testacc.crn
+			bucket_location  = ibm_cos_bucket.testacc.region_location
+			key              = "%[1]s.bin"
+			content_file     = "%[3]s"
+			part_size        = 5
+			concurrency      = 3
+			website_redirect = "/index.html"
+			metadata = {
+				owner = "%[4]s"
+			}
+			tags = {
+				env = "test"
+			}
+		}`, name, instanceCRN, objectFile, owner)
+}
@@ -0,0 +1,269 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMCOSBucketReplicationRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketReplicationRuleCreate,
+		ReadContext:   resourceIBMCOSBucketReplicationRuleRead,
+		UpdateContext: resourceIBMCOSBucketReplicationRuleUpdate,
+		DeleteContext: resourceIBMCOSBucketReplicationRuleDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS source bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS source bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"replication_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1000,
+				Description: "Replicate objects written to the source bucket to a destination bucket. Both buckets need object versioning.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Unique identifier for the rule",
+						},
+						"enable": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Enable or disable the replication rule",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The rule applies to any objects with keys that match this prefix",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Computed:    true,
+							Description: "The priority of the rule, the rule with the highest priority applies when rules overlap",
+						},
+						"deletemarker_replication_status": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Replicate delete markers to the destination bucket",
+						},
+						"destination_bucket_crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The CRN of the destination bucket",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandCosReplicationRules(ruleList []interface{}) []*cosReplicationRule {
+	var rules []*cosReplicationRule
+	for _, l := range ruleList {
+		ruleMap, _ := l.(map[string]interface{})
+		status, deleteMarkerStatus := "Disabled", "Disabled"
+		if ruleMap["enable"].(bool) {
+			status = "Enabled"
+		}
+		if ruleMap["deletemarker_replication_status"].(bool) {
+			deleteMarkerStatus = "Enabled"
+		}
+		rule := &cosReplicationRule{
+			Status: aws.String(status),
+			Filter: &cosReplicationRuleFilter{
+				Prefix: aws.String(ruleMap["prefix"].(string)),
+			},
+			Destination: &cosReplicationDestination{
+				Bucket: aws.String(ruleMap["destination_bucket_crn"].(string)),
+			},
+			DeleteMarkerReplication: &cosDeleteMarkerReplication{
+				Status: aws.String(deleteMarkerStatus),
+			},
+		}
+		if id := ruleMap["rule_id"].(string); id != "" {
+			rule.ID = aws.String(id)
+		}
+		if priority := ruleMap["priority"].(int); priority > 0 {
+			rule.Priority = aws.Int64(int64(priority))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenCosReplicationRules(in []*cosReplicationRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		rule := map[string]interface{}{
+			"rule_id":                         aws.StringValue(r.ID),
+			"enable":                          aws.StringValue(r.Status) == "Enabled",
+			"priority":                        int(aws.Int64Value(r.Priority)),
+			"deletemarker_replication_status": r.DeleteMarkerReplication != nil && aws.StringValue(r.DeleteMarkerReplication.Status) == "Enabled",
+		}
+		if r.Filter != nil {
+			rule["prefix"] = aws.StringValue(r.Filter.Prefix)
+		}
+		if r.Destination != nil {
+			rule["destination_bucket_crn"] = aws.StringValue(r.Destination.Bucket)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// getCosBucketConfigurationId returns the ID of a bucket configuration resource, the ID holds the
+// bucket CRN, location and endpoint type so that the configuration can be imported.
+func getCosBucketConfigurationId(bucketCRN, bucketLocation, endpointType string) string {
+	return fmt.Sprintf("%s:meta:%s:%s", bucketCRN, bucketLocation, endpointType)
+}
+
+func parseCosBucketConfigurationId(id string) (bucketCRN, bucketName, instanceCRN, bucketLocation, endpointType string, err error) {
+	parts := strings.Split(id, ":meta:")
+	if len(parts) != 2 || !strings.Contains(parts[0], ":bucket:") {
+		return "", "", "", "", "", fmt.Errorf("Incorrect ID %s: ID should be a combination of bucketCRN:meta:bucketLocation:endpointType", id)
+	}
+	bucketCRN = parts[0]
+	bucketName = strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN = fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	meta := strings.Split(parts[1], ":")
+	bucketLocation = meta[0]
+	endpointType = "public"
+	if len(meta) > 1 && meta[1] != "" {
+		endpointType = meta[1]
+	}
+	return
+}
+
+func resourceIBMCOSBucketReplicationRuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	d.SetId(getCosBucketConfigurationId(bucketCRN, bucketLocation, endpointType))
+	if diags := resourceIBMCOSBucketReplicationRuleUpdate(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketReplicationRuleRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN, bucketName, instanceCRN, bucketLocation, endpointType, err := parseCosBucketConfigurationId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bxSession, err := m.(ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := cosGetBucketReplication(ctx, s3Client, &cosBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		if cosConfigurationNotFound(err, false) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed getting the replication rules of COS bucket (%s): %s", bucketName, err))
+	}
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	d.Set("endpoint_type", endpointType)
+	if output.ReplicationConfiguration != nil {
+		if err := d.Set("replication_rule", flattenCosReplicationRules(output.ReplicationConfiguration.Rules)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting replication_rule: %s", err))
+		}
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketReplicationRuleUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, bucketName, instanceCRN, bucketLocation, endpointType, err := parseCosBucketConfigurationId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bxSession, err := m.(ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &cosPutBucketReplicationInput{
+		Bucket: aws.String(bucketName),
+		ReplicationConfiguration: &cosReplicationConfiguration{
+			Rules: expandCosReplicationRules(d.Get("replication_rule").([]interface{})),
+		},
+	}
+	if err := cosPutBucketReplication(ctx, s3Client, input); err != nil {
+		return diag.FromErr(fmt.Errorf("failed putting the replication rules of COS bucket (%s): %s", bucketName, err))
+	}
+
+	return resourceIBMCOSBucketReplicationRuleRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketReplicationRuleDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, bucketName, instanceCRN, bucketLocation, endpointType, err := parseCosBucketConfigurationId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bxSession, err := m.(ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = cosDeleteBucketReplication(ctx, s3Client, &cosBucketInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil && !cosConfigurationNotFound(err, false) {
+		return diag.FromErr(fmt.Errorf("failed deleting the replication rules of COS bucket (%s): %s", bucketName, err))
+	}
+	return nil
+}
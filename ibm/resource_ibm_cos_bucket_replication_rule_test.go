@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCosBucketReplicationRule_Basic(t *testing.T) {
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	sourceBucketName := fmt.Sprintf("terraform-source%d", acctest.RandIntRange(10, 100))
+	destinationBucketName := fmt.Sprintf("terraform-destination%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketReplicationRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucketReplicationRuleConfig(cosServiceName, sourceBucketName, destinationBucketName, "logs/", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_replication_rule.replication", "replication_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_replication_rule.replication", "replication_rule.0.rule_id", "replicate-logs"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_replication_rule.replication", "replication_rule.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_replication_rule.replication", "replication_rule.0.deletemarker_replication_status", "false"),
+					resource.TestCheckResourceAttrPair("ibm_cos_bucket_replication_rule.replication", "replication_rule.0.destination_bucket_crn", "ibm_cos_bucket.destination", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucketReplicationRuleConfig(cosServiceName, sourceBucketName, destinationBucketName, "data/", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_replication_rule.replication", "replication_rule.0.prefix", "data/"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_replication_rule.replication", "replication_rule.0.deletemarker_replication_status", "true"),
+				),
+			},
+			{
+				ResourceName:      "ibm_cos_bucket_replication_rule.replication",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucketReplicationRuleDestroy(s *terraform.State) error {
+	bxSession, err := testAccProvider.Meta().(ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cos_bucket_replication_rule" {
+			continue
+		}
+		_, bucketName, instanceCRN, bucketLocation, endpointType, err := parseCosBucketConfigurationId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+		if err != nil {
+			return err
+		}
+		_, err = cosGetBucketReplication(context.Background(), s3Client, &cosBucketInput{Bucket: aws.String(bucketName)})
+		if err == nil {
+			return fmt.Errorf("Replication rules of COS bucket %s still exist", bucketName)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMCosBucketReplicationRuleConfig(cosServiceName, sourceBucketName, destinationBucketName, prefix string, deleteMarker bool) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+
+	resource "ibm_cos_bucket" "source" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "us-south"
+		storage_class        = "standard"
+		object_versioning {
+			enable = true
+		}
+	}
+
+	resource "ibm_cos_bucket" "destination" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "us-east"
+		storage_class        = "standard"
+		object_versioning {
+			enable = true
+		}
+	}
+
+	resource "ibm_iam_authorization_policy" "policy" {
+		roles                  = ["Writer"]
+		source_service_name    = "cloud-object-storage"
+		source_resource_instance_id = ibm_resource_instance.instance.guid
+		target_service_name    = "cloud-object-storage"
+		target_resource_instance_id = ibm_resource_instance.instance.guid
+	}
+
+	resource "ibm_cos_bucket_replication_rule" "replication" {
+		depends_on      = [ibm_iam_authorization_policy.policy]
+		bucket_crn      = ibm_cos_bucket.source.crn
+		bucket_location = ibm_cos_bucket.source.region_location
+		replication_rule {
+			rule_id                         = "replicate-logs"
+			enable                          = true
+			prefix                          = "%s"
+			priority                        = 1
+			deletemarker_replication_status = %t
+			destination_bucket_crn          = ibm_cos_bucket.destination.crn
+		}
+	}
+	`, cosServiceName, sourceBucketName, destinationBucketName, prefix, deleteMarker)
+}
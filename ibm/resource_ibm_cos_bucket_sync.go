@@ -0,0 +1,462 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMCOSBucketSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketSyncCreate,
+		ReadContext:   resourceIBMCOSBucketSyncRead,
+		UpdateContext: resourceIBMCOSBucketSyncUpdate,
+		DeleteContext: resourceIBMCOSBucketSyncDelete,
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMCOSBucketSyncFilesDiff(diff)
+			},
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The local directory that is mirrored into the bucket",
+			},
+			"prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateCosSyncPrefix,
+				Description:  "The object key prefix the directory is mirrored to, such as site/",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the files that are not mirrored, matched against the relative path and the name of the files",
+			},
+			"delete_removed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the objects under the prefix that don't exist in the directory",
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validateAllowedRangeInt(1, 100),
+				Description:  "The number of files that are uploaded in parallel",
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validateAllowedRangeInt(5, 5120),
+				Description:  "The size in MiB of the parts of a multipart upload, files larger than a part are uploaded in parts",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The ETags of the mirrored objects by object key",
+			},
+		},
+	}
+}
+
+// cosSyncLocalFiles walks the directory and returns the ETag that each file gets once it is uploaded
+// with the part size, by the object key of the file.
+func cosSyncLocalFiles(sourceDir, prefix string, exclude []string, partSize int64) (map[string]string, error) {
+	files := make(map[string]string)
+	err := filepath.Walk(sourceDir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		for _, pattern := range exclude {
+			matchPath, _ := path.Match(pattern, rel)
+			matchName, _ := path.Match(pattern, path.Base(rel))
+			if matchPath || matchName {
+				return nil
+			}
+		}
+		etag, err := cosLocalETag(p, partSize)
+		if err != nil {
+			return err
+		}
+		files[prefix+rel] = etag
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading the COS sync directory (%s): %s", sourceDir, err)
+	}
+	return files, nil
+}
+
+// cosLocalETag returns the ETag of a file uploaded by the s3manager uploader. A file that fits in a
+// part gets the MD5 of its content, a larger file gets the MD5 of the MD5s of its parts followed by
+// the number of parts.
+func cosLocalETag(p string, partSize int64) (string, error) {
+	file, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	size := info.Size()
+	if size/partSize >= s3manager.MaxUploadParts {
+		partSize = size/s3manager.MaxUploadParts + 1
+	}
+	if size <= partSize {
+		h := md5.New()
+		if _, err := io.Copy(h, file); err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	var sums []byte
+	parts := 0
+	for {
+		h := md5.New()
+		n, err := io.CopyN(h, file, partSize)
+		if n > 0 {
+			sums = append(sums, h.Sum(nil)...)
+			parts++
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	sum := md5.Sum(sums)
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts), nil
+}
+
+// resourceIBMCOSBucketSyncFilesDiff plans the sync, the files argument holds the ETags the objects
+// get from the local files. A file whose ETag differs from the ETag of the object is uploaded.
+func resourceIBMCOSBucketSyncFilesDiff(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("source_dir") || !diff.NewValueKnown("exclude") {
+		return diff.SetNewComputed("files")
+	}
+	var exclude []string
+	for _, e := range diff.Get("exclude").([]interface{}) {
+		exclude = append(exclude, e.(string))
+	}
+	local, err := cosSyncLocalFiles(diff.Get("source_dir").(string), diff.Get("prefix").(string), exclude, int64(diff.Get("part_size").(int))*1024*1024)
+	if err != nil {
+		return err
+	}
+
+	remote := diff.Get("files").(map[string]interface{})
+	files := make(map[string]interface{}, len(local))
+	if !diff.Get("delete_removed").(bool) {
+		// Objects without a local file are kept
+		for k, v := range remote {
+			files[k] = v
+		}
+	}
+	for k, v := range local {
+		files[k] = v
+	}
+
+	changed := len(files) != len(remote)
+	for k, v := range files {
+		if remote[k] != v {
+			changed = true
+			break
+		}
+	}
+	if changed {
+		return diff.SetNew("files", files)
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	prefix := d.Get("prefix").(string)
+
+	d.SetId(fmt.Sprintf("%s:sync:%s:location:%s", bucketCRN, prefix, bucketLocation))
+	if diags := resourceIBMCOSBucketSyncUpdate(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bxSession, err := m.(ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, d.Get("bucket_location").(string), d.Get("endpoint_type").(string), instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	remote, err := cosListObjectETags(ctx, s3Client, bucketName, d.Get("prefix").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed listing the objects of COS bucket (%s): %s", bucketName, err))
+	}
+
+	// Without delete_removed only the objects that were synced are tracked
+	files := make(map[string]string)
+	tracked := d.Get("files").(map[string]interface{})
+	for k, v := range remote {
+		if _, ok := tracked[k]; ok || d.Get("delete_removed").(bool) {
+			files[k] = v
+		}
+	}
+	d.Set("files", files)
+	return nil
+}
+
+func resourceIBMCOSBucketSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	sourceDir := d.Get("source_dir").(string)
+	prefix := d.Get("prefix").(string)
+	partSize := int64(d.Get("part_size").(int)) * 1024 * 1024
+
+	bxSession, err := m.(ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, d.Get("bucket_location").(string), d.Get("endpoint_type").(string), instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var exclude []string
+	for _, e := range d.Get("exclude").([]interface{}) {
+		exclude = append(exclude, e.(string))
+	}
+	local, err := cosSyncLocalFiles(sourceDir, prefix, exclude, partSize)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	remote, err := cosListObjectETags(ctx, s3Client, bucketName, prefix)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed listing the objects of COS bucket (%s): %s", bucketName, err))
+	}
+
+	var uploads, deletes []string
+	for k, etag := range local {
+		if remote[k] != etag {
+			uploads = append(uploads, k)
+		}
+	}
+	if d.Get("delete_removed").(bool) {
+		for k := range remote {
+			if _, ok := local[k]; !ok {
+				deletes = append(deletes, k)
+			}
+		}
+	}
+	sort.Strings(uploads)
+	log.Printf("[INFO] Syncing %s to COS bucket (%s): %d files to upload, %d objects to delete", sourceDir, bucketName, len(uploads), len(deletes))
+
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = partSize
+		u.Concurrency = 1
+	})
+	errs := cosSyncUpload(ctx, uploader, bucketName, sourceDir, prefix, uploads, d.Get("concurrency").(int))
+	if len(errs) > 0 {
+		var diags diag.Diagnostics
+		for _, err := range errs {
+			diags = append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+
+	if err := cosDeleteObjects(ctx, s3Client, bucketName, deletes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMCOSBucketSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bxSession, err := m.(ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, d.Get("bucket_location").(string), d.Get("endpoint_type").(string), instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var keys []string
+	for k := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, k)
+	}
+	if err := cosDeleteObjects(ctx, s3Client, bucketName, keys); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// cosSyncUpload uploads the files of the object keys with concurrent workers and returns the errors
+// of the failed uploads.
+func cosSyncUpload(ctx context.Context, uploader *s3manager.Uploader, bucketName, sourceDir, prefix string, keys []string, concurrency int) []error {
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var errs []error
+
+	work := make(chan string)
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range work {
+				p := filepath.Join(sourceDir, filepath.FromSlash(strings.TrimPrefix(key, prefix)))
+				if err := cosSyncUploadFile(ctx, uploader, bucketName, key, p); err != nil {
+					mu.Lock()
+					errs = append(errs, err)
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for _, key := range keys {
+		work <- key
+	}
+	close(work)
+	wg.Wait()
+	return errs
+}
+
+func cosSyncUploadFile(ctx context.Context, uploader *s3manager.Uploader, bucketName, key, p string) error {
+	file, err := os.Open(p)
+	if err != nil {
+		return fmt.Errorf("error opening COS sync file (%s): %s", p, err)
+	}
+	defer file.Close()
+
+	log.Printf("[DEBUG] Uploading %s to COS bucket (%s) object (%s)", p, bucketName, key)
+	_, err = uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(key),
+		Body:        file,
+		ContentType: aws.String(cosObjectContentType(p)),
+	})
+	if err != nil {
+		return fmt.Errorf("error putting object (%s) in COS bucket (%s): %s", key, bucketName, err)
+	}
+	return nil
+}
+
+// cosListObjectETags returns the ETags of the objects under the prefix by object key
+func cosListObjectETags(ctx context.Context, s3Client *s3.S3, bucketName, prefix string) (map[string]string, error) {
+	etags := make(map[string]string)
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	err := s3Client.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			etags[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return !lastPage
+	})
+	return etags, err
+}
+
+// cosDeleteObjects deletes the objects in batches of 1000, the limit of a DeleteObjects request
+func cosDeleteObjects(ctx context.Context, s3Client *s3.S3, bucketName string, keys []string) error {
+	for start := 0; start < len(keys); start += 1000 {
+		end := start + 1000
+		if end > len(keys) {
+			end = len(keys)
+		}
+		var objects []*s3.ObjectIdentifier
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		out, err := s3Client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3.Delete{Objects: objects, Quiet: aws.Bool(true)},
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting objects of COS bucket (%s): %s", bucketName, err)
+		}
+		if len(out.Errors) > 0 {
+			return fmt.Errorf("error deleting COS bucket (%s) object (%s): %s", bucketName, aws.StringValue(out.Errors[0].Key), aws.StringValue(out.Errors[0].Message))
+		}
+	}
+	return nil
+}
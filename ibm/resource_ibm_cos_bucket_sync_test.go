@@ -0,0 +1,166 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketSync_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-sync-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := cosCRN
+	sourceDir := t.TempDir()
+	testAccWriteCOSSyncFile(t, sourceDir, "index.html", "<html>index</html>")
+	testAccWriteCOSSyncFile(t, sourceDir, "css/site.css", "body {}")
+	testAccWriteCOSSyncFile(t, sourceDir, "notes.tmp", "not synced")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCOS(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketSyncConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_sync.testacc", "files.%", "2"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_sync.testacc", "files.site/index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_sync.testacc", "files.site/css/site.css"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccWriteCOSSyncFile(t, sourceDir, "index.html", "<html>updated</html>")
+					os.Remove(filepath.Join(sourceDir, "css", "site.css"))
+				},
+				Config: testAccIBMCOSBucketSyncConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_sync.testacc", "files.%", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_sync.testacc", "files.site/index.html", testAccMD5("<html>updated</html>")),
+				),
+			},
+		},
+	})
+}
+
+func TestCosLocalETag(t *testing.T) {
+	dir := t.TempDir()
+	partSize := int64(5 * 1024 * 1024)
+	content := make([]byte, 12*1024*1024)
+	for i := range content {
+		content[i] = byte(i)
+	}
+	p := filepath.Join(dir, "artifact.bin")
+	if err := ioutil.WriteFile(p, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var sums []byte
+	for start := int64(0); start < int64(len(content)); start += partSize {
+		end := start + partSize
+		if end > int64(len(content)) {
+			end = int64(len(content))
+		}
+		sum := md5.Sum(content[start:end])
+		sums = append(sums, sum[:]...)
+	}
+	sum := md5.Sum(sums)
+	expected := hex.EncodeToString(sum[:]) + "-3"
+
+	etag, err := cosLocalETag(p, partSize)
+	if err != nil {
+		t.Fatalf("cosLocalETag failed: %s", err)
+	}
+	if etag != expected {
+		t.Errorf("expected the multipart ETag %s, got %s", expected, etag)
+	}
+
+	etag, err = cosLocalETag(p, int64(len(content)))
+	if err != nil {
+		t.Fatalf("cosLocalETag failed: %s", err)
+	}
+	if single := md5.Sum(content); etag != hex.EncodeToString(single[:]) {
+		t.Errorf("expected the MD5 ETag of a single part upload, got %s", etag)
+	}
+}
+
+func TestCosSyncLocalFiles(t *testing.T) {
+	dir := t.TempDir()
+	testAccWriteCOSSyncFile(t, dir, "index.html", "index")
+	testAccWriteCOSSyncFile(t, dir, "docs/guide.md", "guide")
+	testAccWriteCOSSyncFile(t, dir, "docs/draft.tmp", "draft")
+	testAccWriteCOSSyncFile(t, dir, ".git/config", "config")
+
+	files, err := cosSyncLocalFiles(dir, "site/", []string{"*.tmp", ".git/*"}, 5*1024*1024)
+	if err != nil {
+		t.Fatalf("cosSyncLocalFiles failed: %s", err)
+	}
+	expected := map[string]string{
+		"site/index.html":    testAccMD5("index"),
+		"site/docs/guide.md": testAccMD5("guide"),
+	}
+	if len(files) != len(expected) {
+		t.Fatalf("expected files %v, got %v", expected, files)
+	}
+	for k, v := range expected {
+		if files[k] != v {
+			t.Errorf("expected ETag %s of %s, got %s", v, k, files[k])
+		}
+	}
+}
+
+func TestValidateCosSyncPrefix(t *testing.T) {
+	for prefix, valid := range map[string]bool{
+		"":         true,
+		"site/":    true,
+		"site/v1/": true,
+		"site":     false,
+		"site/v1":  false,
+	} {
+		_, errs := validateCosSyncPrefix(prefix, "prefix")
+		if valid != (len(errs) == 0) {
+			t.Errorf("expected prefix %q to be valid %t, got errors %v", prefix, valid, errs)
+		}
+	}
+}
+
+func testAccWriteCOSSyncFile(t *testing.T, dir, name, content string) {
+	p := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(p, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccMD5(content string) string {
+	sum := md5.Sum([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+func testAccIBMCOSBucketSyncConfig(name string, instanceCRN string, sourceDir string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_sync" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			source_dir      = "%[3]s"
+			prefix          = "site/"
+			exclude         = ["*.tmp"]
+			delete_removed  = true
+			concurrency     = 4
+		}`, name, instanceCRN, sourceDir)
+}
@@ -344,6 +344,110 @@ func TestAccIBMCosBucket_Hard_Quota(t *testing.T) {
 	})
 }
 
+func TestAccIBMCosBucket_Noncurrent_Version_Expiration_Abort_Multipart(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_noncurrent_abort_multipart(cosServiceName, bucketName, bucketRegion, bucketClass, 30, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "noncurrent_version_expiration.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "noncurrent_version_expiration.0.noncurrent_days", "30"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "abort_incomplete_multipart_upload_days.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "abort_incomplete_multipart_upload_days.0.days_after_initiation", "7"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_noncurrent_abort_multipart(cosServiceName, bucketName, bucketRegion, bucketClass, 60, 14),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "noncurrent_version_expiration.0.noncurrent_days", "60"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "abort_incomplete_multipart_upload_days.0.days_after_initiation", "14"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCosBucket_Object_Lock(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_object_lock(cosServiceName, bucketName, bucketRegion, bucketClass, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.0.object_lock_enabled", "Enabled"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.0.object_lock_rule.0.default_retention.0.mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.0.object_lock_rule.0.default_retention.0.days", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_object_lock(cosServiceName, bucketName, bucketRegion, bucketClass, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.0.object_lock_rule.0.default_retention.0.days", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCosBucket_Cors(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_cors(cosServiceName, bucketName, bucketRegion, bucketClass, 3000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cors_rule.0.max_age_seconds", "3000"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_cors(cosServiceName, bucketName, bucketRegion, bucketClass, 600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cors_rule.0.max_age_seconds", "600"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_cors(cosServiceName, bucketName, bucketRegion, bucketClass, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cors_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCosBucket_Smart_Type(t *testing.T) {
 	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
 	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
@@ -1013,3 +1117,109 @@ func testAccCheckIBMCosBucket_hard_quota(cosServiceName string, bucketName strin
 	}
 	`, cosServiceName, bucketName, region, storageClass, hardQuota)
 }
+
+func testAccCheckIBMCosBucket_noncurrent_abort_multipart(cosServiceName string, bucketName string, region string, storageClass string, noncurrentDays int, daysAfterInitiation int) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name           = "%s"
+		resource_instance_id  = ibm_resource_instance.instance.id
+		region_location       = "%s"
+		storage_class         = "%s"
+		object_versioning {
+			enable  = true
+		}
+		noncurrent_version_expiration {
+			rule_id         = "noncurrent"
+			enable          = true
+			prefix          = "logs/"
+			noncurrent_days = %d
+		}
+		abort_incomplete_multipart_upload_days {
+			rule_id               = "multipart"
+			enable                = true
+			days_after_initiation = %d
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass, noncurrentDays, daysAfterInitiation)
+}
+
+func testAccCheckIBMCosBucket_object_lock(cosServiceName string, bucketName string, region string, storageClass string, days int) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name           = "%s"
+		resource_instance_id  = ibm_resource_instance.instance.id
+		region_location       = "%s"
+		storage_class         = "%s"
+		object_versioning {
+			enable  = true
+		}
+		object_lock_configuration {
+			object_lock_enabled = "Enabled"
+			object_lock_rule {
+				default_retention {
+					mode = "COMPLIANCE"
+					days = %d
+				}
+			}
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass, days)
+}
+
+// testAccCheckIBMCosBucket_cors removes the CORS rule when maxAgeSeconds is 0
+func testAccCheckIBMCosBucket_cors(cosServiceName string, bucketName string, region string, storageClass string, maxAgeSeconds int) string {
+
+	corsRule := ""
+	if maxAgeSeconds > 0 {
+		corsRule = fmt.Sprintf(`
+		cors_rule {
+			allowed_methods = ["GET", "PUT"]
+			allowed_origins = ["https://www.example.com"]
+			allowed_headers = ["*"]
+			max_age_seconds = %d
+		}`, maxAgeSeconds)
+	}
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name           = "%s"
+		resource_instance_id  = ibm_resource_instance.instance.id
+		region_location       = "%s"
+		storage_class         = "%s"%s
+	}
+	`, cosServiceName, bucketName, region, storageClass, corsRule)
+}
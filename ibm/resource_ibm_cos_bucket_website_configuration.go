@@ -0,0 +1,343 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMCOSBucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketWebsiteConfigurationCreate,
+		ReadContext:   resourceIBMCOSBucketWebsiteConfigurationRead,
+		UpdateContext: resourceIBMCOSBucketWebsiteConfigurationUpdate,
+		DeleteContext: resourceIBMCOSBucketWebsiteConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"index_document": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"redirect_all_requests_to"},
+				Description:   "The suffix that is appended to requests for a directory, such as index.html",
+			},
+			"error_document": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"redirect_all_requests_to"},
+				Description:   "The object key to return when an error occurs, such as error.html",
+			},
+			"redirect_all_requests_to": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"index_document", "error_document", "routing_rule"},
+				Description:   "Redirect all requests to the website endpoint of the bucket to another host",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The host name to redirect requests to",
+						},
+						"protocol": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"http", "https"}),
+							Description:  "The protocol to use when redirecting requests, http or https",
+						},
+					},
+				},
+			},
+			"routing_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Rules that redirect requests when conditions are met",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"condition": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The condition that must be met to apply the redirect",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"http_error_code_returned_equals": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The HTTP error code when the redirect is applied, such as 404",
+									},
+									"key_prefix_equals": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The object key name prefix when the redirect is applied",
+									},
+								},
+							},
+						},
+						"redirect": {
+							Type:        schema.TypeList,
+							Required:    true,
+							MaxItems:    1,
+							Description: "The redirect to apply",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host_name": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The host name to use in the redirect request",
+									},
+									"http_redirect_code": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The HTTP redirect code to use in the response, such as 301",
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateAllowedStringValue([]string{"http", "https"}),
+										Description:  "The protocol to use when redirecting requests, http or https",
+									},
+									"replace_key_prefix_with": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The object key prefix to use in the redirect request",
+									},
+									"replace_key_with": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "The specific object key to use in the redirect request",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"website_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The website endpoint of the bucket",
+			},
+		},
+	}
+}
+
+func expandCosWebsiteConfiguration(d *schema.ResourceData) *s3.WebsiteConfiguration {
+	config := &s3.WebsiteConfiguration{}
+	if v, ok := d.GetOk("index_document"); ok {
+		config.IndexDocument = &s3.IndexDocument{Suffix: aws.String(v.(string))}
+	}
+	if v, ok := d.GetOk("error_document"); ok {
+		config.ErrorDocument = &s3.ErrorDocument{Key: aws.String(v.(string))}
+	}
+	if v, ok := d.GetOk("redirect_all_requests_to"); ok && v.([]interface{})[0] != nil {
+		redirectMap := v.([]interface{})[0].(map[string]interface{})
+		config.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{
+			HostName: aws.String(redirectMap["host_name"].(string)),
+		}
+		if protocol := redirectMap["protocol"].(string); protocol != "" {
+			config.RedirectAllRequestsTo.Protocol = aws.String(protocol)
+		}
+	}
+	for _, l := range d.Get("routing_rule").([]interface{}) {
+		ruleMap, _ := l.(map[string]interface{})
+		rule := &s3.RoutingRule{Redirect: &s3.Redirect{}}
+		if conditionList := ruleMap["condition"].([]interface{}); len(conditionList) > 0 && conditionList[0] != nil {
+			conditionMap := conditionList[0].(map[string]interface{})
+			rule.Condition = &s3.Condition{}
+			if v := conditionMap["http_error_code_returned_equals"].(string); v != "" {
+				rule.Condition.HttpErrorCodeReturnedEquals = aws.String(v)
+			}
+			if v := conditionMap["key_prefix_equals"].(string); v != "" {
+				rule.Condition.KeyPrefixEquals = aws.String(v)
+			}
+		}
+		if redirectList := ruleMap["redirect"].([]interface{}); len(redirectList) > 0 && redirectList[0] != nil {
+			redirectMap := redirectList[0].(map[string]interface{})
+			if v := redirectMap["host_name"].(string); v != "" {
+				rule.Redirect.HostName = aws.String(v)
+			}
+			if v := redirectMap["http_redirect_code"].(string); v != "" {
+				rule.Redirect.HttpRedirectCode = aws.String(v)
+			}
+			if v := redirectMap["protocol"].(string); v != "" {
+				rule.Redirect.Protocol = aws.String(v)
+			}
+			if v := redirectMap["replace_key_prefix_with"].(string); v != "" {
+				rule.Redirect.ReplaceKeyPrefixWith = aws.String(v)
+			}
+			if v := redirectMap["replace_key_with"].(string); v != "" {
+				rule.Redirect.ReplaceKeyWith = aws.String(v)
+			}
+		}
+		config.RoutingRules = append(config.RoutingRules, rule)
+	}
+	return config
+}
+
+func flattenCosRoutingRules(in []*s3.RoutingRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		rule := make(map[string]interface{})
+		if r.Condition != nil {
+			rule["condition"] = []interface{}{map[string]interface{}{
+				"http_error_code_returned_equals": aws.StringValue(r.Condition.HttpErrorCodeReturnedEquals),
+				"key_prefix_equals":               aws.StringValue(r.Condition.KeyPrefixEquals),
+			}}
+		}
+		if r.Redirect != nil {
+			rule["redirect"] = []interface{}{map[string]interface{}{
+				"host_name":               aws.StringValue(r.Redirect.HostName),
+				"http_redirect_code":      aws.StringValue(r.Redirect.HttpRedirectCode),
+				"protocol":                aws.StringValue(r.Redirect.Protocol),
+				"replace_key_prefix_with": aws.StringValue(r.Redirect.ReplaceKeyPrefixWith),
+				"replace_key_with":        aws.StringValue(r.Redirect.ReplaceKeyWith),
+			}}
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	d.SetId(getCosBucketConfigurationId(bucketCRN, bucketLocation, endpointType))
+	if diags := resourceIBMCOSBucketWebsiteConfigurationUpdate(ctx, d, m); diags.HasError() {
+		d.SetId("")
+		return diags
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN, bucketName, instanceCRN, bucketLocation, endpointType, err := parseCosBucketConfigurationId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bxSession, err := m.(ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := s3Client.GetBucketWebsiteWithContext(ctx, &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		if cosConfigurationNotFound(err, false) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed getting the website configuration of COS bucket (%s): %s", bucketName, err))
+	}
+
+	d.Set("bucket_crn", bucketCRN)
+	d.Set("bucket_location", bucketLocation)
+	d.Set("endpoint_type", endpointType)
+	d.Set("index_document", nil)
+	if output.IndexDocument != nil {
+		d.Set("index_document", output.IndexDocument.Suffix)
+	}
+	d.Set("error_document", nil)
+	if output.ErrorDocument != nil {
+		d.Set("error_document", output.ErrorDocument.Key)
+	}
+	redirect := []interface{}{}
+	if output.RedirectAllRequestsTo != nil {
+		redirect = append(redirect, map[string]interface{}{
+			"host_name": aws.StringValue(output.RedirectAllRequestsTo.HostName),
+			"protocol":  aws.StringValue(output.RedirectAllRequestsTo.Protocol),
+		})
+	}
+	d.Set("redirect_all_requests_to", redirect)
+	if err := d.Set("routing_rule", flattenCosRoutingRules(output.RoutingRules)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting routing_rule: %s", err))
+	}
+	d.Set("website_endpoint", fmt.Sprintf("%s.s3-web.%s.cloud-object-storage.appdomain.cloud", bucketName, bucketLocation))
+	return nil
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, bucketName, instanceCRN, bucketLocation, endpointType, err := parseCosBucketConfigurationId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bxSession, err := m.(ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = s3Client.PutBucketWebsiteWithContext(ctx, &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucketName),
+		WebsiteConfiguration: expandCosWebsiteConfiguration(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed putting the website configuration of COS bucket (%s): %s", bucketName, err))
+	}
+
+	return resourceIBMCOSBucketWebsiteConfigurationRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	_, bucketName, instanceCRN, bucketLocation, endpointType, err := parseCosBucketConfigurationId(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bxSession, err := m.(ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = s3Client.DeleteBucketWebsiteWithContext(ctx, &s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil && !cosConfigurationNotFound(err, false) {
+		return diag.FromErr(fmt.Errorf("failed deleting the website configuration of COS bucket (%s): %s", bucketName, err))
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCosBucketWebsiteConfiguration_Basic(t *testing.T) {
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-website%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketWebsiteConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucketWebsiteConfigurationConfig(cosServiceName, bucketName, "index.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "index_document", "index.html"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "error_document", "error.html"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "routing_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "routing_rule.0.redirect.0.replace_key_prefix_with", "documents/"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_website_configuration.website", "website_endpoint"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucketWebsiteConfigurationConfig(cosServiceName, bucketName, "home.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "index_document", "home.html"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucketWebsiteConfigurationRedirectConfig(cosServiceName, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "redirect_all_requests_to.0.host_name", "www.example.com"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "redirect_all_requests_to.0.protocol", "https"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_website_configuration.website", "index_document", ""),
+				),
+			},
+			{
+				ResourceName:      "ibm_cos_bucket_website_configuration.website",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucketWebsiteConfigurationDestroy(s *terraform.State) error {
+	bxSession, err := testAccProvider.Meta().(ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cos_bucket_website_configuration" {
+			continue
+		}
+		_, bucketName, instanceCRN, bucketLocation, endpointType, err := parseCosBucketConfigurationId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+		if err != nil {
+			return err
+		}
+		_, err = s3Client.GetBucketWebsite(&s3.GetBucketWebsiteInput{Bucket: aws.String(bucketName)})
+		if err == nil {
+			return fmt.Errorf("Website configuration of COS bucket %s still exists", bucketName)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMCosBucketWebsiteConfigurationBase(cosServiceName, bucketName string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "us-south"
+		storage_class        = "standard"
+	}
+	`, cosServiceName, bucketName)
+}
+
+func testAccCheckIBMCosBucketWebsiteConfigurationConfig(cosServiceName, bucketName, indexDocument string) string {
+	return testAccCheckIBMCosBucketWebsiteConfigurationBase(cosServiceName, bucketName) + fmt.Sprintf(`
+	resource "ibm_cos_bucket_website_configuration" "website" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.region_location
+		index_document  = "%s"
+		error_document  = "error.html"
+		routing_rule {
+			condition {
+				key_prefix_equals = "docs/"
+			}
+			redirect {
+				replace_key_prefix_with = "documents/"
+			}
+		}
+	}
+	`, indexDocument)
+}
+
+func testAccCheckIBMCosBucketWebsiteConfigurationRedirectConfig(cosServiceName, bucketName string) string {
+	return testAccCheckIBMCosBucketWebsiteConfigurationBase(cosServiceName, bucketName) + `
+	resource "ibm_cos_bucket_website_configuration" "website" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.region_location
+		redirect_all_requests_to {
+			host_name = "www.example.com"
+			protocol  = "https"
+		}
+	}
+	`
+}
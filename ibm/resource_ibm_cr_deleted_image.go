@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func resourceIBMCrDeletedImage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCrDeletedImageCreate,
+		ReadContext:   resourceIBMCrDeletedImageRead,
+		UpdateContext: resourceIBMCrDeletedImageUpdate,
+		DeleteContext: resourceIBMCrDeletedImageDelete,
+
+		Schema: map[string]*schema.Schema{
+			"image": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The image to delete, referenced by tag or by digest. All tags of the image are moved to the trash can.",
+			},
+			"restore_on_destroy": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Restores the image and its tags from the trash can when the resource is destroyed.",
+			},
+			"digest": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fully qualified digest reference of the deleted image.",
+			},
+			"tags": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The tags that were associated with the image when it was deleted.",
+			},
+			"in_trash": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the image is still in the trash can and can be restored.",
+			},
+			"days_until_expiry": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of days before the image is permanently removed from the trash can.",
+			},
+		},
+	}
+}
+
+func resourceIBMCrDeletedImageCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ref := d.Get("image").(string)
+	image, err := crFindImage(context, containerRegistryClient, ref, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if image == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Image %s was not found", ref))
+	}
+	// The digest is the ID of the image in the trash can, the image can't be tracked once deleted without it
+	digest := crImageDigestReference(image, ref)
+	if digest == "" {
+		return diag.FromErr(fmt.Errorf("[ERROR] The digest of image %s could not be resolved, the image is not deleted", ref))
+	}
+
+	deleteImageOptions := &containerregistryv1.DeleteImageOptions{}
+	deleteImageOptions.SetImage(ref)
+
+	_, response, err := containerRegistryClient.DeleteImageWithContext(context, deleteImageOptions)
+	if err != nil {
+		log.Printf("[DEBUG] DeleteImageWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(digest)
+
+	return resourceIBMCrDeletedImageRead(context, d, meta)
+}
+
+func resourceIBMCrDeletedImageRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	trash, err := crTrashEntry(context, containerRegistryClient, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("digest", d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting digest: %s", err))
+	}
+	// An image that left the trash can is either expired or restored outside of Terraform. The resource is
+	// kept so that the image isn't deleted again.
+	if err = d.Set("in_trash", trash != nil); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting in_trash: %s", err))
+	}
+	if trash != nil {
+		if err = d.Set("tags", trash.Tags); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting tags: %s", err))
+		}
+		if err = d.Set("days_until_expiry", intValue(trash.DaysUntilExpiry)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting days_until_expiry: %s", err))
+		}
+	} else {
+		if err = d.Set("days_until_expiry", 0); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting days_until_expiry: %s", err))
+		}
+	}
+
+	return nil
+}
+
+// Dummy update method just for restore_on_destroy
+func resourceIBMCrDeletedImageUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceIBMCrDeletedImageRead(context, d, meta)
+}
+
+func resourceIBMCrDeletedImageDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("restore_on_destroy").(bool) {
+		d.SetId("")
+		return nil
+	}
+
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	trash, err := crTrashEntry(context, containerRegistryClient, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if trash == nil {
+		log.Printf("[WARN] Image %s is no longer in the trash can and can't be restored", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	restoreTagsOptions := &containerregistryv1.RestoreTagsOptions{}
+	restoreTagsOptions.SetDigest(d.Id())
+
+	result, response, err := containerRegistryClient.RestoreTagsWithContext(context, restoreTagsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] RestoreTagsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+	if result != nil && len(result.Unsuccessful) > 0 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error restoring tags %s of image %s", strings.Join(result.Unsuccessful, ", "), d.Id()))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// crTrashEntry returns the trash can entry of the digest reference, or nil if the image isn't in the trash can
+func crTrashEntry(context context.Context, client *containerregistryv1.ContainerRegistryV1, digest string) (*containerregistryv1.Trash, error) {
+	listDeletedImagesOptions := &containerregistryv1.ListDeletedImagesOptions{}
+	// The namespace is the first path segment after the registry domain
+	if parts := strings.Split(crRepository(digest), "/"); len(parts) > 2 {
+		listDeletedImagesOptions.SetNamespace(parts[1])
+	}
+
+	trash, response, err := client.ListDeletedImagesWithContext(context, listDeletedImagesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListDeletedImagesWithContext failed %s\n%s", err, response)
+		return nil, err
+	}
+	if entry, ok := trash[digest]; ok {
+		return &entry, nil
+	}
+	return nil, nil
+}
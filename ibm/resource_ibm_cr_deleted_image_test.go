@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCrDeletedImageBasic(t *testing.T) {
+	targetImage := fmt.Sprintf("%s:tf-%d", crRepository(crImage), acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCrImage(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCrDeletedImageConfig(crImage, targetImage),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_deleted_image.cr_deleted_image", "in_trash", "true"),
+					resource.TestCheckResourceAttrSet("ibm_cr_deleted_image.cr_deleted_image", "digest"),
+					resource.TestCheckResourceAttrSet("ibm_cr_deleted_image.cr_deleted_image", "days_until_expiry"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrDeletedImageConfig(sourceImage string, targetImage string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_cr_image_tag" "cr_image_tag" {
+			source_image = "%s"
+			target_image = "%s"
+		}
+
+		resource "ibm_cr_deleted_image" "cr_deleted_image" {
+			image              = ibm_cr_image_tag.cr_image_tag.target_image
+			restore_on_destroy = true
+		}
+	`, sourceImage, targetImage)
+}
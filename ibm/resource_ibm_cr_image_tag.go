@@ -0,0 +1,239 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func resourceIBMCrImageTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCrImageTagCreate,
+		ReadContext:   resourceIBMCrImageTagRead,
+		DeleteContext: resourceIBMCrImageTagDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return resourceIBMCrImageTagVAFailureCheck(ctx, diff, meta)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"source_image": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The image that is tagged, referenced by tag or by digest, for example `us.icr.io/birds/woodpecker@sha256:...`.",
+			},
+			"target_image": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The new tag for the image, for example `us.icr.io/birds/woodpecker:prod`.",
+			},
+			"fail_on_va_failure": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Fails the plan when the source image is referenced by digest and its Vulnerability Advisor status is FAIL, whatever the severity of the issues.",
+			},
+			"digest": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The digest of the tagged image.",
+			},
+			"vulnerable": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Vulnerability Advisor status of the tagged image.",
+			},
+		},
+	}
+}
+
+func resourceIBMCrImageTagCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tagImageOptions := &containerregistryv1.TagImageOptions{}
+	tagImageOptions.SetFromimage(d.Get("source_image").(string))
+	tagImageOptions.SetToimage(d.Get("target_image").(string))
+
+	response, err := containerRegistryClient.TagImageWithContext(context, tagImageOptions)
+	if err != nil {
+		log.Printf("[DEBUG] TagImageWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("target_image").(string))
+
+	return resourceIBMCrImageTagRead(context, d, meta)
+}
+
+func resourceIBMCrImageTagRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	image, err := crFindImage(context, containerRegistryClient, d.Id(), true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if image == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("target_image", d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting target_image: %s", err))
+	}
+	if _, ok := d.GetOk("source_image"); !ok {
+		// On import the source is unknown, so the tagged digest is used
+		if err = d.Set("source_image", crImageDigestReference(image, d.Id())); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting source_image: %s", err))
+		}
+	}
+	if err = d.Set("digest", crImageDigest(image, d.Id())); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting digest: %s", err))
+	}
+	if err = d.Set("vulnerable", image.Vulnerable); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting vulnerable: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCrImageTagDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteImageTagOptions := &containerregistryv1.DeleteImageTagOptions{}
+	deleteImageTagOptions.SetImage(d.Id())
+
+	_, response, err := containerRegistryClient.DeleteImageTagWithContext(context, deleteImageTagOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteImageTagWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceIBMCrImageTagVAFailureCheck fails the plan when the Vulnerability Advisor status of a source image that
+// is pinned by digest is FAIL. The registry API only reports the overall status and counts, so the check can't
+// filter by severity and any vulnerability or configuration issue that isn't exempted fails it. Images referenced
+// by tag are not checked because the tag can move between plan and apply.
+func resourceIBMCrImageTagVAFailureCheck(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("fail_on_va_failure").(bool) {
+		return nil
+	}
+	if diff.Id() != "" && !diff.HasChange("source_image") && !diff.HasChange("fail_on_va_failure") {
+		return nil
+	}
+	source := diff.Get("source_image").(string)
+	if !diff.NewValueKnown("source_image") || !strings.Contains(source, "@") {
+		return nil
+	}
+
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return err
+	}
+	image, err := crFindImage(context, containerRegistryClient, source, true)
+	if err != nil {
+		return err
+	}
+	if image == nil {
+		return fmt.Errorf("[ERROR] Image %s was not found", source)
+	}
+	if image.Vulnerable != nil && *image.Vulnerable == "FAIL" {
+		return fmt.Errorf("[ERROR] Image %s fails Vulnerability Advisor with %d vulnerabilities and %d configuration issues and can't be tagged while fail_on_va_failure is set", source, intValue(image.VulnerabilityCount), intValue(image.ConfigurationIssueCount))
+	}
+	return nil
+}
+
+// crRepository returns the repository part of an image reference, for example
+// `us.icr.io/birds/woodpecker` for `us.icr.io/birds/woodpecker:prod`.
+func crRepository(image string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		return image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i]
+	}
+	return image
+}
+
+// crFindImage lists the images of the repository of the image reference and returns the image that
+// has the reference as a tag or digest. A nil image is returned when no image matches.
+func crFindImage(context context.Context, client *containerregistryv1.ContainerRegistryV1, ref string, vulnerabilities bool) (*containerregistryv1.RemoteAPIImage, error) {
+	listImagesOptions := &containerregistryv1.ListImagesOptions{}
+	listImagesOptions.SetRepository(crRepository(ref))
+	listImagesOptions.SetVulnerabilities(vulnerabilities)
+	listImagesOptions.SetIncludeManifestLists(true)
+
+	images, response, err := client.ListImagesWithContext(context, listImagesOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil, nil
+		}
+		log.Printf("[DEBUG] ListImagesWithContext failed %s\n%s", err, response)
+		return nil, err
+	}
+	for i := range images {
+		for _, tag := range images[i].RepoTags {
+			if tag == ref {
+				return &images[i], nil
+			}
+		}
+		for _, digest := range images[i].RepoDigests {
+			if digest == ref {
+				return &images[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// crImageDigestReference returns the digest reference of the image in the repository of ref
+func crImageDigestReference(image *containerregistryv1.RemoteAPIImage, ref string) string {
+	repository := crRepository(ref)
+	for _, digest := range image.RepoDigests {
+		if crRepository(digest) == repository {
+			return digest
+		}
+	}
+	return ""
+}
+
+// crImageDigest returns the digest of the image, for example `sha256:...`
+func crImageDigest(image *containerregistryv1.RemoteAPIImage, ref string) string {
+	digestReference := crImageDigestReference(image, ref)
+	if i := strings.Index(digestReference, "@"); i >= 0 {
+		return digestReference[i+1:]
+	}
+	return ""
+}
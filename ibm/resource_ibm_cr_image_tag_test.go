@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"gotest.tools/assert"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func TestAccIBMCrImageTagBasic(t *testing.T) {
+	targetImage := fmt.Sprintf("%s:tf-%d", crRepository(crImage), acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckCrImage(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCrImageTagDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCrImageTagConfig(crImage, targetImage),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_image_tag.cr_image_tag", "target_image", targetImage),
+					resource.TestCheckResourceAttrSet("ibm_cr_image_tag.cr_image_tag", "digest"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_cr_image_tag.cr_image_tag",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_image", "fail_on_va_failure"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrImageTagConfig(sourceImage string, targetImage string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_cr_image_tag" "cr_image_tag" {
+			source_image = "%s"
+			target_image = "%s"
+		}
+	`, sourceImage, targetImage)
+}
+
+func testAccCheckIBMCrImageTagDestroy(s *terraform.State) error {
+	containerRegistryClient, err := testAccProvider.Meta().(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cr_image_tag" {
+			continue
+		}
+
+		image, err := crFindImage(context.Background(), containerRegistryClient, rs.Primary.ID, false)
+		if err != nil {
+			return fmt.Errorf("Error checking for cr_image_tag (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+		if image != nil {
+			return fmt.Errorf("cr_image_tag still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func TestCrRepository(t *testing.T) {
+	assert.Equal(t, "us.icr.io/birds/woodpecker", crRepository("us.icr.io/birds/woodpecker:prod"))
+	assert.Equal(t, "us.icr.io/birds/woodpecker", crRepository("us.icr.io/birds/woodpecker@sha256:0123456789abcdef"))
+	assert.Equal(t, "us.icr.io/birds/woodpecker", crRepository("us.icr.io/birds/woodpecker"))
+	assert.Equal(t, "localhost:5000/birds/woodpecker", crRepository("localhost:5000/birds/woodpecker"))
+}
+
+func TestCrImageDigest(t *testing.T) {
+	image := &containerregistryv1.RemoteAPIImage{
+		RepoDigests: []string{
+			"us.icr.io/birds/robin@sha256:aaaa",
+			"us.icr.io/birds/woodpecker@sha256:bbbb",
+		},
+	}
+	assert.Equal(t, "us.icr.io/birds/woodpecker@sha256:bbbb", crImageDigestReference(image, "us.icr.io/birds/woodpecker:prod"))
+	assert.Equal(t, "sha256:bbbb", crImageDigest(image, "us.icr.io/birds/woodpecker:prod"))
+	assert.Equal(t, "", crImageDigest(image, "us.icr.io/birds/sparrow:prod"))
+}
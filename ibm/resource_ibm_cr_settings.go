@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+const (
+	crSettings = "ibm_cr_settings"
+	crPlan     = "plan"
+
+	// bytesPerMegabyte is used to convert quota limits, which are reported in bytes but set in megabytes
+	bytesPerMegabyte = 1048576
+)
+
+func resourceIBMCrSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCrSettingsCreate,
+		ReadContext:   resourceIBMCrSettingsRead,
+		UpdateContext: resourceIBMCrSettingsUpdate,
+		DeleteContext: resourceIBMCrSettingsDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"plan": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator(crSettings, crPlan),
+				Description:  "The pricing plan of the account in the targeted registry region. Valid values are `lite` and `standard`.",
+			},
+			"storage_megabytes": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The storage quota in megabytes. The value -1 denotes 'Unlimited'.",
+			},
+			"traffic_megabytes": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The traffic quota in megabytes. The value -1 denotes 'Unlimited'.",
+			},
+			"platform_metrics": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Opt in to IBM Cloud Container Registry publishing platform metrics.",
+			},
+			"storage_usage_bytes": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The storage that is used by the account, in bytes.",
+			},
+			"traffic_usage_bytes": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The pull traffic that is used by the account in the current billing period, in bytes.",
+			},
+		},
+	}
+}
+
+func resourceIBMCrSettingsValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 crPlan,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "lite, standard",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: crSettings, Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMCrSettingsCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := resourceIBMCrSettingsApply(context, d, meta, false); diags != nil {
+		return diags
+	}
+
+	d.SetId(userDetails.userAccount)
+
+	return resourceIBMCrSettingsRead(context, d, meta)
+}
+
+func resourceIBMCrSettingsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	plan, response, err := containerRegistryClient.GetPlansWithContext(context, &containerregistryv1.GetPlansOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] GetPlansWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+	if err = d.Set("plan", plan.Plan); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting plan: %s", err))
+	}
+
+	quota, response, err := containerRegistryClient.GetQuotaWithContext(context, &containerregistryv1.GetQuotaOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] GetQuotaWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+	if quota.Limit != nil {
+		if err = d.Set("storage_megabytes", crBytesToMegabytes(quota.Limit.StorageBytes)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting storage_megabytes: %s", err))
+		}
+		if err = d.Set("traffic_megabytes", crBytesToMegabytes(quota.Limit.TrafficBytes)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting traffic_megabytes: %s", err))
+		}
+	}
+	if quota.Usage != nil {
+		if err = d.Set("storage_usage_bytes", intValue(quota.Usage.StorageBytes)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting storage_usage_bytes: %s", err))
+		}
+		if err = d.Set("traffic_usage_bytes", intValue(quota.Usage.TrafficBytes)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting traffic_usage_bytes: %s", err))
+		}
+	}
+
+	settings, response, err := containerRegistryClient.GetSettingsWithContext(context, &containerregistryv1.GetSettingsOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] GetSettingsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+	if err = d.Set("platform_metrics", settings.PlatformMetrics); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting platform_metrics: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCrSettingsUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := resourceIBMCrSettingsApply(context, d, meta, true); diags != nil {
+		return diags
+	}
+
+	return resourceIBMCrSettingsRead(context, d, meta)
+}
+
+// resourceIBMCrSettingsApply sends the plan, quota and settings that are configured. On update only the
+// changed values are sent.
+func resourceIBMCrSettingsApply(context context.Context, d *schema.ResourceData, meta interface{}, onlyChanges bool) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if plan, ok := d.GetOk("plan"); ok && (!onlyChanges || d.HasChange("plan")) {
+		updatePlansOptions := &containerregistryv1.UpdatePlansOptions{}
+		updatePlansOptions.SetPlan(plan.(string))
+		response, err := containerRegistryClient.UpdatePlansWithContext(context, updatePlansOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdatePlansWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	updateQuotaOptions := &containerregistryv1.UpdateQuotaOptions{}
+	hasQuotaChange := false
+	if storage, ok := d.GetOk("storage_megabytes"); ok && (!onlyChanges || d.HasChange("storage_megabytes")) {
+		updateQuotaOptions.SetStorageMegabytes(int64(storage.(int)))
+		hasQuotaChange = true
+	}
+	if traffic, ok := d.GetOk("traffic_megabytes"); ok && (!onlyChanges || d.HasChange("traffic_megabytes")) {
+		updateQuotaOptions.SetTrafficMegabytes(int64(traffic.(int)))
+		hasQuotaChange = true
+	}
+	if hasQuotaChange {
+		response, err := containerRegistryClient.UpdateQuotaWithContext(context, updateQuotaOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateQuotaWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	if platformMetrics, ok := d.GetOkExists("platform_metrics"); ok && (!onlyChanges || d.HasChange("platform_metrics")) {
+		updateSettingsOptions := &containerregistryv1.UpdateSettingsOptions{}
+		updateSettingsOptions.SetPlatformMetrics(platformMetrics.(bool))
+		response, err := containerRegistryClient.UpdateSettingsWithContext(context, updateSettingsOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSettingsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// The registry settings can't be deleted, so the resource is only removed from the state
+func resourceIBMCrSettingsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+
+	return nil
+}
+
+func crBytesToMegabytes(bytes *int64) int {
+	if bytes == nil {
+		return 0
+	}
+	if *bytes < 0 {
+		return int(*bytes)
+	}
+	return int(*bytes / bytesPerMegabyte)
+}
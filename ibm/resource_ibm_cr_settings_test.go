@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCrSettingsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCrSettingsConfig(500, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cr_settings.cr_settings", "plan"),
+					resource.TestCheckResourceAttr("ibm_cr_settings.cr_settings", "storage_megabytes", "500"),
+					resource.TestCheckResourceAttr("ibm_cr_settings.cr_settings", "platform_metrics", "true"),
+					resource.TestCheckResourceAttrSet("ibm_cr_settings.cr_settings", "storage_usage_bytes"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCrSettingsConfig(-1, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_settings.cr_settings", "storage_megabytes", "-1"),
+					resource.TestCheckResourceAttr("ibm_cr_settings.cr_settings", "platform_metrics", "false"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cr_settings.cr_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrSettingsConfig(storageMegabytes int, platformMetrics bool) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_cr_settings" "cr_settings" {
+			storage_megabytes = %d
+			platform_metrics  = %t
+		}
+	`, storageMegabytes, platformMetrics)
+}
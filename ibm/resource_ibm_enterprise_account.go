@@ -7,12 +7,21 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	enterpriseAccountActiveState = "ACTIVE"
 )
 
 func resourceIbmEnterpriseAccount() *schema.Resource {
@@ -27,11 +36,55 @@ func resourceIbmEnterpriseAccount() *schema.Resource {
 			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: resourceIbmEnterpriseAccountIAMBootstrapDiff,
 		Schema: map[string]*schema.Schema{
 			"parent": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The CRN of the parent under which the account will be created. The parent can be an existing account group or the enterprise itself.",
+				Description: "The CRN of the parent under which the account will be created. The parent can be an existing account group or the enterprise itself. Changing the parent moves the account.",
+			},
+			"iam_bootstrap": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Bootstrap IAM in the new account: a service ID with an API key and owner policies is created in the account, and used to create an initial access group with owner access. The bootstrap only runs when the account is created and can't be changed afterwards.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_group_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the access group to create in the new account.",
+						},
+						"members": &schema.Schema{
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "IAM IDs of users or service IDs to add to the access group. The account owner is always added.",
+						},
+						"access_group_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the access group created in the new account.",
+						},
+						"iam_service_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the service ID created in the new account.",
+						},
+						"iam_apikey_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the API key of the service ID created in the new account.",
+						},
+						"iam_apikey": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Sensitive:   true,
+							Description: "The API key of the service ID created in the new account.",
+						},
+					},
+				},
 			},
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -159,6 +212,39 @@ func resourceIbmEnterpriseAccountCreate(context context.Context, d *schema.Resou
 		}
 		d.SetId(d.Get("account_id").(string))
 	} else if checkCreateAccount(d) {
+		if _, ok := d.GetOk("iam_bootstrap"); ok {
+			createAccountResponse, response, err := createEnterpriseAccountWithIAMServiceID(context, enterpriseManagementClient, d)
+			if createAccountResponse != nil && createAccountResponse.AccountID != nil {
+				d.SetId(*createAccountResponse.AccountID)
+			}
+			if err != nil {
+				log.Printf("[DEBUG] CreateAccountWithContext failed %s\n%s", err, response)
+				return diag.FromErr(err)
+			}
+
+			if _, err := waitForEnterpriseAccountState(context, enterpriseManagementClient, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+				return diag.FromErr(fmt.Errorf("Error waiting for account (%s) to be active: %s", d.Id(), err))
+			}
+
+			// The API key is only returned on create, so keep it in state
+			// even when the bootstrap fails half way. The account itself was
+			// created, so a failed bootstrap is a warning rather than an error
+			// that would taint the account and replace it on the next apply.
+			bootstrap, err := bootstrapEnterpriseAccountIAM(context, meta, d, createAccountResponse)
+			if setErr := d.Set("iam_bootstrap", []map[string]interface{}{bootstrap}); setErr != nil {
+				return diag.FromErr(fmt.Errorf("Error setting iam_bootstrap: %s", setErr))
+			}
+			diags := resourceIbmEnterpriseAccountRead(context, d, meta)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("IAM bootstrap of account %s failed", d.Id()),
+					Detail:   fmt.Sprintf("%s\nThe bootstrap isn't run again, complete the IAM configuration of the account with the API key of iam_bootstrap.", err),
+				})
+			}
+			return diags
+		}
+
 		createAccountOptions := &enterprisemanagementv1.CreateAccountOptions{}
 		createAccountOptions.SetParent(d.Get("parent").(string))
 		createAccountOptions.SetName(d.Get("name").(string))
@@ -169,6 +255,10 @@ func resourceIbmEnterpriseAccountCreate(context context.Context, d *schema.Resou
 			return diag.FromErr(err)
 		}
 		d.SetId(*createAccountResponse.AccountID)
+
+		if _, err := waitForEnterpriseAccountState(context, enterpriseManagementClient, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error waiting for account (%s) to be active: %s", d.Id(), err))
+		}
 	} else {
 
 		err := errors.New("Required Parameters are missing." +
@@ -199,60 +289,69 @@ func resourceIbmEnterpriseAccountRead(context context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	if err = d.Set("parent", account.Parent); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting parent: %s", err))
+	if err = setEnterpriseAccountAttributes(d, account); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// setEnterpriseAccountAttributes sets the attributes shared by
+// ibm_enterprise_account and ibm_enterprise_account_import from account.
+func setEnterpriseAccountAttributes(d *schema.ResourceData, account *enterprisemanagementv1.Account) error {
+	if err := d.Set("parent", account.Parent); err != nil {
+		return fmt.Errorf("Error setting parent: %s", err)
 	}
-	if err = d.Set("name", account.Name); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	if err := d.Set("name", account.Name); err != nil {
+		return fmt.Errorf("Error setting name: %s", err)
 	}
-	if err = d.Set("owner_iam_id", account.OwnerIamID); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting owner_iam_id: %s", err))
+	if err := d.Set("owner_iam_id", account.OwnerIamID); err != nil {
+		return fmt.Errorf("Error setting owner_iam_id: %s", err)
 	}
-	if err = d.Set("account_id", account.ID); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting account_id: %s", err))
+	if err := d.Set("account_id", account.ID); err != nil {
+		return fmt.Errorf("Error setting account_id: %s", err)
 	}
-	if err = d.Set("url", account.URL); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting url: %s", err))
+	if err := d.Set("url", account.URL); err != nil {
+		return fmt.Errorf("Error setting url: %s", err)
 	}
 
-	if err = d.Set("crn", account.CRN); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting crn: %s", err))
+	if err := d.Set("crn", account.CRN); err != nil {
+		return fmt.Errorf("Error setting crn: %s", err)
 	}
-	if err = d.Set("enterprise_account_id", account.EnterpriseAccountID); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting enterprise_account_id: %s", err))
+	if err := d.Set("enterprise_account_id", account.EnterpriseAccountID); err != nil {
+		return fmt.Errorf("Error setting enterprise_account_id: %s", err)
 	}
-	if err = d.Set("enterprise_id", account.EnterpriseID); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting enterprise_id: %s", err))
+	if err := d.Set("enterprise_id", account.EnterpriseID); err != nil {
+		return fmt.Errorf("Error setting enterprise_id: %s", err)
 	}
-	if err = d.Set("enterprise_path", account.EnterprisePath); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting enterprise_path: %s", err))
+	if err := d.Set("enterprise_path", account.EnterprisePath); err != nil {
+		return fmt.Errorf("Error setting enterprise_path: %s", err)
 	}
-	if err = d.Set("state", account.State); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting state: %s", err))
+	if err := d.Set("state", account.State); err != nil {
+		return fmt.Errorf("Error setting state: %s", err)
 	}
-	if err = d.Set("paid", account.Paid); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting paid: %s", err))
+	if err := d.Set("paid", account.Paid); err != nil {
+		return fmt.Errorf("Error setting paid: %s", err)
 	}
-	if err = d.Set("owner_email", account.OwnerEmail); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting owner_email: %s", err))
+	if err := d.Set("owner_email", account.OwnerEmail); err != nil {
+		return fmt.Errorf("Error setting owner_email: %s", err)
 	}
-	if err = d.Set("is_enterprise_account", account.IsEnterpriseAccount); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting is_enterprise_account: %s", err))
+	if err := d.Set("is_enterprise_account", account.IsEnterpriseAccount); err != nil {
+		return fmt.Errorf("Error setting is_enterprise_account: %s", err)
 	}
-	if err = d.Set("created_at", account.CreatedAt.String()); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	if err := d.Set("created_at", account.CreatedAt.String()); err != nil {
+		return fmt.Errorf("Error setting created_at: %s", err)
 	}
-	if err = d.Set("created_by", account.CreatedBy); err != nil {
-		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	if err := d.Set("created_by", account.CreatedBy); err != nil {
+		return fmt.Errorf("Error setting created_by: %s", err)
 	}
 	if account.UpdatedAt != nil {
-		if err = d.Set("updated_at", account.UpdatedAt.String()); err != nil {
-			return diag.FromErr(fmt.Errorf("Error setting updated_at: %s", err))
+		if err := d.Set("updated_at", account.UpdatedAt.String()); err != nil {
+			return fmt.Errorf("Error setting updated_at: %s", err)
 		}
 	}
 	if account.UpdatedBy != nil {
-		if err = d.Set("updated_by", account.UpdatedBy); err != nil {
-			return diag.FromErr(fmt.Errorf("Error setting updated_by: %s", err))
+		if err := d.Set("updated_by", account.UpdatedBy); err != nil {
+			return fmt.Errorf("Error setting updated_by: %s", err)
 		}
 	}
 	return nil
@@ -291,6 +390,10 @@ func resourceIbmEnterpriseAccountUpdate(context context.Context, d *schema.Resou
 			log.Printf("[DEBUG] UpdateAccountWithContext failed %s\n%s", err, response)
 			return diag.FromErr(err)
 		}
+
+		if _, err := waitForEnterpriseAccountParent(context, enterpriseManagementClient, d.Id(), d.Get("parent").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error waiting for account (%s) to be moved: %s", d.Id(), err))
+		}
 	}
 
 	return resourceIbmEnterpriseAccountRead(context, d, meta)
@@ -302,3 +405,258 @@ func resourceIbmEnterpriseAccountDelete(context context.Context, d *schema.Resou
 
 	return nil
 }
+
+// waitForEnterpriseAccountState waits until the account is active. Accounts
+// are created and imported asynchronously, so they can be missing right after
+// the request is accepted.
+func waitForEnterpriseAccountState(context context.Context, client *enterprisemanagementv1.EnterpriseManagementV1, accountID string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pending"},
+		Target:  []string{enterpriseAccountActiveState},
+		Refresh: func() (interface{}, string, error) {
+			getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+			getAccountOptions.SetAccountID(accountID)
+			account, response, err := client.GetAccountWithContext(context, getAccountOptions)
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return account, "pending", nil
+				}
+				return nil, "", fmt.Errorf("GetAccountWithContext failed %s\n%s", err, response)
+			}
+			if account.State == nil || *account.State != enterpriseAccountActiveState {
+				return account, "pending", nil
+			}
+			return account, enterpriseAccountActiveState, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+// waitForEnterpriseAccountParent waits until a move of the account to parent
+// is reflected by the enterprise.
+func waitForEnterpriseAccountParent(context context.Context, client *enterprisemanagementv1.EnterpriseManagementV1, accountID, parent string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"moving"},
+		Target:  []string{"moved"},
+		Refresh: func() (interface{}, string, error) {
+			getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+			getAccountOptions.SetAccountID(accountID)
+			account, response, err := client.GetAccountWithContext(context, getAccountOptions)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetAccountWithContext failed %s\n%s", err, response)
+			}
+			if account.Parent == nil || *account.Parent != parent {
+				return account, "moving", nil
+			}
+			return account, "moved", nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+// enterpriseAccountCreateRequest and enterpriseAccountCreateResponse model the
+// create account options of the enterprise management API that are not part of
+// the SDK yet.
+type enterpriseAccountCreateRequest struct {
+	Parent     string                          `json:"parent"`
+	Name       string                          `json:"name"`
+	OwnerIamID string                          `json:"owner_iam_id"`
+	Options    *enterpriseAccountCreateOptions `json:"options,omitempty"`
+}
+
+type enterpriseAccountCreateOptions struct {
+	CreateIamServiceIDWithApikeyAndOwnerPolicies bool `json:"create_iam_service_id_with_apikey_and_owner_policies"`
+}
+
+type enterpriseAccountCreateResponse struct {
+	AccountID    *string `json:"account_id,omitempty"`
+	IamServiceID *string `json:"iam_service_id,omitempty"`
+	IamApikeyID  *string `json:"iam_apikey_id,omitempty"`
+	IamApikey    *string `json:"iam_apikey,omitempty"`
+}
+
+// createEnterpriseAccountWithIAMServiceID creates an account and lets the
+// enterprise create a service ID with an API key and owner policies in it.
+func createEnterpriseAccountWithIAMServiceID(context context.Context, client *enterprisemanagementv1.EnterpriseManagementV1, d *schema.ResourceData) (*enterpriseAccountCreateResponse, *core.DetailedResponse, error) {
+	body := enterpriseAccountCreateRequest{
+		Parent:     d.Get("parent").(string),
+		Name:       d.Get("name").(string),
+		OwnerIamID: d.Get("owner_iam_id").(string),
+		Options: &enterpriseAccountCreateOptions{
+			CreateIamServiceIDWithApikeyAndOwnerPolicies: true,
+		},
+	}
+
+	builder := core.NewRequestBuilder(core.POST)
+	builder = builder.WithContext(context)
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, `/accounts`, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	builder.AddHeader("Content-Type", "application/json")
+	if _, err = builder.SetBodyContentJSON(body); err != nil {
+		return nil, nil, err
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := &enterpriseAccountCreateResponse{}
+	response, err := client.Service.Request(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+	if result.AccountID == nil || result.IamServiceID == nil || result.IamApikeyID == nil || result.IamApikey == nil {
+		return result, response, fmt.Errorf("[ERROR] Error creating account: the response has no account ID or service ID API key")
+	}
+	return result, response, nil
+}
+
+// resourceIbmEnterpriseAccountIAMBootstrapDiff rejects changes of iam_bootstrap once the account exists, the
+// bootstrap only runs when the account is created
+func resourceIbmEnterpriseAccountIAMBootstrapDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange("iam_bootstrap") {
+		return fmt.Errorf("iam_bootstrap of account %s can't be changed, it only applies when the account is created", diff.Id())
+	}
+	return nil
+}
+
+// bootstrapEnterpriseAccountIAM uses the API key of the service ID created in
+// the new account to create an access group with the owner and the configured
+// members, and owner policies for the access group.
+func bootstrapEnterpriseAccountIAM(context context.Context, meta interface{}, d *schema.ResourceData, account *enterpriseAccountCreateResponse) (map[string]interface{}, error) {
+	bootstrap := map[string]interface{}{
+		"access_group_name": d.Get("iam_bootstrap.0.access_group_name").(string),
+		"members":           d.Get("iam_bootstrap.0.members").(*schema.Set),
+		"iam_service_id":    *account.IamServiceID,
+		"iam_apikey_id":     *account.IamApikeyID,
+		"iam_apikey":        *account.IamApikey,
+	}
+
+	iamAccessGroupsClient, err := meta.(ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return bootstrap, err
+	}
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return bootstrap, err
+	}
+
+	authenticator := &core.IamAuthenticator{
+		ApiKey: *account.IamApikey,
+		URL:    iamAccessGroupsClient.Service.Options.URL + "/identity/token",
+	}
+	childAccessGroupsClient, err := iamaccessgroupsv2.NewIamAccessGroupsV2(&iamaccessgroupsv2.IamAccessGroupsV2Options{
+		Authenticator: authenticator,
+		URL:           iamAccessGroupsClient.Service.Options.URL,
+	})
+	if err != nil {
+		return bootstrap, fmt.Errorf("[ERROR] Error configuring IAM access groups for account %s: %s", *account.AccountID, err)
+	}
+	childPolicyManagementClient, err := iampolicymanagementv1.NewIamPolicyManagementV1(&iampolicymanagementv1.IamPolicyManagementV1Options{
+		Authenticator: authenticator,
+		URL:           iamPolicyManagementClient.Service.Options.URL,
+	})
+	if err != nil {
+		return bootstrap, fmt.Errorf("[ERROR] Error configuring IAM policy management for account %s: %s", *account.AccountID, err)
+	}
+
+	// IAM of a new account can take a while to accept the new API key.
+	var accessGroupID string
+	err = resource.RetryContext(context, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		createAccessGroupOptions := childAccessGroupsClient.NewCreateAccessGroupOptions(*account.AccountID, bootstrap["access_group_name"].(string))
+		createAccessGroupOptions.SetDescription("Owner access group created by Terraform")
+		accessGroup, response, err := childAccessGroupsClient.CreateAccessGroupWithContext(context, createAccessGroupOptions)
+		if err != nil {
+			if response != nil && (response.StatusCode == 401 || response.StatusCode == 403) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(fmt.Errorf("CreateAccessGroupWithContext failed %s\n%s", err, response))
+		}
+		accessGroupID = *accessGroup.ID
+		return nil
+	})
+	if err != nil {
+		return bootstrap, fmt.Errorf("[ERROR] Error creating access group in account %s: %s", *account.AccountID, err)
+	}
+	bootstrap["access_group_id"] = accessGroupID
+
+	members := []iamaccessgroupsv2.AddGroupMembersRequestMembersItem{}
+	for _, iamID := range enterpriseAccountBootstrapMembers(d.Get("owner_iam_id").(string), expandStringList(bootstrap["members"].(*schema.Set).List())) {
+		member, _ := childAccessGroupsClient.NewAddGroupMembersRequestMembersItem(iamID, enterpriseAccountMemberType(iamID))
+		members = append(members, *member)
+	}
+	addMembersOptions := childAccessGroupsClient.NewAddMembersToAccessGroupOptions(accessGroupID)
+	addMembersOptions.SetMembers(members)
+	if _, response, err := childAccessGroupsClient.AddMembersToAccessGroupWithContext(context, addMembersOptions); err != nil {
+		return bootstrap, fmt.Errorf("[ERROR] Error adding members to access group %s in account %s: %s\n%s", accessGroupID, *account.AccountID, err, response)
+	}
+
+	for _, policy := range enterpriseAccountOwnerPolicies {
+		subject := iampolicymanagementv1.PolicySubject{
+			Attributes: []iampolicymanagementv1.SubjectAttribute{
+				{Name: core.StringPtr("access_group_id"), Value: &accessGroupID},
+			},
+		}
+		policyResource := iampolicymanagementv1.PolicyResource{
+			Attributes: []iampolicymanagementv1.ResourceAttribute{
+				{Name: core.StringPtr("accountId"), Value: account.AccountID, Operator: core.StringPtr("stringEquals")},
+				{Name: core.StringPtr("serviceType"), Value: core.StringPtr(policy.serviceType), Operator: core.StringPtr("stringEquals")},
+			},
+		}
+		roles := make([]iampolicymanagementv1.PolicyRole, 0, len(policy.roleIDs))
+		for _, roleID := range policy.roleIDs {
+			roles = append(roles, iampolicymanagementv1.PolicyRole{RoleID: core.StringPtr(roleID)})
+		}
+		createPolicyOptions := childPolicyManagementClient.NewCreatePolicyOptions("access", []iampolicymanagementv1.PolicySubject{subject}, roles, []iampolicymanagementv1.PolicyResource{policyResource})
+		if _, response, err := childPolicyManagementClient.CreatePolicyWithContext(context, createPolicyOptions); err != nil {
+			return bootstrap, fmt.Errorf("[ERROR] Error creating owner policy for access group %s in account %s: %s\n%s", accessGroupID, *account.AccountID, err, response)
+		}
+	}
+
+	return bootstrap, nil
+}
+
+// enterpriseAccountOwnerPolicies grant full access to all IAM enabled services
+// and to all account management services.
+var enterpriseAccountOwnerPolicies = []struct {
+	serviceType string
+	roleIDs     []string
+}{
+	{"service", []string{"crn:v1:bluemix:public:iam::::role:Administrator", "crn:v1:bluemix:public:iam::::serviceRole:Manager"}},
+	{"platform_service", []string{"crn:v1:bluemix:public:iam::::role:Administrator"}},
+}
+
+// enterpriseAccountBootstrapMembers returns the owner followed by the other
+// members, without duplicates.
+func enterpriseAccountBootstrapMembers(ownerIamID string, members []string) []string {
+	result := []string{ownerIamID}
+	seen := map[string]bool{ownerIamID: true}
+	for _, member := range members {
+		if !seen[member] {
+			seen[member] = true
+			result = append(result, member)
+		}
+	}
+	return result
+}
+
+func enterpriseAccountMemberType(iamID string) string {
+	if strings.HasPrefix(iamID, "iam-ServiceId-") {
+		return "service"
+	}
+	if strings.HasPrefix(iamID, "iam-Profile-") {
+		return "profile"
+	}
+	return "user"
+}
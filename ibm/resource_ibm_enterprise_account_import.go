@@ -0,0 +1,250 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIbmEnterpriseAccountImport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmEnterpriseAccountImportCreate,
+		ReadContext:   resourceIbmEnterpriseAccountImportRead,
+		UpdateContext: resourceIbmEnterpriseAccountImportUpdate,
+		DeleteContext: resourceIbmEnterpriseAccountImportDelete,
+		Importer:      &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"enterprise_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the enterprise to import the account into.",
+			},
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the existing stand-alone account to import.",
+			},
+			"parent": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The CRN of the account group or enterprise to import the account under. Defaults to the enterprise. Changing the parent moves the account.",
+			},
+			"billing_unit_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The ID of the billing unit to associate with the account.",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the account.",
+			},
+			"owner_iam_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the account owner.",
+			},
+			"url": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the account.",
+			},
+			"crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Cloud Resource Name (CRN) of the account.",
+			},
+			"enterprise_account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The enterprise account ID.",
+			},
+			"enterprise_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The path from the enterprise to this particular account.",
+			},
+			"state": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the account.",
+			},
+			"paid": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "The type of account - whether it is free or paid.",
+			},
+			"owner_email": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The email address of the owner of the account.",
+			},
+			"is_enterprise_account": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "The flag to indicate whether the account is an enterprise account or not.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time stamp at which the account was created.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the user or service that created the account.",
+			},
+			"updated_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time stamp at which the account was last updated.",
+			},
+			"updated_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the user or service that updated the account.",
+			},
+		},
+	}
+}
+
+func resourceIbmEnterpriseAccountImportCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseManagementClient, err := meta.(ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	enterpriseID := d.Get("enterprise_id").(string)
+	accountID := d.Get("account_id").(string)
+
+	importAccountToEnterpriseOptions := &enterprisemanagementv1.ImportAccountToEnterpriseOptions{}
+	importAccountToEnterpriseOptions.SetEnterpriseID(enterpriseID)
+	importAccountToEnterpriseOptions.SetAccountID(accountID)
+	if parent, ok := d.GetOk("parent"); ok {
+		importAccountToEnterpriseOptions.SetParent(parent.(string))
+	}
+	if billingUnitID, ok := d.GetOk("billing_unit_id"); ok {
+		importAccountToEnterpriseOptions.SetBillingUnitID(billingUnitID.(string))
+	}
+
+	response, err := enterpriseManagementClient.ImportAccountToEnterpriseWithContext(context, importAccountToEnterpriseOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ImportAccountToEnterpriseWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ImportAccountToEnterpriseWithContext failed %s\n%s", err, response))
+	}
+	d.SetId(accountID)
+
+	if _, err := waitForEnterpriseAccountImport(context, enterpriseManagementClient, accountID, enterpriseID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error waiting for account (%s) to be imported: %s", d.Id(), err))
+	}
+
+	return resourceIbmEnterpriseAccountImportRead(context, d, meta)
+}
+
+func resourceIbmEnterpriseAccountImportRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseManagementClient, err := meta.(ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+	getAccountOptions.SetAccountID(d.Id())
+
+	account, response, err := enterpriseManagementClient.GetAccountWithContext(context, getAccountOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetAccountWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetAccountWithContext failed %s\n%s", err, response))
+	}
+
+	if err = setEnterpriseAccountAttributes(d, account); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceIbmEnterpriseAccountImportUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	enterpriseManagementClient, err := meta.(ClientSession).EnterpriseManagementV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("parent") {
+		parent := d.Get("parent").(string)
+		updateAccountOptions := &enterprisemanagementv1.UpdateAccountOptions{}
+		updateAccountOptions.SetAccountID(d.Id())
+		updateAccountOptions.SetParent(parent)
+
+		response, err := enterpriseManagementClient.UpdateAccountWithContext(context, updateAccountOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateAccountWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateAccountWithContext failed %s\n%s", err, response))
+		}
+
+		if _, err := waitForEnterpriseAccountParent(context, enterpriseManagementClient, d.Id(), parent, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error waiting for account (%s) to be moved: %s", d.Id(), err))
+		}
+	}
+
+	return resourceIbmEnterpriseAccountImportRead(context, d, meta)
+}
+
+func resourceIbmEnterpriseAccountImportDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// An account can't be removed from an enterprise, it is only removed
+	// from the state.
+	log.Printf("[WARN] Account %s stays in enterprise %s, removing it from state only", d.Id(), d.Get("enterprise_id").(string))
+	d.SetId("")
+
+	return nil
+}
+
+// waitForEnterpriseAccountImport waits until the account is an active member
+// of the enterprise.
+func waitForEnterpriseAccountImport(context context.Context, client *enterprisemanagementv1.EnterpriseManagementV1, accountID, enterpriseID string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"importing"},
+		Target:  []string{"imported"},
+		Refresh: func() (interface{}, string, error) {
+			getAccountOptions := &enterprisemanagementv1.GetAccountOptions{}
+			getAccountOptions.SetAccountID(accountID)
+			account, response, err := client.GetAccountWithContext(context, getAccountOptions)
+			if err != nil {
+				if response != nil && (response.StatusCode == 403 || response.StatusCode == 404) {
+					return account, "importing", nil
+				}
+				return nil, "", fmt.Errorf("GetAccountWithContext failed %s\n%s", err, response)
+			}
+			if account.EnterpriseID == nil || *account.EnterpriseID != enterpriseID ||
+				account.State == nil || *account.State != enterpriseAccountActiveState {
+				return account, "importing", nil
+			}
+			return account, "imported", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/IBM/platform-services-go-sdk/enterprisemanagementv1"
+)
+
+/* To run this test case ensure the IC_API_KEY belongs to an enterprise.
+ACCOUNT_TO_BE_IMPORTED should invite enterprise and grant relevant iam policies before running this test case" */
+func TestAccIbmEnterpriseAccountImportBasic(t *testing.T) {
+	var conf enterprisemanagementv1.Account
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckEnterpriseAccountImport(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmEnterpriseAccountImportConfig(account_to_be_imported, "data.ibm_enterprises.enterprises_instance.enterprises[0].crn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmEnterpriseAccountExists("ibm_enterprise_account_import.account", conf),
+					resource.TestCheckResourceAttr("ibm_enterprise_account_import.account", "account_id", account_to_be_imported),
+					resource.TestCheckResourceAttr("ibm_enterprise_account_import.account", "state", "ACTIVE"),
+					resource.TestCheckResourceAttrPair("ibm_enterprise_account_import.account", "parent", "data.ibm_enterprises.enterprises_instance", "enterprises.0.crn"),
+				),
+			},
+			resource.TestStep{
+				// Moving the account to an account group updates it in place.
+				Config: testAccCheckIbmEnterpriseAccountImportConfig(account_to_be_imported, "data.ibm_enterprise_account_groups.account_groups_instance.account_groups[0].crn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_enterprise_account_import.account", "id", account_to_be_imported),
+					resource.TestCheckResourceAttrPair("ibm_enterprise_account_import.account", "parent", "data.ibm_enterprise_account_groups.account_groups_instance", "account_groups.0.crn"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_enterprise_account_import.account",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"billing_unit_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmEnterpriseAccountImportConfig(accountToBeImported, parent string) string {
+	return fmt.Sprintf(`
+		data "ibm_enterprises" "enterprises_instance" {
+		}
+		data "ibm_enterprise_account_groups" "account_groups_instance" {
+		}
+		resource "ibm_enterprise_account_import" "account" {
+			enterprise_id = data.ibm_enterprises.enterprises_instance.enterprises[0].id
+			account_id    = "%s"
+			parent        = %s
+		}
+	`, accountToBeImported, parent)
+}
@@ -36,7 +36,7 @@ func TestAccIbmEnterpriseAccountBasic(t *testing.T) {
 			resource.TestStep{
 				Config: testAccCheckIbmEnterpriseAccountConfigUpdateBasic(name),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("ibm_enterprise_account.enterprise_account", "parent"),
+					resource.TestCheckResourceAttrPair("ibm_enterprise_account.enterprise_account", "parent", "data.ibm_enterprise_account_groups.account_groups_instance", "account_groups.0.crn"),
 					resource.TestCheckResourceAttrSet("ibm_enterprise_account.enterprise_account", "name"),
 					resource.TestCheckResourceAttrSet("ibm_enterprise_account.enterprise_account", "owner_iam_id"),
 				),
@@ -71,6 +71,55 @@ func TestAccIbmEnterpriseImportAccountBasic(t *testing.T) {
 	})
 }
 
+/* To run this test case ensure the IC_API_KEY belongs to an enterprise" */
+func TestAccIbmEnterpriseAccountIAMBootstrap(t *testing.T) {
+	var conf enterprisemanagementv1.Account
+	name := fmt.Sprintf("tf-gen-account-name_%d", acctest.RandIntRange(10, 100))
+	accessGroupName := fmt.Sprintf("tf-owners-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckEnterprise(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmEnterpriseAccountConfigIAMBootstrap(name, accessGroupName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmEnterpriseAccountExists("ibm_enterprise_account.enterprise_account", conf),
+					resource.TestCheckResourceAttr("ibm_enterprise_account.enterprise_account", "state", "ACTIVE"),
+					resource.TestCheckResourceAttr("ibm_enterprise_account.enterprise_account", "iam_bootstrap.0.access_group_name", accessGroupName),
+					resource.TestCheckResourceAttrSet("ibm_enterprise_account.enterprise_account", "iam_bootstrap.0.access_group_id"),
+					resource.TestCheckResourceAttrSet("ibm_enterprise_account.enterprise_account", "iam_bootstrap.0.iam_service_id"),
+					resource.TestCheckResourceAttrSet("ibm_enterprise_account.enterprise_account", "iam_bootstrap.0.iam_apikey"),
+				),
+			},
+		},
+	})
+}
+
+func TestEnterpriseAccountBootstrapMembers(t *testing.T) {
+	members := enterpriseAccountBootstrapMembers("IBMid-owner", []string{"IBMid-a", "IBMid-owner", "iam-ServiceId-b", "IBMid-a"})
+	expected := []string{"IBMid-owner", "IBMid-a", "iam-ServiceId-b"}
+	if len(members) != len(expected) {
+		t.Fatalf("enterpriseAccountBootstrapMembers() = %v, expected %v", members, expected)
+	}
+	for i := range expected {
+		if members[i] != expected[i] {
+			t.Fatalf("enterpriseAccountBootstrapMembers() = %v, expected %v", members, expected)
+		}
+	}
+
+	for iamID, memberType := range map[string]string{
+		"IBMid-123":                 "user",
+		"iam-ServiceId-123":         "service",
+		"iam-Profile-123":           "profile",
+		"1234567890-abcdefghijklmn": "user",
+	} {
+		if got := enterpriseAccountMemberType(iamID); got != memberType {
+			t.Errorf("enterpriseAccountMemberType(%q) = %q, expected %q", iamID, got, memberType)
+		}
+	}
+}
+
 func testAccCheckIbmEnterpriseAccountConfigBasic(name string) string {
 	return fmt.Sprintf(`
 		data "ibm_enterprises" "enterprises_instance" {
@@ -134,3 +183,18 @@ func testAccCheckIbmEnterpriseAccountExists(n string, obj enterprisemanagementv1
 		return nil
 	}
 }
+
+func testAccCheckIbmEnterpriseAccountConfigIAMBootstrap(name, accessGroupName string) string {
+	return fmt.Sprintf(`
+		data "ibm_enterprises" "enterprises_instance" {
+		}
+		resource "ibm_enterprise_account" "enterprise_account" {
+			parent = data.ibm_enterprises.enterprises_instance.enterprises[0].crn
+			name = "%s"
+			owner_iam_id = data.ibm_enterprises.enterprises_instance.enterprises[0].primary_contact_iam_id
+			iam_bootstrap {
+				access_group_name = "%s"
+			}
+		}
+	`, name, accessGroupName)
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMEventStreamsMirroringConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsMirroringConfigUpdate,
+		ReadContext:   resourceIBMEventStreamsMirroringConfigRead,
+		UpdateContext: resourceIBMEventStreamsMirroringConfigUpdate,
+		DeleteContext: resourceIBMEventStreamsMirroringConfigDelete,
+		Importer:      &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Event Streams instance the topics are mirrored to",
+			},
+			"mirroring_topic_patterns": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The patterns of the names of the topics mirrored from the source instance",
+			},
+			"mirroring_active_topics": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The topics that are mirrored",
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsMirroringConfigUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("resource_instance_id").(string)
+	esClient, err := eventStreamsAdminAPI(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	patterns := expandStringList(d.Get("mirroring_topic_patterns").([]interface{}))
+	if _, err := esClient.ReplaceMirroringTopicSelection(context, patterns); err != nil {
+		return diag.Errorf("Error setting mirroring topic selection of instance %s: %s", instanceCRN, err)
+	}
+	d.SetId(instanceCRN)
+
+	return resourceIBMEventStreamsMirroringConfigRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsMirroringConfigRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Id()
+	esClient, err := eventStreamsAdminAPI(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	selection, _, err := esClient.GetMirroringTopicSelection(context)
+	if err != nil {
+		return diag.Errorf("Error reading mirroring topic selection of instance %s: %s", instanceCRN, err)
+	}
+	activeTopics, _, err := esClient.GetMirroringActiveTopics(context)
+	if err != nil {
+		return diag.Errorf("Error reading mirroring active topics of instance %s: %s", instanceCRN, err)
+	}
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("mirroring_topic_patterns", selection.Includes)
+	d.Set("mirroring_active_topics", activeTopics)
+
+	return nil
+}
+
+func resourceIBMEventStreamsMirroringConfigDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Id()
+	esClient, err := eventStreamsAdminAPI(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Mirroring stays enabled on the instance, no topics are selected anymore
+	if _, err := esClient.ReplaceMirroringTopicSelection(context, []string{}); err != nil {
+		return diag.Errorf("Error clearing mirroring topic selection of instance %s: %s", instanceCRN, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// existingMirroringTargetInstanceName is an enterprise instance with mirroring enabled
+var existingMirroringTargetInstanceName = "hyperion-preprod-spp-a-mirroring-target"
+
+func TestAccIBMEventStreamsMirroringConfigResourceWithExistingInstance(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsMirroringConfig(existingMirroringTargetInstanceName, `["orders.*"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring", "mirroring_topic_patterns.#", "1"),
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring", "mirroring_topic_patterns.0", "orders.*"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsMirroringConfig(existingMirroringTargetInstanceName, `["orders.*", "payments"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring", "mirroring_topic_patterns.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "ibm_event_streams_mirroring_config.es_mirroring",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"mirroring_active_topics"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsMirroringConfig(instanceName, patterns string) string {
+	return getPlatformResource(instanceName) + fmt.Sprintf(`
+	resource "ibm_event_streams_mirroring_config" "es_mirroring" {
+		resource_instance_id     = data.ibm_resource_instance.es_instance.id
+		mirroring_topic_patterns = %s
+	}`, patterns)
+}
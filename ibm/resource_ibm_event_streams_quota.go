@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMEventStreamsQuota() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsQuotaCreate,
+		ReadContext:   resourceIBMEventStreamsQuotaRead,
+		UpdateContext: resourceIBMEventStreamsQuotaUpdate,
+		DeleteContext: resourceIBMEventStreamsQuotaDelete,
+		Importer:      &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			"entity": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The entity the quota applies to, default for the default quota of the instance or the ID of an IAM service ID",
+			},
+			"producer_byte_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateAllowedRangeInt(1, 2147483647),
+				AtLeastOneOf: []string{"producer_byte_rate", "consumer_byte_rate"},
+				Description:  "The producer throughput quota in bytes per second",
+			},
+			"consumer_byte_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateAllowedRangeInt(1, 2147483647),
+				AtLeastOneOf: []string{"producer_byte_rate", "consumer_byte_rate"},
+				Description:  "The consumer throughput quota in bytes per second",
+			},
+		},
+	}
+}
+
+func expandEventStreamsQuota(d *schema.ResourceData) eventStreamsQuota {
+	quota := eventStreamsQuota{}
+	if v, ok := d.GetOk("producer_byte_rate"); ok {
+		rate := int64(v.(int))
+		quota.ProducerByteRate = &rate
+	}
+	if v, ok := d.GetOk("consumer_byte_rate"); ok {
+		rate := int64(v.(int))
+		quota.ConsumerByteRate = &rate
+	}
+	return quota
+}
+
+func resourceIBMEventStreamsQuotaCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("resource_instance_id").(string)
+	entity := d.Get("entity").(string)
+	esClient, err := eventStreamsAdminAPI(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := esClient.CreateQuota(context, entity, expandEventStreamsQuota(d)); err != nil {
+		return diag.Errorf("Error creating quota of %s: %s", entity, err)
+	}
+	d.SetId(getEventStreamsResourceID(instanceCRN, "quota", entity))
+
+	return resourceIBMEventStreamsQuotaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsQuotaRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, entity, err := eventStreamsResourceIDParts(d.Id(), "quota")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	esClient, err := eventStreamsAdminAPI(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	quota, response, err := esClient.GetQuota(context, entity)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading quota of %s: %s", entity, err)
+	}
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("entity", entity)
+	if quota.ProducerByteRate != nil {
+		d.Set("producer_byte_rate", *quota.ProducerByteRate)
+	} else {
+		d.Set("producer_byte_rate", nil)
+	}
+	if quota.ConsumerByteRate != nil {
+		d.Set("consumer_byte_rate", *quota.ConsumerByteRate)
+	} else {
+		d.Set("consumer_byte_rate", nil)
+	}
+
+	return nil
+}
+
+func resourceIBMEventStreamsQuotaUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, entity, err := eventStreamsResourceIDParts(d.Id(), "quota")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	esClient, err := eventStreamsAdminAPI(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	quota := expandEventStreamsQuota(d)
+	// The rates missing in the update are kept, the quota is recreated when a rate is removed
+	if (quota.ProducerByteRate == nil && d.HasChange("producer_byte_rate")) || (quota.ConsumerByteRate == nil && d.HasChange("consumer_byte_rate")) {
+		if _, err := esClient.DeleteQuota(context, entity); err != nil {
+			return diag.Errorf("Error deleting quota of %s: %s", entity, err)
+		}
+		if _, err := esClient.CreateQuota(context, entity, quota); err != nil {
+			return diag.Errorf("Error creating quota of %s: %s", entity, err)
+		}
+		return resourceIBMEventStreamsQuotaRead(context, d, meta)
+	}
+	if _, err := esClient.UpdateQuota(context, entity, quota); err != nil {
+		return diag.Errorf("Error updating quota of %s: %s", entity, err)
+	}
+
+	return resourceIBMEventStreamsQuotaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsQuotaDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, entity, err := eventStreamsResourceIDParts(d.Id(), "quota")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	esClient, err := eventStreamsAdminAPI(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := esClient.DeleteQuota(context, entity)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.Errorf("Error deleting quota of %s: %s", entity, err)
+	}
+
+	d.SetId("")
+	return nil
+}
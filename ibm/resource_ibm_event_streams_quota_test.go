@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsQuotaResourceWithExistingInstance(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsQuotaConfig(existingInstanceName, "producer_byte_rate = 1048576"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "entity", "default"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "producer_byte_rate", "1048576"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsQuotaConfig(existingInstanceName, "consumer_byte_rate = 2097152"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "producer_byte_rate", "0"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "consumer_byte_rate", "2097152"),
+				),
+			},
+			{
+				ResourceName:      "ibm_event_streams_quota.es_quota",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsQuotaConfig(instanceName, rates string) string {
+	return getPlatformResource(instanceName) + fmt.Sprintf(`
+	resource "ibm_event_streams_quota" "es_quota" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		entity               = "default"
+		%s
+	}`, rates)
+}
@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var eventStreamsSchemaCompatibilities = []string{
+	"NONE",
+	"BACKWARD",
+	"BACKWARD_TRANSITIVE",
+	"FORWARD",
+	"FORWARD_TRANSITIVE",
+	"FULL",
+	"FULL_TRANSITIVE",
+}
+
+func resourceIBMEventStreamsSchema() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsSchemaCreate,
+		ReadContext:   resourceIBMEventStreamsSchemaRead,
+		UpdateContext: resourceIBMEventStreamsSchemaUpdate,
+		DeleteContext: resourceIBMEventStreamsSchemaDelete,
+		Importer:      &schema.ResourceImporter{},
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the Event Streams instance",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The subject of the schema in the schema registry",
+			},
+			"schema": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentJSONStructure,
+				Description:      "The Avro schema in JSON, a change registers a new version of the subject",
+			},
+			"compatibility": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue(eventStreamsSchemaCompatibilities),
+				Description:  "The compatibility rule the new versions of the subject are checked against",
+			},
+			"schema_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the schema in the schema registry",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The latest version of the subject",
+			},
+		},
+	}
+}
+
+func eventStreamsResourceIDParts(id, resourceType string) (string, string, error) {
+	segments := strings.Split(id, ":")
+	if len(segments) < 10 || segments[8] != resourceType || segments[9] == "" {
+		return "", "", fmt.Errorf("Incorrect ID %s: Id should be the CRN of the instance with the %s resource type", id, resourceType)
+	}
+	return getEventStreamsResourceInstanceCRN(id), getEventStreamsResourceName(id), nil
+}
+
+func resourceIBMEventStreamsSchemaCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("resource_instance_id").(string)
+	subject := d.Get("subject").(string)
+	esClient, err := eventStreamsAdminAPI(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, _, err := esClient.RegisterSchema(context, subject, d.Get("schema").(string)); err != nil {
+		return diag.Errorf("Error registering schema of subject %s: %s", subject, err)
+	}
+	d.SetId(getEventStreamsResourceID(instanceCRN, "schema", subject))
+
+	if compatibility, ok := d.GetOk("compatibility"); ok {
+		if _, err := esClient.SetSchemaCompatibility(context, subject, compatibility.(string)); err != nil {
+			return diag.Errorf("Error setting compatibility of subject %s: %s", subject, err)
+		}
+	}
+
+	return resourceIBMEventStreamsSchemaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsSchemaRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, subject, err := eventStreamsResourceIDParts(d.Id(), "schema")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	esClient, err := eventStreamsAdminAPI(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	latest, response, err := esClient.GetLatestSchema(context, subject)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading schema of subject %s: %s", subject, err)
+	}
+	d.Set("resource_instance_id", instanceCRN)
+	d.Set("subject", subject)
+	d.Set("schema", latest.Schema)
+	d.Set("schema_id", latest.ID)
+	d.Set("version", latest.Version)
+
+	// The subjects without their own compatibility rule use the global rule of the registry
+	compatibility, response, err := esClient.GetSchemaCompatibility(context, subject)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.Errorf("Error reading compatibility of subject %s: %s", subject, err)
+	}
+	if compatibility != "" {
+		d.Set("compatibility", compatibility)
+	}
+
+	return nil
+}
+
+func resourceIBMEventStreamsSchemaUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, subject, err := eventStreamsResourceIDParts(d.Id(), "schema")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	esClient, err := eventStreamsAdminAPI(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The new compatibility rule applies to the new version
+	if d.HasChange("compatibility") {
+		if _, err := esClient.SetSchemaCompatibility(context, subject, d.Get("compatibility").(string)); err != nil {
+			return diag.Errorf("Error setting compatibility of subject %s: %s", subject, err)
+		}
+	}
+	if d.HasChange("schema") {
+		if _, _, err := esClient.RegisterSchema(context, subject, d.Get("schema").(string)); err != nil {
+			return diag.Errorf("Error registering new version of subject %s: %s", subject, err)
+		}
+	}
+
+	return resourceIBMEventStreamsSchemaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsSchemaDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, subject, err := eventStreamsResourceIDParts(d.Id(), "schema")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	esClient, err := eventStreamsAdminAPI(meta, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := esClient.DeleteSchemaSubject(context, subject)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.Errorf("Error deleting subject %s: %s", subject, err)
+	}
+
+	d.SetId("")
+	return nil
+}
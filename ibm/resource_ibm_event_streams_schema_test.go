@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"gotest.tools/assert"
+)
+
+func TestAccIBMEventStreamsSchemaResourceWithExistingInstance(t *testing.T) {
+	subject := fmt.Sprintf("es_schema_%d-value", acctest.RandInt())
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsSchemaConfig(existingInstanceName, subject, `[{"name":"id","type":"string"}]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_schema.es_schema", "subject", subject),
+					resource.TestCheckResourceAttr("ibm_event_streams_schema.es_schema", "compatibility", "BACKWARD"),
+					resource.TestCheckResourceAttr("ibm_event_streams_schema.es_schema", "version", "1"),
+					resource.TestCheckResourceAttrSet("ibm_event_streams_schema.es_schema", "schema_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsSchemaConfig(existingInstanceName, subject, `[{"name":"id","type":"string"},{"name":"amount","type":"int","default":0}]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_schema.es_schema", "version", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_event_streams_schema.es_schema",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestEventStreamsSchemaJSON(t *testing.T) {
+	avroSchema := `{
+		"type": "record",
+		"name": "order",
+		"namespace": "com.example.orders",
+		"fields": [
+			{"name": "id", "type": "string"},
+			{"name": "amount", "type": "int", "default": 0},
+			{"name": "status", "type": {"type": "enum", "name": "status", "symbols": ["NEW", "SHIPPED"]}}
+		]
+	}`
+	// The schema as it is returned by the schema registry, without whitespace and with the keys in another order
+	registered := `{"name":"order","namespace":"com.example.orders","type":"record","fields":[{"name":"id","type":"string"},{"default":0,"name":"amount","type":"int"},{"name":"status","type":{"name":"status","symbols":["NEW","SHIPPED"],"type":"enum"}}]}`
+
+	schemaArg := resourceIBMEventStreamsSchema().Schema["schema"]
+	_, errs := schemaArg.ValidateFunc(avroSchema, "schema")
+	assert.Equal(t, 0, len(errs))
+	_, errs = schemaArg.ValidateFunc(`{"type": "record",`, "schema")
+	assert.Equal(t, 1, len(errs))
+
+	assert.Assert(t, schemaArg.DiffSuppressFunc("schema", registered, avroSchema, nil))
+	assert.Assert(t, !schemaArg.DiffSuppressFunc("schema", registered, strings.Replace(avroSchema, `"default": 0`, `"default": 1`, 1), nil))
+}
+
+func testAccCheckIBMEventStreamsSchemaConfig(instanceName, subject, fields string) string {
+	return getPlatformResource(instanceName) + fmt.Sprintf(`
+	resource "ibm_event_streams_schema" "es_schema" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		subject              = "%s"
+		compatibility        = "BACKWARD"
+		schema = jsonencode({
+			type   = "record"
+			name   = "order"
+			fields = jsondecode(%q)
+		})
+	}`, subject, fields)
+}
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/Shopify/sarama"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,6 +52,11 @@ func resourceIBMEventStreamsTopic() *schema.Resource {
 		Update:   resourceIBMEventStreamsTopicUpdate,
 		Delete:   resourceIBMEventStreamsTopicDelete,
 		Importer: &schema.ResourceImporter{},
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMEventStreamsTopicPartitionsCustomizeDiff(diff)
+			},
+		),
 		Schema: map[string]*schema.Schema{
 			"resource_instance_id": &schema.Schema{
 				Type:        schema.TypeString,
@@ -87,6 +94,18 @@ func resourceIBMEventStreamsTopic() *schema.Resource {
 	}
 }
 
+// resourceIBMEventStreamsTopicPartitionsCustomizeDiff fails the plan of a partitions decrease, Kafka only adds partitions
+func resourceIBMEventStreamsTopicPartitionsCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange("partitions") {
+		return nil
+	}
+	o, n := diff.GetChange("partitions")
+	if n.(int) < o.(int) {
+		return fmt.Errorf("The partitions of topic %s can't be decreased from %d to %d", diff.Get("name").(string), o.(int), n.(int))
+	}
+	return nil
+}
+
 // clientPool maintains Kafka admin client for each instance.
 // key is instance's CRN
 var clientPool = map[string]sarama.ClusterAdmin{}
@@ -187,14 +206,20 @@ func resourceIBMEventStreamsTopicUpdate(d *schema.ResourceData, meta interface{}
 		log.Printf("[INFO]resourceIBMEventStreamsTopicUpdate partitions is set to %d", newPartitions)
 	}
 	if d.HasChange("config") {
-		config := d.Get("config").(map[string]interface{})
-		configEntries := config2TopicDetail(config)
+		oc, nc := d.GetChange("config")
+		currentEntries, err := adminClient.DescribeConfig(sarama.ConfigResource{Type: sarama.TopicResource, Name: topicName})
+		if err != nil {
+			log.Printf("[DEBUG]resourceIBMEventStreamsTopicUpdate DescribeConfig err %s", err)
+			return err
+		}
+		// AlterConfig replaces the whole configuration of the topic, the configurations that are not managed
+		// in the resource are sent with their current values
+		configEntries := topicConfigUpdate(currentEntries, oc.(map[string]interface{}), nc.(map[string]interface{}))
 		err = adminClient.AlterConfig(sarama.TopicResource, topicName, configEntries, false)
 		if err != nil {
 			log.Printf("[DEBUG]resourceIBMEventStreamsTopicUpdate AlterConfig err %s", err)
 			return err
 		}
-		d.Set("config", topicDetail2Config(configEntries))
 		log.Printf("[INFO]resourceIBMEventStreamsTopicUpdate config is set to %v", topicDetail2Config(configEntries))
 	}
 	return resourceIBMEventStreamsTopicRead(d, meta)
@@ -298,6 +323,31 @@ func topicDetail2Config(topicConfigEntries map[string]*string) map[string]*strin
 	return configs
 }
 
+// topicConfigUpdate returns the configuration of the topic with the changes between the old and the new configuration
+// of the resource applied key by key to the configurations set on the topic
+func topicConfigUpdate(current []sarama.ConfigEntry, oldConfig, newConfig map[string]interface{}) map[string]*string {
+	configEntries := map[string]*string{}
+	for _, entry := range current {
+		if entry.Source == sarama.SourceTopic && !entry.ReadOnly && !entry.Sensitive {
+			value := entry.Value
+			configEntries[entry.Name] = &value
+		}
+	}
+	for key := range oldConfig {
+		if _, ok := newConfig[key]; !ok {
+			log.Printf("[INFO] topicConfigUpdate %s is reset to its default", key)
+			delete(configEntries, key)
+		}
+	}
+	for key, value := range config2TopicDetail(newConfig) {
+		if oldValue, ok := oldConfig[key]; !ok || oldValue != *value {
+			log.Printf("[INFO] topicConfigUpdate %s is set to %s", key, *value)
+		}
+		configEntries[key] = value
+	}
+	return configEntries
+}
+
 func config2TopicDetail(config map[string]interface{}) map[string]*string {
 	configEntries := make(map[string]*string)
 	for key, value := range config {
@@ -326,3 +376,19 @@ func getInstanceCRN(topicID string) string {
 	crnSegments[9] = ""
 	return strings.Join(crnSegments, ":")
 }
+
+// getEventStreamsResourceID returns the CRN of a resource of the instance like getTopicID, the name can contain colons
+func getEventStreamsResourceID(instanceCRN, resourceType, name string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = resourceType
+	crnSegments[9] = name
+	return strings.Join(crnSegments, ":")
+}
+
+func getEventStreamsResourceName(resourceID string) string {
+	return strings.Join(strings.Split(resourceID, ":")[9:], ":")
+}
+
+func getEventStreamsResourceInstanceCRN(resourceID string) string {
+	return strings.Join(strings.Split(resourceID, ":")[:8], ":") + "::"
+}
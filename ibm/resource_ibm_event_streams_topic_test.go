@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/Shopify/sarama"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -363,3 +364,33 @@ func TestGetTopicName(t *testing.T) {
 	gotTopicName := getTopicName(topicID)
 	assert.Equal(t, mytopicName, gotTopicName)
 }
+
+func TestGetEventStreamsResourceID(t *testing.T) {
+	schemaID := getEventStreamsResourceID(instanceCRN, "schema", "orders:value")
+	assert.Equal(t, "crn:v1:staging:public:messagehub:us-south:a/6db1b0d0b5c54ee5c201552547febcd8:c822a30e-bfff-4867-85ec-b805eeab1835:schema:orders:value", schemaID)
+	assert.Equal(t, "orders:value", getEventStreamsResourceName(schemaID))
+	assert.Equal(t, instanceCRN, getEventStreamsResourceInstanceCRN(schemaID))
+}
+
+func TestTopicConfigUpdate(t *testing.T) {
+	current := []sarama.ConfigEntry{
+		{Name: "retention.ms", Value: "3600000", Source: sarama.SourceTopic},
+		{Name: "segment.bytes", Value: "10485760", Source: sarama.SourceTopic},
+		{Name: "max.message.bytes", Value: "2097152", Source: sarama.SourceTopic},
+		{Name: "cleanup.policy", Value: "delete", Default: true, Source: sarama.SourceDefault},
+	}
+	oldConfig := map[string]interface{}{"retention.ms": "3600000", "segment.bytes": "10485760"}
+	newConfig := map[string]interface{}{"retention.ms": "7200000", "cleanup.policy": "compact"}
+
+	entries := topicConfigUpdate(current, oldConfig, newConfig)
+	got := map[string]string{}
+	for k, v := range entries {
+		got[k] = *v
+	}
+	// The configuration that is not managed by the resource is kept, the removed one is reset to its default
+	assert.DeepEqual(t, map[string]string{
+		"retention.ms":      "7200000",
+		"cleanup.policy":    "compact",
+		"max.message.bytes": "2097152",
+	}, got)
+}
@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"log"
+	"strings"
 	"time"
 
+	"github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -23,6 +26,16 @@ func resourceIBMIAMAccessGroupMembers() *schema.Resource {
 		DeleteContext: resourceIBMIAMAccessGroupMembersDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				// Show the members that were added outside of the resource as removed in the plan
+				if diff.Get("exclusive").(bool) && diff.Get("unmanaged_members").(*schema.Set).Len() > 0 {
+					return diff.SetNew("unmanaged_members", []string{})
+				}
+				return nil
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"access_group_id": {
 				Type:        schema.TypeString,
@@ -42,6 +55,19 @@ func resourceIBMIAMAccessGroupMembers() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Remove the members of the access group that are not listed in ibm_ids or iam_service_ids",
+			},
+
+			"unmanaged_members": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IAM IDs of the members of the access group that are not listed in ibm_ids or iam_service_ids",
+			},
+
 			"members": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -108,6 +134,17 @@ func resourceIBMIAMAccessGroupMembersCreate(context context.Context, d *schema.R
 
 	d.SetId(fmt.Sprintf("%s/%s", grpID, time.Now().UTC().String()))
 
+	if d.Get("exclusive").(bool) {
+		current, err := listAccessGroupMembers(iamAccessGroupsClient, grpID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		unlisted := unlistedAccessGroupMembers(current, append(userids, serviceids...))
+		if err := removeAccessGroupMembers(iamAccessGroupsClient, grpID, unlisted); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMIAMAccessGroupMembersRead(context, d, meta)
 }
 
@@ -123,10 +160,9 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 	}
 
 	grpID := parts[0]
-	listAccessGroupMembersOptions := iamAccessGroupsClient.NewListAccessGroupMembersOptions(grpID)
-	members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+	members, err := listAccessGroupMembers(iamAccessGroupsClient, grpID)
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse))
+		return diag.FromErr(err)
 	}
 
 	d.Set("access_group_id", grpID)
@@ -176,14 +212,34 @@ func resourceIBMIAMAccessGroupMembersRead(context context.Context, d *schema.Res
 		}
 	}
 
-	d.Set("members", flattenAccessGroupMembers(members.Members, res, allrecs))
-	ibmID, serviceID := flattenMembersData(members, res, allrecs)
-	if len(ibmID) > 0 {
-		d.Set("ibm_ids", ibmID)
-	}
-	if len(serviceID) > 0 {
-		d.Set("iam_service_ids", serviceID)
-	}
+	d.Set("members", flattenAccessGroupMembers(members, res, allrecs))
+
+	// Without exclusive, every member of the access group is read into ibm_ids and iam_service_ids as before, so
+	// that the members added outside of the resource show as drift
+	if _, ok := d.GetOkExists("exclusive"); !ok {
+		ibmID, serviceID := flattenMembersData(&iamaccessgroupsv2.GroupMembersList{Members: members}, res, allrecs)
+		if len(ibmID) > 0 {
+			d.Set("ibm_ids", ibmID)
+		}
+		if len(serviceID) > 0 {
+			d.Set("iam_service_ids", serviceID)
+		}
+		d.Set("unmanaged_members", []string{})
+		return nil
+	}
+
+	ibmIDs := d.Get("ibm_ids").(*schema.Set)
+	serviceIDs := d.Get("iam_service_ids").(*schema.Set)
+	if ibmIDs.Len() == 0 && serviceIDs.Len() == 0 {
+		// An imported resource manages all of the members that are users or service IDs
+		ibmID, serviceID := flattenMembersData(&iamaccessgroupsv2.GroupMembersList{Members: members}, res, allrecs)
+		ibmIDs = newStringSet(schema.HashString, ibmID)
+		serviceIDs = newStringSet(schema.HashString, serviceID)
+	}
+	ibmID, serviceID, unmanaged := splitAccessGroupMembers(members, res, allrecs, expandStringList(ibmIDs.List()), expandStringList(serviceIDs.List()))
+	d.Set("ibm_ids", ibmID)
+	d.Set("iam_service_ids", serviceID)
+	d.Set("unmanaged_members", unmanaged)
 	return nil
 }
 
@@ -278,6 +334,14 @@ func resourceIBMIAMAccessGroupMembersUpdate(context context.Context, d *schema.R
 		}
 	}
 
+	// The members to remove are the unmanaged members of the state, which the plan showed as removed
+	if d.Get("exclusive").(bool) {
+		o, _ := d.GetChange("unmanaged_members")
+		if err := removeAccessGroupMembers(iamAccessGroupsClient, grpID, expandStringList(o.(*schema.Set).List())); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMIAMAccessGroupMembersRead(context, d, meta)
 
 }
@@ -380,3 +444,97 @@ func getServiceID(id string, meta interface{}) (iamidentityv1.ServiceID, error)
 	}
 	return *serviceID, nil
 }
+
+// listAccessGroupMembers lists all of the members of the access group, page by page
+func listAccessGroupMembers(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, grpID string) ([]iamaccessgroupsv2.ListGroupMembersResponseMember, error) {
+	var limit int64 = 100
+	var offset int64
+	allrecs := []iamaccessgroupsv2.ListGroupMembersResponseMember{}
+	for {
+		listAccessGroupMembersOptions := iamAccessGroupsClient.NewListAccessGroupMembersOptions(grpID)
+		listAccessGroupMembersOptions.SetLimit(limit)
+		listAccessGroupMembersOptions.SetOffset(offset)
+		members, detailedResponse, err := iamAccessGroupsClient.ListAccessGroupMembers(listAccessGroupMembersOptions)
+		if err != nil || members == nil {
+			return nil, fmt.Errorf("[ERROR] Error retrieving access group members: %s. API Response: %s", err, detailedResponse)
+		}
+		allrecs = append(allrecs, members.Members...)
+		offset += int64(len(members.Members))
+		if len(members.Members) == 0 || members.TotalCount == nil || offset >= *members.TotalCount {
+			break
+		}
+	}
+	return allrecs, nil
+}
+
+// splitAccessGroupMembers splits the members of the access group into the users and service IDs that are
+// managed by the resource, and the IAM IDs of all of the other members
+func splitAccessGroupMembers(members []iamaccessgroupsv2.ListGroupMembersResponseMember, users []usermanagementv2.UserInfo, serviceids []iamidentityv1.ServiceID, ibmIDs, iamServiceIDs []string) (managedUsers, managedServiceIDs, unmanaged []string) {
+	emails := make(map[string]string, len(ibmIDs))
+	for _, email := range ibmIDs {
+		emails[strings.ToLower(email)] = email
+	}
+	ids := make(map[string]bool, len(iamServiceIDs))
+	for _, id := range iamServiceIDs {
+		ids[id] = true
+	}
+
+	managedUsers, managedServiceIDs, unmanaged = []string{}, []string{}, []string{}
+	for _, m := range members {
+		managed := false
+		if *m.Type == "user" {
+			for _, user := range users {
+				if user.IamID == *m.IamID {
+					if email, ok := emails[strings.ToLower(user.Email)]; ok {
+						managedUsers = append(managedUsers, email)
+						managed = true
+					}
+					break
+				}
+			}
+		} else {
+			for _, srid := range serviceids {
+				if *srid.IamID == *m.IamID {
+					if ids[*srid.ID] {
+						managedServiceIDs = append(managedServiceIDs, *srid.ID)
+						managed = true
+					}
+					break
+				}
+			}
+		}
+		if !managed {
+			unmanaged = append(unmanaged, *m.IamID)
+		}
+	}
+	return
+}
+
+// unlistedAccessGroupMembers returns the IAM IDs of the members of the access group that aren't in iamIDs
+func unlistedAccessGroupMembers(members []iamaccessgroupsv2.ListGroupMembersResponseMember, iamIDs []string) []string {
+	listed := make(map[string]bool, len(iamIDs))
+	for _, id := range iamIDs {
+		listed[id] = true
+	}
+	unlisted := []string{}
+	for _, m := range members {
+		if m.IamID != nil && !listed[*m.IamID] {
+			unlisted = append(unlisted, *m.IamID)
+		}
+	}
+	return unlisted
+}
+
+func removeAccessGroupMembers(iamAccessGroupsClient *iamaccessgroupsv2.IamAccessGroupsV2, grpID string, iamIDs []string) error {
+	for _, iamID := range iamIDs {
+		removeMembersFromAccessGroupOptions := iamAccessGroupsClient.NewRemoveMemberFromAccessGroupOptions(grpID, iamID)
+		detailResponse, err := iamAccessGroupsClient.RemoveMemberFromAccessGroup(removeMembersFromAccessGroupOptions)
+		if err != nil {
+			if detailResponse != nil && detailResponse.StatusCode == 404 {
+				continue
+			}
+			return fmt.Errorf("Error removing member %s from group(%s): %s. API Response: %s", iamID, grpID, err, detailResponse)
+		}
+	}
+	return nil
+}
@@ -5,9 +5,13 @@ package ibm
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/IBM-Cloud/bluemix-go/api/usermanagement/usermanagementv2"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -56,6 +60,112 @@ func TestAccIBMIAMAccessGroupMember_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMAccessGroupMember_Exclusive(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	sname1 := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupMemberExclusive(name, sname, sname1, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "iam_service_ids.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "unmanaged_members.#", "0"),
+					// Add a member outside of the resource
+					testAccIBMIAMAccessGroupMemberAddMember("ibm_iam_access_group.accgroup", "ibm_iam_service_id.serviceID2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupMemberExclusive(name, sname, sname1, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "iam_service_ids.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "unmanaged_members.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "members.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupMemberExclusive(name, sname, sname1, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "iam_service_ids.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "unmanaged_members.#", "0"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_members.accgroupmem", "members.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMIAMAccessGroupMemberAddMember(group, serviceID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		grp, ok := s.RootModule().Resources[group]
+		if !ok {
+			return fmt.Errorf("Not found: %s", group)
+		}
+		sid, ok := s.RootModule().Resources[serviceID]
+		if !ok {
+			return fmt.Errorf("Not found: %s", serviceID)
+		}
+		accClient, err := testAccProvider.Meta().(ClientSession).IAMAccessGroupsV2()
+		if err != nil {
+			return err
+		}
+		addMembersToAccessGroupOptions := accClient.NewAddMembersToAccessGroupOptions(grp.Primary.ID)
+		addMembersToAccessGroupOptions.SetMembers(prepareMemberAddRequest(accClient, nil, []string{sid.Primary.Attributes["iam_id"]}))
+		_, detailResponse, err := accClient.AddMembersToAccessGroup(addMembersToAccessGroupOptions)
+		if err != nil {
+			return fmt.Errorf("Error adding member to access group: %s\n%s", err, detailResponse)
+		}
+		return nil
+	}
+}
+
+func TestSplitAccessGroupMembers(t *testing.T) {
+	members := []iamaccessgroupsv2.ListGroupMembersResponseMember{
+		{IamID: core.StringPtr("IBMid-1"), Type: core.StringPtr("user")},
+		{IamID: core.StringPtr("IBMid-2"), Type: core.StringPtr("user")},
+		{IamID: core.StringPtr("iam-ServiceId-1"), Type: core.StringPtr("service")},
+		{IamID: core.StringPtr("iam-ServiceId-2"), Type: core.StringPtr("service")},
+		{IamID: core.StringPtr("iam-Profile-1"), Type: core.StringPtr("profile")},
+	}
+	users := []usermanagementv2.UserInfo{
+		{IamID: "IBMid-1", Email: "User1@example.com"},
+		{IamID: "IBMid-2", Email: "user2@example.com"},
+	}
+	serviceIDs := []iamidentityv1.ServiceID{
+		{ID: core.StringPtr("ServiceId-1"), IamID: core.StringPtr("iam-ServiceId-1")},
+		{ID: core.StringPtr("ServiceId-2"), IamID: core.StringPtr("iam-ServiceId-2")},
+	}
+
+	managedUsers, managedServiceIDs, unmanaged := splitAccessGroupMembers(members, users, serviceIDs, []string{"user1@example.com"}, []string{"ServiceId-2"})
+	if !reflect.DeepEqual(managedUsers, []string{"user1@example.com"}) {
+		t.Errorf("unexpected managed users %v", managedUsers)
+	}
+	if !reflect.DeepEqual(managedServiceIDs, []string{"ServiceId-2"}) {
+		t.Errorf("unexpected managed service IDs %v", managedServiceIDs)
+	}
+	if !reflect.DeepEqual(unmanaged, []string{"IBMid-2", "iam-ServiceId-1", "iam-Profile-1"}) {
+		t.Errorf("unexpected unmanaged members %v", unmanaged)
+	}
+}
+
+func TestUnlistedAccessGroupMembers(t *testing.T) {
+	members := []iamaccessgroupsv2.ListGroupMembersResponseMember{
+		{IamID: core.StringPtr("IBMid-1"), Type: core.StringPtr("user")},
+		{IamID: core.StringPtr("IBMid-2"), Type: core.StringPtr("user")},
+		{IamID: core.StringPtr("iam-ServiceId-1"), Type: core.StringPtr("service")},
+		{IamID: core.StringPtr("iam-Profile-1"), Type: core.StringPtr("profile")},
+	}
+
+	unlisted := unlistedAccessGroupMembers(members, []string{"IBMid-1", "iam-ServiceId-1"})
+	if !reflect.DeepEqual(unlisted, []string{"IBMid-2", "iam-Profile-1"}) {
+		t.Errorf("unexpected unlisted members %v", unlisted)
+	}
+}
+
 func TestAccIBMIAMAccessGroupMember_import(t *testing.T) {
 	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
 	sname := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
@@ -207,3 +317,25 @@ func testAccCheckIBMIAMAccessGroupMemberImport(name, sname string) string {
 		iam_service_ids = [ibm_iam_service_id.serviceID.id]
 	}`, name, sname, IAMUser)
 }
+
+func testAccCheckIBMIAMAccessGroupMemberExclusive(name, sname, sname1 string, exclusive bool) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_iam_access_group" "accgroup" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_service_id" "serviceID2" {
+		name = "%s"
+	}
+
+	resource "ibm_iam_access_group_members" "accgroupmem" {
+		access_group_id = ibm_iam_access_group.accgroup.id
+		iam_service_ids = [ibm_iam_service_id.serviceID.id]
+		exclusive       = %t
+	}`, name, sname, sname1, exclusive)
+}
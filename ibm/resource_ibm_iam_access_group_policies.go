@@ -0,0 +1,384 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
+)
+
+func resourceIBMIAMAccessGroupPolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMAccessGroupPoliciesCreate,
+		ReadContext:   resourceIBMIAMAccessGroupPoliciesRead,
+		UpdateContext: resourceIBMIAMAccessGroupPoliciesUpdate,
+		DeleteContext: resourceIBMIAMAccessGroupPoliciesDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of access group",
+			},
+
+			"policy": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Set:         resourceIBMIAMAccessGroupPoliciesHash,
+				Description: "All of the access policies of the access group, policies that are not listed are removed from the access group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the policy",
+						},
+						"roles": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "Role names of the policy definition",
+						},
+						"resource_attributes": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: "Resource attributes of the policy, for example serviceName or serviceType",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of attribute.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Value of attribute.",
+									},
+									"operator": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Default:     "stringEquals",
+										Description: "Operator of attribute.",
+									},
+								},
+							},
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Description of the policy",
+						},
+						"rule_conditions": iamPolicyRuleConditionsSchema(),
+						"rule_operator": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "and",
+							ValidateFunc: validateAllowedStringValue([]string{"and", "or"}),
+							Description:  "Operator that combines the rule conditions",
+						},
+						"pattern": iamPolicyPatternSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceIBMIAMAccessGroupPoliciesHash hashes the content of a policy, so that a policy that was read from
+// the access group matches the configured policy that grants the same access
+func resourceIBMIAMAccessGroupPoliciesHash(v interface{}) int {
+	var buf bytes.Buffer
+	p := v.(map[string]interface{})
+
+	roles := expandStringList(p["roles"].(*schema.Set).List())
+	sort.Strings(roles)
+	buf.WriteString(fmt.Sprintf("%s-", strings.Join(roles, ",")))
+
+	attributes := []string{}
+	for _, a := range p["resource_attributes"].(*schema.Set).List() {
+		attribute := a.(map[string]interface{})
+		attributes = append(attributes, fmt.Sprintf("%s:%s:%s", attribute["name"], attribute["operator"], attribute["value"]))
+	}
+	sort.Strings(attributes)
+	buf.WriteString(fmt.Sprintf("%s-", strings.Join(attributes, ",")))
+	buf.WriteString(fmt.Sprintf("%s-", p["description"]))
+
+	conditions := []string{}
+	if set, ok := p["rule_conditions"].(*schema.Set); ok {
+		for _, c := range set.List() {
+			condition := c.(map[string]interface{})
+			values := expandStringList(condition["value"].(*schema.Set).List())
+			sort.Strings(values)
+			conditions = append(conditions, fmt.Sprintf("%s:%s:%s", condition["key"], condition["operator"], strings.Join(values, "|")))
+		}
+	}
+	sort.Strings(conditions)
+	buf.WriteString(fmt.Sprintf("%s-", strings.Join(conditions, ",")))
+	// The operator only matters when it combines more than one condition
+	if len(conditions) > 1 {
+		buf.WriteString(fmt.Sprintf("%s-", p["rule_operator"]))
+	}
+	buf.WriteString(fmt.Sprintf("%s-", p["pattern"]))
+
+	return hashcode.String(buf.String())
+}
+
+func expandIAMAccessGroupPoliciesPolicy(meta interface{}, accountID, accessGroupID string, p map[string]interface{}) (*iamPolicyV2, error) {
+	resource, control, err := expandIAMPolicyV2Grant(meta, expandStringList(p["roles"].(*schema.Set).List()), p["resource_attributes"].(*schema.Set))
+	if err != nil {
+		return nil, err
+	}
+	resource.Attributes = append(resource.Attributes, iamPolicyV2Attribute{
+		Key:      core.StringPtr("accountId"),
+		Operator: core.StringPtr("stringEquals"),
+		Value:    accountID,
+	})
+
+	policy := &iamPolicyV2{
+		Type: core.StringPtr("access"),
+		Subject: &iamPolicyV2Subject{
+			Attributes: []iamPolicyV2Attribute{
+				{
+					Key:      core.StringPtr("access_group_id"),
+					Operator: core.StringPtr("stringEquals"),
+					Value:    accessGroupID,
+				},
+			},
+		},
+		Resource: resource,
+		Control:  control,
+		Rule:     expandIAMPolicyRule(p["rule_conditions"].(*schema.Set), p["rule_operator"].(string)),
+	}
+	if v := p["description"].(string); v != "" {
+		policy.Description = core.StringPtr(v)
+	}
+	if v := p["pattern"].(string); v != "" {
+		policy.Pattern = core.StringPtr(v)
+	}
+	return policy, nil
+}
+
+func flattenIAMAccessGroupPoliciesPolicy(meta interface{}, policy iamPolicyV2) (map[string]interface{}, error) {
+	converted, err := iamPolicyV2ToV1(meta, &policy)
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]string, len(converted.Roles))
+	for i, role := range converted.Roles {
+		roles[i] = *role.DisplayName
+	}
+	resourceAttributes := []map[string]interface{}{}
+	for _, a := range converted.Resources[0].Attributes {
+		if *a.Name == "accountId" {
+			continue
+		}
+		resourceAttributes = append(resourceAttributes, map[string]interface{}{
+			"name":     a.Name,
+			"value":    a.Value,
+			"operator": a.Operator,
+		})
+	}
+	ruleOperator := "and"
+	if policy.Rule != nil && len(policy.Rule.Conditions) > 0 {
+		ruleOperator = *policy.Rule.Operator
+	}
+	return map[string]interface{}{
+		"id":                  policy.ID,
+		"roles":               roles,
+		"resource_attributes": resourceAttributes,
+		"description":         policy.Description,
+		"rule_conditions":     flattenIAMPolicyRuleConditions(policy.Rule),
+		"rule_operator":       ruleOperator,
+		"pattern":             policy.Pattern,
+	}, nil
+}
+
+func listIAMAccessGroupPolicies(meta interface{}, accessGroupID string) ([]iamPolicyV2, *core.DetailedResponse, error) {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, nil, err
+	}
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, nil, err
+	}
+	query := url.Values{}
+	query.Set("account_id", userDetails.userAccount)
+	query.Set("access_group_id", accessGroupID)
+	query.Set("type", "access")
+	return listIAMPoliciesV2(iamPolicyManagementClient, query)
+}
+
+// waitForIAMAccessGroupPolicies waits until the list of the policies of the access group includes the
+// created policies, the list is eventually consistent
+func waitForIAMAccessGroupPolicies(ctx context.Context, meta interface{}, accessGroupID string, policyIDs []string, timeout time.Duration) error {
+	if len(policyIDs) == 0 {
+		return nil
+	}
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		policies, resp, err := listIAMAccessGroupPolicies(meta, accessGroupID)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("[ERROR] Error listing access group policies: %s\n%s", err, resp))
+		}
+		listed := make(map[string]bool, len(policies))
+		for _, p := range policies {
+			listed[*p.ID] = true
+		}
+		for _, id := range policyIDs {
+			if !listed[id] {
+				return resource.RetryableError(fmt.Errorf("policy %s of access group %s is not listed yet", id, accessGroupID))
+			}
+		}
+		return nil
+	})
+}
+
+func createIAMAccessGroupPolicies(meta interface{}, accessGroupID string, policies []interface{}) ([]string, error) {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(policies))
+	for _, p := range policies {
+		policy, err := expandIAMAccessGroupPoliciesPolicy(meta, userDetails.userAccount, accessGroupID, p.(map[string]interface{}))
+		if err != nil {
+			return ids, err
+		}
+		result := &iamPolicyV2{}
+		resp, err := iamPolicyV2Request(iamPolicyManagementClient, core.POST, iamPoliciesV2Path, "", policy, result)
+		if err != nil {
+			return ids, fmt.Errorf("[ERROR] Error creating access group policy: %s\n%s", err, resp)
+		}
+		ids = append(ids, *result.ID)
+	}
+	return ids, nil
+}
+
+func deleteIAMAccessGroupPolicies(meta interface{}, policies []interface{}) error {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	for _, p := range policies {
+		id := p.(map[string]interface{})["id"].(string)
+		if id == "" {
+			continue
+		}
+		resp, err := iamPolicyV2Request(iamPolicyManagementClient, core.DELETE, iamPolicyV2Path(id), "", nil, nil)
+		if err != nil {
+			if resp != nil && resp.StatusCode == 404 {
+				continue
+			}
+			return fmt.Errorf("[ERROR] Error deleting access group policy %s: %s\n%s", id, err, resp)
+		}
+	}
+	return nil
+}
+
+func resourceIBMIAMAccessGroupPoliciesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	accessGroupID := d.Get("access_group_id").(string)
+
+	// Policies that the access group already has are not removed on create, they are read into the state
+	// so that the next plan shows them as removed
+	ids, err := createIAMAccessGroupPolicies(meta, accessGroupID, d.Get("policy").(*schema.Set).List())
+	d.SetId(accessGroupID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := waitForIAMAccessGroupPolicies(context, meta, accessGroupID, ids, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMIAMAccessGroupPoliciesRead(context, d, meta)
+}
+
+func resourceIBMIAMAccessGroupPoliciesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	accessGroupID := d.Id()
+
+	policies, resp, err := listIAMAccessGroupPolicies(meta, accessGroupID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error listing access group policies: %s\n%s", err, resp))
+	}
+
+	result := make([]map[string]interface{}, 0, len(policies))
+	for _, p := range policies {
+		policy, err := flattenIAMAccessGroupPoliciesPolicy(meta, p)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		result = append(result, policy)
+	}
+
+	d.Set("access_group_id", accessGroupID)
+	if err := d.Set("policy", result); err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error setting policy: %s", err))
+	}
+	return nil
+}
+
+func resourceIBMIAMAccessGroupPoliciesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("policy") {
+		accessGroupID := d.Id()
+
+		o, n := d.GetChange("policy")
+		os := o.(*schema.Set)
+		ns := n.(*schema.Set)
+
+		// The policies that are no longer configured include the policies that were added outside of
+		// the resource, they were read into the state and the plan showed them as removed
+		ids, err := createIAMAccessGroupPolicies(meta, accessGroupID, ns.Difference(os).List())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := deleteIAMAccessGroupPolicies(meta, os.Difference(ns).List()); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := waitForIAMAccessGroupPolicies(context, meta, accessGroupID, ids, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIAMAccessGroupPoliciesRead(context, d, meta)
+}
+
+func resourceIBMIAMAccessGroupPoliciesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteIAMAccessGroupPolicies(meta, d.Get("policy").(*schema.Set).List()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceIBMIAMAccessGroupPoliciesHash(t *testing.T) {
+	hash := func(policy map[string]interface{}) int {
+		d := schema.TestResourceDataRaw(t, resourceIBMIAMAccessGroupPolicies().Schema, map[string]interface{}{
+			"access_group_id": "AccessGroupId-1",
+			"policy":          []interface{}{policy},
+		})
+		return resourceIBMIAMAccessGroupPoliciesHash(d.Get("policy").(*schema.Set).List()[0])
+	}
+	condition := func(value string) map[string]interface{} {
+		return map[string]interface{}{
+			"key":      "{{environment.attributes.day_of_week}}",
+			"operator": "dayOfWeekAnyOf",
+			"value":    []interface{}{value},
+		}
+	}
+
+	viewer := hash(map[string]interface{}{
+		"roles":               []interface{}{"Viewer", "Editor"},
+		"resource_attributes": []interface{}{map[string]interface{}{"name": "serviceName", "value": "kms"}},
+		"rule_conditions":     []interface{}{condition("1+00:00")},
+	})
+	reordered := hash(map[string]interface{}{
+		"roles":               []interface{}{"Editor", "Viewer"},
+		"resource_attributes": []interface{}{map[string]interface{}{"name": "serviceName", "value": "kms", "operator": "stringEquals"}},
+		"rule_conditions":     []interface{}{condition("1+00:00")},
+		"rule_operator":       "or",
+	})
+	if viewer != reordered {
+		t.Errorf("expected the same hash for the same policy, got %d and %d", viewer, reordered)
+	}
+
+	other := hash(map[string]interface{}{
+		"roles":               []interface{}{"Viewer", "Editor"},
+		"resource_attributes": []interface{}{map[string]interface{}{"name": "serviceName", "value": "kms"}},
+		"rule_conditions":     []interface{}{condition("2+00:00")},
+	})
+	if viewer == other {
+		t.Errorf("expected a different hash for a policy with different rule conditions")
+	}
+
+	and := hash(map[string]interface{}{
+		"roles":               []interface{}{"Viewer"},
+		"resource_attributes": []interface{}{map[string]interface{}{"name": "serviceName", "value": "kms"}},
+		"rule_conditions":     []interface{}{condition("1+00:00"), condition("2+00:00")},
+	})
+	or := hash(map[string]interface{}{
+		"roles":               []interface{}{"Viewer"},
+		"resource_attributes": []interface{}{map[string]interface{}{"name": "serviceName", "value": "kms"}},
+		"rule_conditions":     []interface{}{condition("1+00:00"), condition("2+00:00")},
+		"rule_operator":       "or",
+	})
+	if and == or {
+		t.Errorf("expected a different hash for rule conditions that are combined with a different operator")
+	}
+}
+
+func TestAccIBMIAMAccessGroupPolicies_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupPoliciesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesBasic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group.accgrp", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policy.#", "2"),
+					testAccCheckIBMIAMAccessGroupPoliciesCount("ibm_iam_access_group_policies.policies", 2),
+					// Grant access outside of the resource, the next step removes it
+					testAccIBMIAMAccessGroupPoliciesAddPolicy("ibm_iam_access_group_policies.policies"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesBasic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policy.#", "2"),
+					testAccCheckIBMIAMAccessGroupPoliciesCount("ibm_iam_access_group_policies.policies", 2),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupPoliciesUpdate(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policies.policies", "policy.#", "1"),
+					testAccCheckIBMIAMAccessGroupPoliciesCount("ibm_iam_access_group_policies.policies", 1),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_access_group_policies.policies",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIBMIAMAccessGroupPoliciesAddPolicy(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		ids, err := createIAMAccessGroupPolicies(testAccProvider.Meta(), rs.Primary.ID, []interface{}{
+			map[string]interface{}{
+				"roles": newStringSet(schema.HashString, []string{"Viewer"}),
+				"resource_attributes": schema.NewSet(schema.HashResource(resourceIBMIAMAccessGroupPolicies().Schema["policy"].Elem.(*schema.Resource).Schema["resource_attributes"].Elem.(*schema.Resource)), []interface{}{
+					map[string]interface{}{"name": "serviceName", "value": "cloud-object-storage", "operator": "stringEquals"},
+				}),
+				"rule_conditions": schema.NewSet(schema.HashString, []interface{}{}),
+				"rule_operator":   "and",
+				"description":     "Added outside of Terraform",
+				"pattern":         "",
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if len(ids) != 1 {
+			return fmt.Errorf("Expected one policy to be created, got %d", len(ids))
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		policies, resp, err := listIAMAccessGroupPolicies(testAccProvider.Meta(), rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error listing access group policies: %s\n%s", err, resp)
+		}
+		if len(policies) != count {
+			return fmt.Errorf("Expected %d policies of access group %s, got %d", count, rs.Primary.ID, len(policies))
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesDestroy(s *terraform.State) error {
+	iamPolicyManagementClient, err := testAccProvider.Meta().(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_access_group_policies" {
+			continue
+		}
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "policy.") || !strings.HasSuffix(k, ".id") {
+				continue
+			}
+			policy, response, err := getIAMPolicyV2(iamPolicyManagementClient, v)
+			if err == nil && (policy.State == nil || *policy.State != "deleted") {
+				return fmt.Errorf("Access group policy still exists: %s\n", v)
+			} else if err != nil && (response == nil || response.StatusCode != 404) {
+				return fmt.Errorf("Error waiting for access group policy (%s) to be destroyed: %s", v, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesBasic(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_access_group_policies" "policies" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+
+			policy {
+				roles = ["Viewer"]
+				resource_attributes {
+					name  = "serviceType"
+					value = "service"
+				}
+			}
+
+			policy {
+				roles = ["Writer", "Reader"]
+				resource_attributes {
+					name  = "serviceName"
+					value = "kms"
+				}
+				rule_conditions {
+					key      = "{{environment.attributes.day_of_week}}"
+					operator = "dayOfWeekAnyOf"
+					value    = ["1+00:00", "2+00:00", "3+00:00", "4+00:00", "5+00:00"]
+				}
+				pattern = "time-based-conditions:weekly:all-day"
+			}
+		}
+	`, name)
+}
+
+func testAccCheckIBMIAMAccessGroupPoliciesUpdate(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_access_group_policies" "policies" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+
+			policy {
+				roles       = ["Viewer", "Operator"]
+				description = "Viewer and operator of all IAM services"
+				resource_attributes {
+					name  = "serviceType"
+					value = "service"
+				}
+			}
+		}
+	`, name)
+}
@@ -22,6 +22,10 @@ func resourceIBMIAMAccessGroupPolicy() *schema.Resource {
 		Exists: resourceIBMIAMAccessGroupPolicyExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts, err := idParts(d.Id())
+				if err != nil || len(parts) < 2 {
+					return nil, fmt.Errorf("Incorrect ID %s: Id should be a combination of accessGroupID/PolicyID", d.Id())
+				}
 				resources, resourceAttributes, err := importAccessGroupPolicy(d, meta)
 				if err != nil {
 					return nil, fmt.Errorf("Error reading resource ID: %s", err)
@@ -141,6 +145,12 @@ func resourceIBMIAMAccessGroupPolicy() *schema.Resource {
 				Set:      schema.HashString,
 			},
 
+			"rule_conditions": iamPolicyRuleConditionsSchema(),
+
+			"rule_operator": iamPolicyRuleOperatorSchema(),
+
+			"pattern": iamPolicyPatternSchema(),
+
 			"version": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -194,18 +204,14 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 		[]iampolicymanagementv1.PolicyResource{*policyResource},
 	)
 
-	accessGroupPolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	accessGroupPolicy, res, err := createIAMPolicy(d, iamPolicyManagementClient, createPolicyOptions)
 	if err != nil || accessGroupPolicy == nil {
 		return fmt.Errorf("Error creating access group policy: %s\n%s", err, res)
 	}
 
-	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
-		PolicyID: accessGroupPolicy.ID,
-	}
-
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, res, err := getIAMPolicy(d, meta, iamPolicyManagementClient, *accessGroupPolicy.ID)
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -216,7 +222,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 	})
 
 	if isResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, res, err = getIAMPolicy(d, meta, iamPolicyManagementClient, *accessGroupPolicy.ID)
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", accessGroupId, *accessGroupPolicy.ID))
@@ -241,14 +247,12 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	accessGroupId := parts[0]
 	accessGroupPolicyId := parts[1]
 
-	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
-		PolicyID: &accessGroupPolicyId,
-	}
 	accessGroupPolicy := &iampolicymanagementv1.Policy{}
+	var accessGroupPolicyV2 *iamPolicyV2
 	res := &core.DetailedResponse{}
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		accessGroupPolicy, accessGroupPolicyV2, res, err = getIAMPolicy(d, meta, iamPolicyManagementClient, accessGroupPolicyId)
 		if err != nil || accessGroupPolicy == nil {
 			if res != nil && res.StatusCode == 404 {
 				return resource.RetryableError(err)
@@ -259,7 +263,7 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	})
 
 	if isResourceTimeoutError(err) {
-		accessGroupPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		accessGroupPolicy, accessGroupPolicyV2, res, err = getIAMPolicy(d, meta, iamPolicyManagementClient, accessGroupPolicyId)
 	}
 	if err != nil || accessGroupPolicy == nil {
 		return fmt.Errorf("Error retrieving access group policy: %s\n%s", err, res)
@@ -275,7 +279,7 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 	for i, role := range accessGroupPolicy.Roles {
 		roles[i] = *role.DisplayName
 	}
-	d.Set("roles", roles)
+	d.Set("roles", orderIAMPolicyRoles(d.Get("roles").([]interface{}), roles))
 	d.Set("version", res.Headers.Get("ETag"))
 
 	if _, ok := d.GetOk("resources"); ok {
@@ -292,6 +296,7 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 			d.Set("account_management", true)
 		}
 	}
+	setIAMPolicyRule(d, accessGroupPolicyV2)
 
 	return nil
 }
@@ -302,7 +307,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 	if err != nil {
 		return err
 	}
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 		parts, err := idParts(d.Id())
 		if err != nil {
 			return err
@@ -348,7 +353,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 			[]iampolicymanagementv1.PolicyResource{*policyResource},
 		)
 
-		_, res, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		res, err := updateIAMPolicy(d, iamPolicyManagementClient, updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("Error updating access group policy: %s\n%s", err, res)
 		}
@@ -370,11 +375,7 @@ func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interfac
 
 	accessGroupPolicyId := parts[1]
 
-	deletePolicyOptions := iamPolicyManagementClient.NewDeletePolicyOptions(
-		accessGroupPolicyId,
-	)
-
-	res, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	res, err := deleteIAMPolicy(d, iamPolicyManagementClient, accessGroupPolicyId)
 	if err != nil {
 		return fmt.Errorf("Error deleting access group policy: %s\n%s", err, res)
 	}
@@ -399,11 +400,7 @@ func resourceIBMIAMAccessGroupPolicyExists(d *schema.ResourceData, meta interfac
 
 	accessGroupPolicyId := parts[1]
 
-	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
-		accessGroupPolicyId,
-	)
-
-	accessGroupPolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	accessGroupPolicy, _, resp, err := getIAMPolicy(d, meta, iamPolicyManagementClient, accessGroupPolicyId)
 	if err != nil || accessGroupPolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -432,11 +429,7 @@ func importAccessGroupPolicy(d *schema.ResourceData, meta interface{}) (interfac
 	}
 	accgrpPolicyID := parts[1]
 
-	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
-		accgrpPolicyID,
-	)
-
-	accessGroupPolicy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	accessGroupPolicy, _, res, err := getIAMPolicy(d, meta, iamPolicyManagementClient, accgrpPolicyID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("Error retrieving access group policy: %s\n%s", err, res)
 	}
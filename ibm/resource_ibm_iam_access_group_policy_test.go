@@ -263,6 +263,41 @@ func TestAccIBMIAMAccessGroupPolicy_WithCustomRole(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMAccessGroupPolicy_With_Rule_Conditions(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupPolicyRuleConditions(name, "2030-01-01T00:00:00+00:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "rule_conditions.#", "2"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "rule_operator", "and"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "pattern", "time-based-conditions:once"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "roles.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupPolicyRuleConditions(name, "2031-01-01T00:00:00+00:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "rule_conditions.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_iam_access_group_policy.policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"resources", "resource_attributes", "tags", "version",
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMAccessGroupPolicyDestroy(s *terraform.State) error {
 	iamPolicyManagementClient, err := testAccProvider.Meta().(ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
@@ -594,3 +629,31 @@ func testAccCheckIBMIAMAccessGroupPolicyResourceAttributesUpdate(name string) st
 	  	}
 	`, name)
 }
+
+func testAccCheckIBMIAMAccessGroupPolicyRuleConditions(name, expiry string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_access_group_policy" "policy" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			roles           = ["Writer", "Viewer"]
+			resources {
+				service = "kms"
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_date_time}}"
+				operator = "dateTimeGreaterThanOrEquals"
+				value    = ["2021-01-01T00:00:00+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_date_time}}"
+				operator = "dateTimeLessThanOrEquals"
+				value    = ["%s"]
+			}
+			rule_operator = "and"
+			pattern       = "time-based-conditions:once"
+		}
+	`, name, expiry)
+}
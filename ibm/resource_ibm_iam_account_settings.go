@@ -4,11 +4,19 @@
 package ibm
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
@@ -19,8 +27,20 @@ const (
 	restrictCreateServiceId = "restrict_create_service_id"
 	restrictCreateApiKey    = "restrict_create_platform_apikey"
 	mfa                     = "mfa"
+	userMfa                 = "user_mfa.mfa"
 )
 
+// MFA traits of single users, which the iamidentityv1 package doesn't model
+type iamAccountSettingsUserMFA struct {
+	IamID *string `json:"iam_id"`
+	Mfa   *string `json:"mfa"`
+}
+
+type iamAccountSettingsUserMFAs struct {
+	EntityTag *string                     `json:"entity_tag,omitempty"`
+	UserMfa   []iamAccountSettingsUserMFA `json:"user_mfa"`
+}
+
 func resourceIbmIamAccountSettings() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceIbmIamAccountSettingsCreate,
@@ -29,6 +49,12 @@ func resourceIbmIamAccountSettings() *schema.Resource {
 		DeleteContext: resourceIbmIamAccountSettingsDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return resourceIbmIamAccountSettingsLockoutWarnings(diff, meta)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"include_history": &schema.Schema{
 				Type:        schema.TypeBool,
@@ -51,10 +77,17 @@ func resourceIbmIamAccountSettings() *schema.Resource {
 				Description:  "Defines whether or not creating platform API keys is access controlled. Valid values:  * RESTRICTED - to apply access control  * NOT_RESTRICTED - to remove access control  * NOT_SET - to 'unset' a previous set value.",
 			},
 			"allowed_ip_addresses": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Defines the IP addresses and subnets from which IAM tokens can be created for the account.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedIPAddresses,
+				Description:  "Defines the IP addresses and subnets from which IAM tokens can be created for the account.",
+			},
+			"current_ip": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateIP,
+				Description:  "IP address from which IAM sees the identity that runs Terraform, a plan warns when allowed_ip_addresses doesn't include it.",
 			},
 			"entity_tag": &schema.Schema{
 				Type:        schema.TypeString,
@@ -70,10 +103,37 @@ func resourceIbmIamAccountSettings() *schema.Resource {
 				Description:  "Defines the MFA trait for the account. Valid values:  * NONE - No MFA trait set  * TOTP - For all non-federated IBMId users  * TOTP4ALL - For all users  * LEVEL1 - Email-based MFA for all users  * LEVEL2 - TOTP-based MFA for all users  * LEVEL3 - U2F MFA for all users.",
 			},
 			"if_match": &schema.Schema{
-				Type:        schema.TypeString,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressIamAccountSettingsLegacyIfMatch,
+				Description:      "Version of the account settings to be updated. Specify the version that you retrieved as entity_tag (ETag header) when reading the account. This value helps identifying parallel usage of this API. Pass * to indicate to update any version available. This might result in stale updates. Defaults to the entity_tag that was read last, so that an update fails when the account settings were changed since.",
+			},
+			"user_mfa": &schema.Schema{
+				Type:        schema.TypeSet,
 				Optional:    true,
-				Default:     "*",
-				Description: "Version of the account settings to be updated. Specify the version that you retrieved as entity_tag (ETag header) when reading the account. This value helps identifying parallel usage of this API. Pass * to indicate to update any version available. This might result in stale updates.",
+				Computed:    true,
+				Description: "List of users with an MFA trait that overrides the MFA trait of the account.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"iam_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The IAM ID of the user.",
+						},
+						"mfa": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: InvokeValidator(accountSettings, userMfa),
+							Description:  "Defines the MFA trait of the user. Valid values:  * NONE - No MFA trait set  * NONE_NO_ROPC - No MFA, disable CLI logins with only a password  * TOTP - For all non-federated IBMId users  * TOTP4ALL - For all users  * LEVEL1 - Email-based MFA for all users  * LEVEL2 - TOTP-based MFA for all users  * LEVEL3 - U2F MFA for all users.",
+						},
+					},
+				},
+			},
+			"lockout_warnings": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Warnings about planned changes that could lock the identity that runs Terraform out of the account.",
 			},
 			"history": &schema.Schema{
 				Type:        schema.TypeList,
@@ -116,22 +176,25 @@ func resourceIbmIamAccountSettings() *schema.Resource {
 				},
 			},
 			"session_expiration_in_seconds": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Defines the session expiration in seconds for the account. Valid values:  * Any whole number between between '900' and '86400'  * NOT_SET - To unset account setting and use service default.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAccountSettingsNumber(900, 86400),
+				Description:  "Defines the session expiration in seconds for the account. Valid values:  * Any whole number between between '900' and '86400'  * NOT_SET - To unset account setting and use service default.",
 			},
 			"session_invalidation_in_seconds": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Defines the period of time in seconds in which a session will be invalidated due  to inactivity. Valid values:   * Any whole number between '900' and '7200'   * NOT_SET - To unset account setting and use service default.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAccountSettingsNumber(900, 7200),
+				Description:  "Defines the period of time in seconds in which a session will be invalidated due  to inactivity. Valid values:   * Any whole number between '900' and '7200'   * NOT_SET - To unset account setting and use service default.",
 			},
 			"max_sessions_per_identity": &schema.Schema{
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-				Description: "Defines the max allowed sessions per identity required by the account. Value values: * Any whole number greater than '0'   * NOT_SET - To unset account setting and use service default.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAccountSettingsNumber(1, 0),
+				Description:  "Defines the max allowed sessions per identity required by the account. Value values: * Any whole number greater than '0'   * NOT_SET - To unset account setting and use service default.",
 			},
 		},
 	}
@@ -164,6 +227,13 @@ func resourceIBMIAMAccountSettingsValidator() *ResourceValidator {
 			Type:                       TypeString,
 			Required:                   true,
 			AllowedValues:              mfa_values})
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 userMfa,
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "NONE, NONE_NO_ROPC, TOTP, TOTP4ALL, LEVEL1, LEVEL2, LEVEL3"})
 
 	ibmIAMAccountSettingsValidator := ResourceValidator{ResourceName: "ibm_iam_account_settings", Schema: validateSchema}
 	return &ibmIAMAccountSettingsValidator
@@ -193,6 +263,7 @@ func resourceIbmIamAccountSettingsCreate(context context.Context, d *schema.Reso
 	}
 
 	d.SetId(fmt.Sprintf("%s", *accountSettingsResponse.AccountID))
+	d.Set("entity_tag", accountSettingsResponse.EntityTag)
 
 	return resourceIbmIamAccountSettingsUpdate(context, d, meta)
 }
@@ -203,12 +274,7 @@ func resourceIbmIamAccountSettingsRead(context context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	getAccountSettingsOptions := &iamidentityv1.GetAccountSettingsOptions{}
-
-	getAccountSettingsOptions.SetAccountID(d.Id())
-	getAccountSettingsOptions.SetIncludeHistory(d.Get("include_history").(bool))
-
-	accountSettingsResponse, response, err := iamIdentityClient.GetAccountSettings(getAccountSettingsOptions)
+	accountSettingsResponse, userMFA, response, err := getIamAccountSettings(iamIdentityClient, d.Id(), d.Get("include_history").(bool))
 	if err != nil {
 		if response != nil && response.StatusCode == 404 {
 			d.SetId("")
@@ -253,6 +319,11 @@ func resourceIbmIamAccountSettingsRead(context context.Context, d *schema.Resour
 		return diag.FromErr(fmt.Errorf("Error setting max_sessions_per_identity: %s", err))
 	}
 
+	if err = d.Set("user_mfa", flattenIamAccountSettingsUserMFA(userMFA)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting user_mfa: %s", err))
+	}
+	d.Set("lockout_warnings", []string{})
+
 	return nil
 }
 
@@ -275,10 +346,29 @@ func resourceIbmIamAccountSettingsUpdate(context context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
+	// The lockout warnings of the plan are repeated in the output of the apply
+	var diags diag.Diagnostics
+	for _, warning := range d.Get("lockout_warnings").([]interface{}) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  warning.(string),
+		})
+	}
+
+	// Without an explicit if_match the entity tag that was read last is used, so that the update
+	// fails instead of overwriting changes that were made since
+	ifMatch := d.Get("if_match").(string)
+	if ifMatch == "" {
+		ifMatch = d.Get("entity_tag").(string)
+	}
+	if ifMatch == "" {
+		ifMatch = "*"
+	}
+
 	updateAccountSettingsOptions := &iamidentityv1.UpdateAccountSettingsOptions{}
 
 	updateAccountSettingsOptions.SetAccountID(d.Id())
-	updateAccountSettingsOptions.SetIfMatch(d.Get("if_match").(string))
+	updateAccountSettingsOptions.SetIfMatch(ifMatch)
 
 	hasChange := false
 
@@ -325,14 +415,34 @@ func resourceIbmIamAccountSettingsUpdate(context context.Context, d *schema.Reso
 	}
 
 	if hasChange {
-		_, response, err := iamIdentityClient.UpdateAccountSettings(updateAccountSettingsOptions)
+		accountSettingsResponse, response, err := iamIdentityClient.UpdateAccountSettings(updateAccountSettingsOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateAccountSettings failed %s\n%s", err, response)
-			return diag.FromErr(err)
+			return append(diags, diag.FromErr(iamAccountSettingsUpdateError(d.Id(), ifMatch, err, response))...)
+		}
+		// The MFA traits of the users are updated with the version that the update created
+		if accountSettingsResponse.EntityTag != nil {
+			ifMatch = *accountSettingsResponse.EntityTag
 		}
 	}
 
-	return resourceIbmIamAccountSettingsRead(context, d, meta)
+	if d.HasChange("user_mfa") {
+		userMFA := expandIamAccountSettingsUserMFA(d.Get("user_mfa").(*schema.Set))
+		response, err := updateIamAccountSettingsUserMFA(iamIdentityClient, d.Id(), ifMatch, userMFA)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateAccountSettings failed %s\n%s", err, response)
+			return append(diags, diag.FromErr(iamAccountSettingsUpdateError(d.Id(), ifMatch, err, response))...)
+		}
+	}
+
+	return append(diags, resourceIbmIamAccountSettingsRead(context, d, meta)...)
+}
+
+func iamAccountSettingsUpdateError(accountID, ifMatch string, err error, response *core.DetailedResponse) error {
+	if response != nil && (response.StatusCode == 409 || response.StatusCode == 412) {
+		return fmt.Errorf("[ERROR] The account settings of account %s were changed since version %s was read, refresh the state and apply again: %s", accountID, ifMatch, err)
+	}
+	return err
 }
 
 func resourceIbmIamAccountSettingsDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -342,3 +452,188 @@ func resourceIbmIamAccountSettingsDelete(context context.Context, d *schema.Reso
 
 	return nil
 }
+
+// suppressIamAccountSettingsLegacyIfMatch keeps the * that if_match defaulted to in earlier releases from showing
+// up as a change when if_match isn't configured
+func suppressIamAccountSettingsLegacyIfMatch(k, old, new string, d *schema.ResourceData) bool {
+	return old == "*" && new == ""
+}
+
+func iamAccountSettingsUserMFARequest(client *iamidentityv1.IamIdentityV1, method, accountID, ifMatch string, query url.Values, body, result interface{}) (*core.DetailedResponse, error) {
+	return sendCoreRequest(context.Background(), client.Service, coreRequest{
+		Method:  method,
+		Path:    "/v1/accounts/" + url.PathEscape(accountID) + "/settings/identity",
+		Query:   query,
+		Headers: map[string]string{"If-Match": ifMatch},
+		Body:    body,
+	}, result)
+}
+
+// getIamAccountSettings reads the account settings together with the MFA traits of the users, which come back on
+// the same response but aren't part of iamidentityv1.AccountSettingsResponse
+func getIamAccountSettings(client *iamidentityv1.IamIdentityV1, accountID string, includeHistory bool) (*iamidentityv1.AccountSettingsResponse, []iamAccountSettingsUserMFA, *core.DetailedResponse, error) {
+	var raw map[string]json.RawMessage
+	response, err := iamAccountSettingsUserMFARequest(client, core.GET, accountID, "", url.Values{"include_history": {fmt.Sprint(includeHistory)}}, nil, &raw)
+	if err != nil {
+		return nil, nil, response, err
+	}
+	var settings *iamidentityv1.AccountSettingsResponse
+	if err = core.UnmarshalModel(raw, "", &settings, iamidentityv1.UnmarshalAccountSettingsResponse); err != nil {
+		return nil, nil, response, err
+	}
+	var userMFA []iamAccountSettingsUserMFA
+	if v, ok := raw["user_mfa"]; ok {
+		if err = json.Unmarshal(v, &userMFA); err != nil {
+			return nil, nil, response, err
+		}
+	}
+	return settings, userMFA, response, nil
+}
+
+func updateIamAccountSettingsUserMFA(client *iamidentityv1.IamIdentityV1, accountID, ifMatch string, userMFA []iamAccountSettingsUserMFA) (*core.DetailedResponse, error) {
+	return iamAccountSettingsUserMFARequest(client, core.PUT, accountID, ifMatch, nil, &iamAccountSettingsUserMFAs{UserMfa: userMFA}, nil)
+}
+
+func expandIamAccountSettingsUserMFA(set *schema.Set) []iamAccountSettingsUserMFA {
+	userMFA := make([]iamAccountSettingsUserMFA, 0, set.Len())
+	for _, u := range set.List() {
+		user := u.(map[string]interface{})
+		userMFA = append(userMFA, iamAccountSettingsUserMFA{
+			IamID: core.StringPtr(user["iam_id"].(string)),
+			Mfa:   core.StringPtr(user["mfa"].(string)),
+		})
+	}
+	return userMFA
+}
+
+func flattenIamAccountSettingsUserMFA(userMFA []iamAccountSettingsUserMFA) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(userMFA))
+	for _, user := range userMFA {
+		result = append(result, map[string]interface{}{
+			"iam_id": user.IamID,
+			"mfa":    user.Mfa,
+		})
+	}
+	return result
+}
+
+// validateAccountSettingsNumber validates a number setting of the account, which is either NOT_SET or a whole
+// number of at least min and, unless max is 0, at most max
+func validateAccountSettingsNumber(min, max int) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if value == "NOT_SET" || value == "" {
+			return
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < min || (max > 0 && n > max) {
+			if max > 0 {
+				errors = append(errors, fmt.Errorf("%q must be NOT_SET or a whole number between %d and %d, got %s", k, min, max, value))
+			} else {
+				errors = append(errors, fmt.Errorf("%q must be NOT_SET or a whole number of at least %d, got %s", k, min, value))
+			}
+		}
+		return
+	}
+}
+
+// validateAllowedIPAddresses validates a comma separated list of IP addresses, subnets and IP ranges
+func validateAllowedIPAddresses(v interface{}, k string) (ws []string, errors []error) {
+	for _, entry := range strings.Split(v.(string), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if _, _, err := parseAllowedIPAddress(entry); err != nil {
+			errors = append(errors, fmt.Errorf("%q: %s", k, err))
+		}
+	}
+	return
+}
+
+// parseAllowedIPAddress returns the first and the last address of an IP address, subnet or IP range
+func parseAllowedIPAddress(entry string) (net.IP, net.IP, error) {
+	if bounds := strings.Split(entry, "-"); len(bounds) == 2 {
+		first, last := net.ParseIP(strings.TrimSpace(bounds[0])), net.ParseIP(strings.TrimSpace(bounds[1]))
+		if first == nil || last == nil || bytes.Compare(first.To16(), last.To16()) > 0 {
+			return nil, nil, fmt.Errorf("%s is not a valid IP range", entry)
+		}
+		return first, last, nil
+	}
+	if strings.Contains(entry, "/") {
+		_, subnet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s is not a valid subnet", entry)
+		}
+		last := make(net.IP, len(subnet.IP))
+		for i := range subnet.IP {
+			last[i] = subnet.IP[i] | ^subnet.Mask[i]
+		}
+		return subnet.IP, last, nil
+	}
+	ip := net.ParseIP(entry)
+	if ip == nil {
+		return nil, nil, fmt.Errorf("%s is not a valid IP address", entry)
+	}
+	return ip, ip, nil
+}
+
+// ipAddressAllowed reports whether the IP address is in the comma separated list of allowed IP addresses,
+// subnets and IP ranges, an empty list allows all addresses
+func ipAddressAllowed(ip net.IP, allowedIPAddresses string) bool {
+	allowed := true
+	for _, entry := range strings.Split(allowedIPAddresses, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		allowed = false
+		first, last, err := parseAllowedIPAddress(entry)
+		if err != nil {
+			continue
+		}
+		if bytes.Compare(ip.To16(), first.To16()) >= 0 && bytes.Compare(ip.To16(), last.To16()) <= 0 {
+			return true
+		}
+	}
+	return allowed
+}
+
+// resourceIbmIamAccountSettingsLockoutWarnings sets lockout_warnings to warnings about planned changes that
+// could lock the identity that runs Terraform out of the account. The address IAM sees the identity from
+// can't be found out locally, so an IP allowlist is only checked against current_ip
+func resourceIbmIamAccountSettingsLockoutWarnings(diff *schema.ResourceDiff, meta interface{}) error {
+	warnings := []string{}
+
+	currentIP := diff.Get("current_ip").(string)
+	if currentIP != "" && (diff.HasChange("allowed_ip_addresses") || diff.HasChange("current_ip")) && diff.NewValueKnown("allowed_ip_addresses") {
+		allowedIPAddresses := diff.Get("allowed_ip_addresses").(string)
+		if !ipAddressAllowed(net.ParseIP(currentIP), allowedIPAddresses) {
+			warnings = append(warnings, fmt.Sprintf("allowed_ip_addresses %s doesn't include current_ip %s, IAM tokens can't be created from other addresses, which could lock the identity that runs Terraform out of the account", allowedIPAddresses, currentIP))
+		}
+	}
+
+	if diff.HasChange("user_mfa") {
+		userDetails, err := meta.(ClientSession).BluemixUserDetails()
+		if err == nil {
+			for _, u := range diff.Get("user_mfa").(*schema.Set).List() {
+				user := u.(map[string]interface{})
+				if user["iam_id"].(string) == userDetails.userID && user["mfa"].(string) != "NONE" {
+					warnings = append(warnings, fmt.Sprintf("user_mfa sets MFA trait %s for %s, the identity that runs Terraform, logins of the identity require the MFA factor from then on", user["mfa"], userDetails.userID))
+				}
+			}
+		}
+	}
+
+	if diff.HasChange("restrict_create_platform_apikey") && diff.Get("restrict_create_platform_apikey").(string) == "RESTRICTED" {
+		warnings = append(warnings, "restrict_create_platform_apikey RESTRICTED only allows identities with access to create platform API keys, the identity that runs Terraform may not be able to create or rotate its API key")
+	}
+
+	for _, warning := range warnings {
+		log.Printf("[WARN] %s", warning)
+	}
+	if len(warnings) > 0 {
+		return diff.SetNew("lockout_warnings", warnings)
+	}
+	return nil
+}
@@ -5,6 +5,8 @@ package ibm
 
 import (
 	"fmt"
+	"net"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -98,6 +100,113 @@ func TestAccIBMIAMAccountSettingsUpdate(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMAccountSettingsUserMFA(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmIamAccountSettingsUserMFAConfig("LEVEL1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.iam_account_settings", "user_mfa.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.iam_account_settings", "session_expiration_in_seconds", "3600"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmIamAccountSettingsUserMFAConfig("NONE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_account_settings.iam_account_settings", "user_mfa.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMAccountSettingsConflict(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmIamAccountSettingsSessionConfig("3600"),
+			},
+			resource.TestStep{
+				// A version of the account settings that is not the current version
+				Config:      testAccCheckIbmIamAccountSettingsIfMatchConfig("1800", "1-00000000000000000000000000000000"),
+				ExpectError: regexp.MustCompile("were changed since version"),
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMAccountSettingsInvalidSession(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckIbmIamAccountSettingsSessionConfig("60"),
+				ExpectError: regexp.MustCompile("must be NOT_SET or a whole number between 900 and 86400"),
+			},
+		},
+	})
+}
+
+func TestIpAddressAllowed(t *testing.T) {
+	allowlist := "192.0.2.10, 198.51.100.0/24,203.0.113.5-203.0.113.9"
+	testCases := []struct {
+		ip      string
+		allowed bool
+	}{
+		{"192.0.2.10", true},
+		{"192.0.2.11", false},
+		{"198.51.100.200", true},
+		{"198.51.101.1", false},
+		{"203.0.113.7", true},
+		{"203.0.113.10", false},
+	}
+	for _, tc := range testCases {
+		if allowed := ipAddressAllowed(net.ParseIP(tc.ip), allowlist); allowed != tc.allowed {
+			t.Errorf("ipAddressAllowed(%s) = %t, expected %t", tc.ip, allowed, tc.allowed)
+		}
+	}
+	if !ipAddressAllowed(net.ParseIP("192.0.2.11"), "") {
+		t.Errorf("an empty allowlist must allow all addresses")
+	}
+
+	if _, errs := validateAllowedIPAddresses("192.0.2.10,198.51.100.0/33", "allowed_ip_addresses"); len(errs) != 1 {
+		t.Errorf("expected an error for an invalid subnet, got %v", errs)
+	}
+	if _, errs := validateAllowedIPAddresses("203.0.113.9-203.0.113.5", "allowed_ip_addresses"); len(errs) != 1 {
+		t.Errorf("expected an error for an IP range that ends before it starts, got %v", errs)
+	}
+}
+
+func testAccCheckIbmIamAccountSettingsUserMFAConfig(userMFA string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_iam_users" "users" {
+		}
+
+		resource "ibm_iam_account_settings" "iam_account_settings" {
+			session_expiration_in_seconds = "3600"
+			user_mfa {
+				iam_id = data.ibm_iam_users.users.users[0].iam_id
+				mfa    = "%s"
+			}
+		}
+	`, userMFA)
+}
+
+func testAccCheckIbmIamAccountSettingsSessionConfig(sessionExpiration string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_account_settings" "iam_account_settings" {
+			session_expiration_in_seconds = "%s"
+		}
+	`, sessionExpiration)
+}
+
 func testAccCheckIbmIamAccountSettingsConfigBasic() string {
 	return fmt.Sprintf(`
 
@@ -171,3 +280,13 @@ func testAccCheckIbmIamAccountSettingsDestroy(s *terraform.State) error {
 	// NOT SUPPORTED
 	return nil
 }
+
+func testAccCheckIbmIamAccountSettingsIfMatchConfig(sessionExpiration, ifMatch string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_account_settings" "iam_account_settings" {
+			session_expiration_in_seconds = "%s"
+			if_match                      = "%s"
+		}
+	`, sessionExpiration, ifMatch)
+}
@@ -0,0 +1,392 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMPolicyTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMPolicyTemplateCreate,
+		ReadContext:   resourceIBMIAMPolicyTemplateRead,
+		UpdateContext: resourceIBMIAMPolicyTemplateUpdate,
+		DeleteContext: resourceIBMIAMPolicyTemplateDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				oldCommitted, newCommitted := diff.GetChange("committed")
+				if diff.Id() != "" && oldCommitted.(bool) && !newCommitted.(bool) && !diff.HasChange("policy") && !diff.HasChange("description") {
+					return fmt.Errorf("version %s of the policy template is committed and can't be uncommitted", diff.Get("version").(string))
+				}
+				return nil
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the policy template",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the policy template version",
+			},
+
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "ID of the account of the policy template, defaults to the account of the provider",
+			},
+
+			"policy": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    1,
+				Description: "The v2 policy that the template assigns",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "access",
+							ValidateFunc: InvokeValidator("ibm_iam_policy_template", "type"),
+							Description:  "Type of the policy",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Description of the policy",
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Role names of the policy",
+						},
+						"resource_attributes": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: "Resource attributes of the policy",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of attribute.",
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Value of attribute.",
+									},
+									"operator": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Default:     "stringEquals",
+										Description: "Operator of attribute.",
+									},
+								},
+							},
+						},
+						"rule_conditions": iamPolicyRuleConditionsSchema(),
+						"rule_operator":   iamPolicyRuleOperatorSchema(),
+						"pattern":         iamPolicyPatternSchema(),
+					},
+				},
+			},
+
+			"committed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Commit the version of the policy template, a committed version can't be changed and a change of the template creates a new version",
+			},
+
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current version of the policy template",
+			},
+
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the policy template version",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMPolicyTemplateValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "access, authorization",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_iam_policy_template", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func expandIAMPolicyTemplatePolicy(d *schema.ResourceData, meta interface{}) (*iamPolicyV2, error) {
+	p := d.Get("policy").([]interface{})[0].(map[string]interface{})
+
+	resource, control, err := expandIAMPolicyV2Grant(meta, expandStringList(p["roles"].([]interface{})), p["resource_attributes"].(*schema.Set))
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &iamPolicyV2{
+		Type:     core.StringPtr(p["type"].(string)),
+		Resource: resource,
+		Control:  control,
+		Rule:     expandIAMPolicyRule(p["rule_conditions"].(*schema.Set), p["rule_operator"].(string)),
+	}
+	if v := p["description"].(string); v != "" {
+		policy.Description = core.StringPtr(v)
+	}
+	if v := p["pattern"].(string); v != "" {
+		policy.Pattern = core.StringPtr(v)
+	}
+	return policy, nil
+}
+
+func flattenIAMPolicyTemplatePolicy(d *schema.ResourceData, meta interface{}, policy *iamPolicyV2) ([]map[string]interface{}, error) {
+	if policy == nil {
+		return []map[string]interface{}{}, nil
+	}
+	converted, err := iamPolicyV2ToV1(meta, policy)
+	if err != nil {
+		return nil, err
+	}
+	roles := make([]string, len(converted.Roles))
+	for i, role := range converted.Roles {
+		roles[i] = *role.DisplayName
+	}
+	resourceAttributes := []map[string]interface{}{}
+	for _, a := range converted.Resources[0].Attributes {
+		resourceAttributes = append(resourceAttributes, map[string]interface{}{
+			"name":     a.Name,
+			"value":    a.Value,
+			"operator": a.Operator,
+		})
+	}
+	ruleOperator := ""
+	if policy.Rule != nil && len(policy.Rule.Conditions) > 0 {
+		ruleOperator = *policy.Rule.Operator
+	}
+	return []map[string]interface{}{
+		{
+			"type":                policy.Type,
+			"description":         policy.Description,
+			"roles":               orderIAMPolicyRoles(d.Get("policy.0.roles").([]interface{}), roles),
+			"resource_attributes": resourceAttributes,
+			"rule_conditions":     flattenIAMPolicyRuleConditions(policy.Rule),
+			"rule_operator":       ruleOperator,
+			"pattern":             policy.Pattern,
+		},
+	}, nil
+}
+
+func commitIAMPolicyTemplateVersion(client *iampolicymanagementv1.IamPolicyManagementV1, templateID, version string) error {
+	_, resp, err := getIAMPolicyTemplateVersion(client, templateID, version)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving policy template version: %s\n%s", err, resp)
+	}
+	resp, err = iamPolicyV2Request(client, core.POST, iamPolicyTemplateVersionPath(templateID, version)+"/commit", resp.Headers.Get("ETag"), nil, nil)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error committing policy template version: %s\n%s", err, resp)
+	}
+	return nil
+}
+
+func resourceIBMIAMPolicyTemplateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(ClientSession).BluemixUserDetails()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		accountID = userDetails.userAccount
+	}
+
+	policy, err := expandIAMPolicyTemplatePolicy(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	template := &iamPolicyTemplate{
+		Name:      core.StringPtr(d.Get("name").(string)),
+		AccountID: core.StringPtr(accountID),
+		Policy:    policy,
+	}
+	if v, ok := d.GetOk("description"); ok {
+		template.Description = core.StringPtr(v.(string))
+	}
+
+	result := &iamPolicyTemplate{}
+	resp, err := iamPolicyV2Request(iamPolicyManagementClient, core.POST, iamPolicyTemplatesPath, "", template, result)
+	if err != nil || result.ID == nil {
+		log.Printf("Error creating policy template: %s, %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating policy template: %s\n%s", err, resp))
+	}
+	d.SetId(*result.ID)
+	d.Set("version", result.Version)
+
+	if d.Get("committed").(bool) {
+		if err := commitIAMPolicyTemplateVersion(iamPolicyManagementClient, *result.ID, *result.Version); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIAMPolicyTemplateRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyTemplateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	version := d.Get("version").(string)
+	if version == "" {
+		// An imported template is read at its latest version
+		result := &iamPolicyTemplate{}
+		resp, err := iamPolicyV2Request(iamPolicyManagementClient, core.GET, iamPolicyTemplatePath(d.Id()), "", nil, result)
+		if err != nil || result.Version == nil {
+			if resp != nil && resp.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+			return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving policy template: %s\n%s", err, resp))
+		}
+		version = *result.Version
+	}
+
+	template, resp, err := getIAMPolicyTemplateVersion(iamPolicyManagementClient, d.Id(), version)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("Error retrieving policy template: %s %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving policy template: %s\n%s", err, resp))
+	}
+
+	policy, err := flattenIAMPolicyTemplatePolicy(d, meta, template.Policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("name", template.Name)
+	d.Set("description", template.Description)
+	d.Set("account_id", template.AccountID)
+	d.Set("policy", policy)
+	d.Set("version", template.Version)
+	d.Set("state", template.State)
+	d.Set("committed", template.Committed != nil && *template.Committed)
+
+	return nil
+}
+
+func resourceIBMIAMPolicyTemplateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	templateID := d.Id()
+	version := d.Get("version").(string)
+
+	if d.HasChange("description") || d.HasChange("policy") {
+		policy, err := expandIAMPolicyTemplatePolicy(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		template := &iamPolicyTemplate{
+			Name:   core.StringPtr(d.Get("name").(string)),
+			Policy: policy,
+		}
+		if v, ok := d.GetOk("description"); ok {
+			template.Description = core.StringPtr(v.(string))
+		}
+
+		oldCommitted, _ := d.GetChange("committed")
+		if oldCommitted.(bool) {
+			// A committed version can't be changed, the change is a new version of the template
+			result := &iamPolicyTemplate{}
+			resp, err := iamPolicyV2Request(iamPolicyManagementClient, core.POST, iamPolicyTemplatePath(templateID)+"/versions", "", template, result)
+			if err != nil || result.Version == nil {
+				log.Printf("Error creating policy template version: %s %s", err, resp)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error creating policy template version: %s\n%s", err, resp))
+			}
+			version = *result.Version
+			d.Set("version", version)
+		} else {
+			_, resp, err := getIAMPolicyTemplateVersion(iamPolicyManagementClient, templateID, version)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving policy template version: %s\n%s", err, resp))
+			}
+			resp, err = iamPolicyV2Request(iamPolicyManagementClient, core.PUT, iamPolicyTemplateVersionPath(templateID, version), resp.Headers.Get("ETag"), template, nil)
+			if err != nil {
+				log.Printf("Error updating policy template version: %s %s", err, resp)
+				return diag.FromErr(fmt.Errorf("[ERROR] Error updating policy template version: %s\n%s", err, resp))
+			}
+		}
+	}
+
+	if d.Get("committed").(bool) && (d.HasChange("committed") || d.HasChange("description") || d.HasChange("policy")) {
+		if err := commitIAMPolicyTemplateVersion(iamPolicyManagementClient, templateID, version); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMIAMPolicyTemplateRead(context, d, meta)
+}
+
+func resourceIBMIAMPolicyTemplateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := iamPolicyV2Request(iamPolicyManagementClient, core.DELETE, iamPolicyTemplatePath(d.Id()), "", nil, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("Error deleting policy template: %s %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting policy template: %s\n%s", err, resp))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMPolicyTemplate_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_iam_policy_template.template"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMPolicyTemplateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicyTemplateConfig(name, "Viewer", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.roles.0", "Viewer"),
+					resource.TestCheckResourceAttr(resourceName, "policy.0.rule_conditions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "committed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMPolicyTemplateConfig(name, "Viewer", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "committed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMPolicyTemplateConfig(name, "Editor", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policy.0.roles.0", "Editor"),
+					resource.TestCheckResourceAttr(resourceName, "committed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMPolicyTemplateDestroy(s *terraform.State) error {
+	iamPolicyManagementClient, err := testAccProvider.Meta().(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_policy_template" {
+			continue
+		}
+
+		resp, err := iamPolicyV2Request(iamPolicyManagementClient, core.GET, iamPolicyTemplatePath(rs.Primary.ID), "", nil, &iamPolicyTemplate{})
+		if err == nil {
+			return fmt.Errorf("Policy template still exists: %s", rs.Primary.ID)
+		} else if resp == nil || resp.StatusCode != 404 {
+			return fmt.Errorf("Error waiting for policy template (%s) to be destroyed: %s %s", rs.Primary.ID, err, resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMPolicyTemplateConfig(name, role string, committed bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_policy_template" "template" {
+			name        = "%s"
+			description = "Policy template created by terraform acceptance tests"
+			committed   = %t
+
+			policy {
+				roles = ["%s"]
+				resource_attributes {
+					name  = "serviceName"
+					value = "kms"
+				}
+				rule_conditions {
+					key      = "{{environment.attributes.current_date_time}}"
+					operator = "dateTimeLessThan"
+					value    = ["2030-01-01T00:00:00+00:00"]
+				}
+				pattern = "time-based-conditions:once"
+			}
+		}
+	`, name, committed, role)
+}
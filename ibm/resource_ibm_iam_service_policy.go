@@ -24,6 +24,10 @@ func resourceIBMIAMServicePolicy() *schema.Resource {
 		Exists: resourceIBMIAMServicePolicyExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts, err := idParts(d.Id())
+				if err != nil || len(parts) < 2 {
+					return nil, fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of serviceID(OR)iamID/PolicyID", d.Id())
+				}
 				resources, resourceAttributes, err := importServicePolicy(d, meta)
 				if err != nil {
 					return nil, fmt.Errorf("[ERROR] Error reading resource ID: %s", err)
@@ -155,6 +159,12 @@ func resourceIBMIAMServicePolicy() *schema.Resource {
 				Optional:    true,
 				Description: "Description of the Policy",
 			},
+
+			"rule_conditions": iamPolicyRuleConditionsSchema(),
+
+			"rule_operator": iamPolicyRuleOperatorSchema(),
+
+			"pattern": iamPolicyPatternSchema(),
 		},
 	}
 }
@@ -228,18 +238,14 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		createPolicyOptions.Description = &des
 	}
 
-	servicePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	servicePolicy, res, err := createIAMPolicy(d, iamPolicyManagementClient, createPolicyOptions)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error creating servicePolicy: %s %s", err, res)
 	}
 
-	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
-		*servicePolicy.ID,
-	)
-
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, res, err := getIAMPolicy(d, meta, iamPolicyManagementClient, *servicePolicy.ID)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -251,7 +257,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 	})
 
 	if isResourceTimeoutError(err) {
-		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, res, err = getIAMPolicy(d, meta, iamPolicyManagementClient, *servicePolicy.ID)
 	}
 	if err != nil {
 		if v, ok := d.GetOk("iam_service_id"); ok && v != nil {
@@ -288,13 +294,11 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	serviceIDUUID := parts[0]
 	servicePolicyID := parts[1]
 	servicePolicy := &iampolicymanagementv1.Policy{}
+	var servicePolicyV2 *iamPolicyV2
 	res := &core.DetailedResponse{}
-	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
-		servicePolicyID,
-	)
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		servicePolicy, servicePolicyV2, res, err = getIAMPolicy(d, meta, iamPolicyManagementClient, servicePolicyID)
 
 		if err != nil || servicePolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -306,7 +310,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	})
 
 	if isResourceTimeoutError(err) {
-		servicePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		servicePolicy, servicePolicyV2, res, err = getIAMPolicy(d, meta, iamPolicyManagementClient, servicePolicyID)
 	}
 	if err != nil || servicePolicy == nil {
 		return fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s %s", err, res)
@@ -321,7 +325,7 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	for i, role := range servicePolicy.Roles {
 		roles[i] = *role.DisplayName
 	}
-	d.Set("roles", roles)
+	d.Set("roles", orderIAMPolicyRoles(d.Get("roles").([]interface{}), roles))
 
 	if _, ok := d.GetOk("resources"); ok {
 		d.Set("resources", flattenPolicyResource(servicePolicy.Resources))
@@ -340,13 +344,14 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 	if servicePolicy.Description != nil {
 		d.Set("description", *servicePolicy.Description)
 	}
+	setIAMPolicyRule(d, servicePolicyV2)
 
 	return nil
 }
 
 func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 
 		parts, err := idParts(d.Id())
 		if err != nil {
@@ -408,10 +413,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 			return err
 		}
 
-		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
-			servicePolicyID,
-		)
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, response, err := getIAMPolicy(d, meta, iamPolicyManagementClient, servicePolicyID)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -434,7 +436,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 			updatePolicyOptions.Description = &des
 		}
 
-		_, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, err = updateIAMPolicy(d, iamPolicyManagementClient, updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("[ERROR] Error updating service policy: %s", err)
 		}
@@ -457,11 +459,7 @@ func resourceIBMIAMServicePolicyDelete(d *schema.ResourceData, meta interface{})
 	}
 	servicePolicyID := parts[1]
 
-	deletePolicyOptions := iamPolicyManagementClient.NewDeletePolicyOptions(
-		servicePolicyID,
-	)
-
-	_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	_, err = deleteIAMPolicy(d, iamPolicyManagementClient, servicePolicyID)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error deleting service policy: %s", err)
 	}
@@ -486,11 +484,7 @@ func resourceIBMIAMServicePolicyExists(d *schema.ResourceData, meta interface{})
 	serviceIDUUID := parts[0]
 	servicePolicyID := parts[1]
 
-	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
-		servicePolicyID,
-	)
-
-	servicePolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	servicePolicy, _, resp, err := getIAMPolicy(d, meta, iamPolicyManagementClient, servicePolicyID)
 	if err != nil || servicePolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -518,10 +512,7 @@ func importServicePolicy(d *schema.ResourceData, meta interface{}) (interface{},
 		return nil, nil, err
 	}
 	servicePolicyID := parts[1]
-	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
-		servicePolicyID,
-	)
-	servicePolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	servicePolicy, _, _, err := getIAMPolicy(d, meta, iamPolicyManagementClient, servicePolicyID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("[ERROR] Error retrieving servicePolicy: %s", err)
 	}
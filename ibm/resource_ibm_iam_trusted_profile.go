@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMTrustedProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfileCreate,
+		ReadContext:   resourceIBMIAMTrustedProfileRead,
+		UpdateContext: resourceIBMIAMTrustedProfileUpdate,
+		DeleteContext: resourceIBMIAMTrustedProfileDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the trusted profile. The name is checked for uniqueness within the account",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the trusted profile",
+			},
+
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the account that the trusted profile belongs to",
+			},
+
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "crn of the trusted profile",
+			},
+
+			"iam_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the trusted profile, it is the subject of the access policies of the profile",
+			},
+
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the trusted profile",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of creation of the trusted profile",
+			},
+
+			"modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last modification of the trusted profile",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMTrustedProfileCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profile := &iamTrustedProfile{
+		Name:      core.StringPtr(d.Get("name").(string)),
+		AccountID: &userDetails.userAccount,
+	}
+	if v, ok := d.GetOk("description"); ok {
+		profile.Description = core.StringPtr(v.(string))
+	}
+
+	result := &iamTrustedProfile{}
+	resp, err := iamTrustedProfileRequest(context, iamIdentityClient, core.POST, iamTrustedProfilesPath, nil, "", profile, result)
+	if err != nil || result.ID == nil {
+		log.Printf("Error creating trusted profile: %s, %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating trusted profile: %s\n%s", err, resp))
+	}
+	d.SetId(*result.ID)
+
+	return resourceIBMIAMTrustedProfileRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profile, resp, err := getIAMTrustedProfile(context, iamIdentityClient, d.Id())
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("Error retrieving trusted profile: %s %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving trusted profile: %s\n%s", err, resp))
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("account_id", profile.AccountID)
+	d.Set("crn", profile.CRN)
+	d.Set("iam_id", profile.IamID)
+	d.Set("entity_tag", profile.EntityTag)
+	d.Set("created_at", profile.CreatedAt)
+	d.Set("modified_at", profile.ModifiedAt)
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfileUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") || d.HasChange("description") {
+		profile := &iamTrustedProfile{
+			Name:        core.StringPtr(d.Get("name").(string)),
+			Description: core.StringPtr(d.Get("description").(string)),
+		}
+		resp, err := iamTrustedProfileRequest(context, iamIdentityClient, core.PUT, iamTrustedProfilePath(d.Id()), nil, "*", profile, nil)
+		if err != nil {
+			log.Printf("Error updating trusted profile: %s, %s", err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating trusted profile: %s\n%s", err, resp))
+		}
+	}
+
+	return resourceIBMIAMTrustedProfileRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := iamTrustedProfileRequest(context, iamIdentityClient, core.DELETE, iamTrustedProfilePath(d.Id()), nil, "", nil, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("Error deleting trusted profile: %s %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting trusted profile: %s\n%s", err, resp))
+	}
+
+	d.SetId("")
+
+	return nil
+}
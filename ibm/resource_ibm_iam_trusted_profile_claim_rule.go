@@ -0,0 +1,340 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMTrustedProfileClaimRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfileClaimRuleCreate,
+		ReadContext:   resourceIBMIAMTrustedProfileClaimRuleRead,
+		UpdateContext: resourceIBMIAMTrustedProfileClaimRuleUpdate,
+		DeleteContext: resourceIBMIAMTrustedProfileClaimRuleDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return resourceIBMIAMTrustedProfileClaimRuleValidate(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the trusted profile",
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_claim_rule", "type"),
+				Description:  "Type of the claim rule, Profile-SAML for the claims of a federated identity provider or Profile-CR for the claims of a compute resource",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the claim rule",
+			},
+
+			"realm_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The realm name of the identity provider that is authorized to apply the trusted profile, required for type Profile-SAML",
+			},
+
+			"cr_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_claim_rule", "cr_type"),
+				Description:  "The compute resource type the rule applies to, required for type Profile-CR",
+			},
+
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_claim_rule", "expiration"),
+				Description:  "Session expiration in seconds, only valid for type Profile-SAML",
+			},
+
+			"conditions": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "Conditions of the claim rule, all conditions must match for the rule to apply",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"claim": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The claim to evaluate against",
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_claim_rule", "operator"),
+							Description:  "The operation to perform on the claim",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The stringified JSON value that the claim is compared to using the operator",
+						},
+					},
+				},
+			},
+
+			"rule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the claim rule",
+			},
+
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the claim rule",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of creation of the claim rule",
+			},
+
+			"modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last modification of the claim rule",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              iamTrustedProfileClaimRuleTypeSAML + ", " + iamTrustedProfileClaimRuleTypeCR,
+		},
+		ValidateSchema{
+			Identifier:                 "cr_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "VSI, IKS_SA, ROKS_SA",
+		},
+		ValidateSchema{
+			Identifier:                 "expiration",
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "900",
+			MaxValue:                   "43200",
+		},
+		ValidateSchema{
+			Identifier:                 "operator",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "EQUALS, NOT_EQUALS, EQUALS_IGNORE_CASE, NOT_EQUALS_IGNORE_CASE, CONTAINS, IN",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_iam_trusted_profile_claim_rule", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// resourceIBMIAMTrustedProfileClaimRuleValidate checks the arguments that only apply to one type of rule
+func resourceIBMIAMTrustedProfileClaimRuleValidate(diff *schema.ResourceDiff) error {
+	ruleType := diff.Get("type").(string)
+	_, realmName := diff.GetOk("realm_name")
+	_, crType := diff.GetOk("cr_type")
+	switch ruleType {
+	case iamTrustedProfileClaimRuleTypeSAML:
+		if !realmName {
+			return fmt.Errorf("realm_name is required for a claim rule of type %s", ruleType)
+		}
+		if crType {
+			return fmt.Errorf("cr_type can't be set for a claim rule of type %s", ruleType)
+		}
+	case iamTrustedProfileClaimRuleTypeCR:
+		if !crType {
+			return fmt.Errorf("cr_type is required for a claim rule of type %s", ruleType)
+		}
+		if realmName {
+			return fmt.Errorf("realm_name can't be set for a claim rule of type %s", ruleType)
+		}
+		if diff.HasChange("expiration") && diff.Get("expiration").(int) != 0 {
+			return fmt.Errorf("expiration can't be set for a claim rule of type %s", ruleType)
+		}
+	}
+	return nil
+}
+
+func expandIAMTrustedProfileClaimRule(d *schema.ResourceData) *iamTrustedProfileClaimRule {
+	rule := &iamTrustedProfileClaimRule{
+		Type:       core.StringPtr(d.Get("type").(string)),
+		Conditions: []iamTrustedProfileClaimRuleCondition{},
+	}
+	if v, ok := d.GetOk("name"); ok {
+		rule.Name = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("realm_name"); ok {
+		rule.RealmName = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("cr_type"); ok {
+		rule.CrType = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("expiration"); ok {
+		rule.Expiration = core.Int64Ptr(int64(v.(int)))
+	}
+	for _, c := range d.Get("conditions").([]interface{}) {
+		condition := c.(map[string]interface{})
+		rule.Conditions = append(rule.Conditions, iamTrustedProfileClaimRuleCondition{
+			Claim:    core.StringPtr(condition["claim"].(string)),
+			Operator: core.StringPtr(condition["operator"].(string)),
+			Value:    core.StringPtr(condition["value"].(string)),
+		})
+	}
+	return rule
+}
+
+func flattenIAMTrustedProfileClaimRuleConditions(conditions []iamTrustedProfileClaimRuleCondition) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(conditions))
+	for _, condition := range conditions {
+		result = append(result, map[string]interface{}{
+			"claim":    condition.Claim,
+			"operator": condition.Operator,
+			"value":    condition.Value,
+		})
+	}
+	return result
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profileID := d.Get("profile_id").(string)
+	rule := expandIAMTrustedProfileClaimRule(d)
+
+	result := &iamTrustedProfileClaimRule{}
+	resp, err := iamTrustedProfileRequest(context, iamIdentityClient, core.POST, iamTrustedProfileClaimRulePath(profileID, ""), nil, "", rule, result)
+	if err != nil || result.ID == nil {
+		log.Printf("Error creating trusted profile claim rule: %s, %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating trusted profile claim rule: %s\n%s", err, resp))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", profileID, *result.ID))
+
+	return resourceIBMIAMTrustedProfileClaimRuleRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) < 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of profileID/ruleID", d.Id()))
+	}
+
+	rule, resp, err := getIAMTrustedProfileClaimRule(context, iamIdentityClient, parts[0], parts[1])
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("Error retrieving trusted profile claim rule: %s %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving trusted profile claim rule: %s\n%s", err, resp))
+	}
+
+	d.Set("profile_id", parts[0])
+	d.Set("rule_id", rule.ID)
+	d.Set("type", rule.Type)
+	d.Set("name", rule.Name)
+	d.Set("realm_name", rule.RealmName)
+	d.Set("cr_type", rule.CrType)
+	d.Set("expiration", rule.Expiration)
+	d.Set("conditions", flattenIAMTrustedProfileClaimRuleConditions(rule.Conditions))
+	d.Set("entity_tag", rule.EntityTag)
+	d.Set("created_at", rule.CreatedAt)
+	d.Set("modified_at", rule.ModifiedAt)
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("name") || d.HasChange("realm_name") || d.HasChange("cr_type") || d.HasChange("expiration") || d.HasChange("conditions") {
+		// The rule is replaced as a whole, the entity tag makes sure that a concurrent change is not overwritten
+		rule := expandIAMTrustedProfileClaimRule(d)
+		resp, err := iamTrustedProfileRequest(context, iamIdentityClient, core.PUT, iamTrustedProfileClaimRulePath(parts[0], parts[1]), nil, d.Get("entity_tag").(string), rule, nil)
+		if err != nil {
+			log.Printf("Error updating trusted profile claim rule: %s, %s", err, resp)
+			return diag.FromErr(fmt.Errorf("[ERROR] Error updating trusted profile claim rule: %s\n%s", err, resp))
+		}
+	}
+
+	return resourceIBMIAMTrustedProfileClaimRuleRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := iamTrustedProfileRequest(context, iamIdentityClient, core.DELETE, iamTrustedProfileClaimRulePath(parts[0], parts[1]), nil, "", nil, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("Error deleting trusted profile claim rule: %s %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting trusted profile claim rule: %s\n%s", err, resp))
+	}
+
+	d.SetId("")
+
+	return nil
+}
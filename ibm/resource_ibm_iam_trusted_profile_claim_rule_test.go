@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMTrustedProfileClaimRule_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_iam_trusted_profile_claim_rule.rule"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMTrustedProfileClaimRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfileClaimRuleSAML(name, "\\\"admins\\\""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "Profile-SAML"),
+					resource.TestCheckResourceAttr(resourceName, "expiration", "43200"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.value", "\"admins\""),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMTrustedProfileClaimRuleSAML(name, "\\\"operators\\\""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "conditions.0.value", "\"operators\""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMTrustedProfileClaimRule_InvalidType(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMIAMTrustedProfileClaimRuleCRWithRealm(name),
+				ExpectError: regexp.MustCompile("realm_name can't be set for a claim rule of type Profile-CR"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfileClaimRuleDestroy(s *terraform.State) error {
+	iamIdentityClient, err := testAccProvider.Meta().(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_trusted_profile_claim_rule" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, resp, err := getIAMTrustedProfileClaimRule(context.Background(), iamIdentityClient, parts[0], parts[1])
+		if err == nil {
+			return fmt.Errorf("Trusted profile claim rule still exists: %s %s", rs.Primary.ID, resp)
+		} else if resp == nil || resp.StatusCode != 404 {
+			return fmt.Errorf("Error waiting for trusted profile claim rule (%s) to be destroyed: %s %s", rs.Primary.ID, err, resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMTrustedProfileClaimRuleSAML(name, value string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "profile" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile_claim_rule" "rule" {
+			profile_id = ibm_iam_trusted_profile.profile.id
+			type       = "Profile-SAML"
+			name       = "%s"
+			realm_name = "https://sso.example.com/saml"
+			expiration = 43200
+			conditions {
+				claim    = "groups"
+				operator = "CONTAINS"
+				value    = "%s"
+			}
+		}
+	`, name, name, value)
+}
+
+func testAccCheckIBMIAMTrustedProfileClaimRuleCRWithRealm(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "profile" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile_claim_rule" "rule" {
+			profile_id = ibm_iam_trusted_profile.profile.id
+			type       = "Profile-CR"
+			cr_type    = "IKS_SA"
+			realm_name = "https://sso.example.com/saml"
+			conditions {
+				claim    = "namespace"
+				operator = "EQUALS"
+				value    = "\"default\""
+			}
+		}
+	`, name)
+}
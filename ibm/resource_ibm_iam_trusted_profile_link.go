@@ -0,0 +1,256 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMTrustedProfileLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfileLinkCreate,
+		ReadContext:   resourceIBMIAMTrustedProfileLinkRead,
+		DeleteContext: resourceIBMIAMTrustedProfileLinkDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return resourceIBMIAMTrustedProfileLinkValidate(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the trusted profile",
+			},
+
+			"cr_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_link", "cr_type"),
+				Description:  "The compute resource type, VSI for a virtual server instance, IKS_SA or ROKS_SA for a service account of a Kubernetes or OpenShift cluster",
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the link",
+			},
+
+			"link": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				MaxItems:    1,
+				Description: "The compute resource that is linked to the trusted profile",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The CRN of the compute resource",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The Kubernetes namespace of the service account, required for IKS_SA and ROKS_SA",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The name of the Kubernetes service account, required for IKS_SA and ROKS_SA",
+						},
+					},
+				},
+			},
+
+			"link_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the link",
+			},
+
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the link",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of creation of the link",
+			},
+
+			"modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last modification of the link",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMTrustedProfileLinkValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "cr_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "VSI, IKS_SA, ROKS_SA",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_iam_trusted_profile_link", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// resourceIBMIAMTrustedProfileLinkValidate checks that a service account link names the service account
+func resourceIBMIAMTrustedProfileLinkValidate(diff *schema.ResourceDiff) error {
+	crType := diff.Get("cr_type").(string)
+	if crType != "IKS_SA" && crType != "ROKS_SA" {
+		return nil
+	}
+	if !diff.NewValueKnown("link") {
+		return nil
+	}
+	for _, l := range diff.Get("link").([]interface{}) {
+		link, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if link["namespace"].(string) == "" || link["name"].(string) == "" {
+			return fmt.Errorf("link.0.namespace and link.0.name are required for a link of type %s", crType)
+		}
+	}
+	return nil
+}
+
+func resourceIBMIAMTrustedProfileLinkCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profileID := d.Get("profile_id").(string)
+	target := d.Get("link").([]interface{})[0].(map[string]interface{})
+	link := &iamTrustedProfileLink{
+		CrType: core.StringPtr(d.Get("cr_type").(string)),
+		Link: &iamTrustedProfileLinkTarget{
+			CRN: core.StringPtr(target["crn"].(string)),
+		},
+	}
+	if v, ok := d.GetOk("name"); ok {
+		link.Name = core.StringPtr(v.(string))
+	}
+	if v := target["namespace"].(string); v != "" {
+		link.Link.Namespace = core.StringPtr(v)
+	}
+	if v := target["name"].(string); v != "" {
+		link.Link.Name = core.StringPtr(v)
+	}
+
+	result := &iamTrustedProfileLink{}
+	resp, err := iamTrustedProfileRequest(context, iamIdentityClient, core.POST, iamTrustedProfileLinkPath(profileID, ""), nil, "", link, result)
+	if err != nil || result.ID == nil {
+		log.Printf("Error creating trusted profile link: %s, %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error creating trusted profile link: %s\n%s", err, resp))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", profileID, *result.ID))
+
+	return resourceIBMIAMTrustedProfileLinkRead(context, d, meta)
+}
+
+func flattenIAMTrustedProfileLinkTarget(target *iamTrustedProfileLinkTarget) []map[string]interface{} {
+	if target == nil {
+		return []map[string]interface{}{}
+	}
+	return []map[string]interface{}{
+		{
+			"crn":       target.CRN,
+			"namespace": target.Namespace,
+			"name":      target.Name,
+		},
+	}
+}
+
+func resourceIBMIAMTrustedProfileLinkRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) < 2 {
+		return diag.FromErr(fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of profileID/linkID", d.Id()))
+	}
+
+	link, resp, err := getIAMTrustedProfileLink(context, iamIdentityClient, parts[0], parts[1])
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("Error retrieving trusted profile link: %s %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error retrieving trusted profile link: %s\n%s", err, resp))
+	}
+
+	d.Set("profile_id", parts[0])
+	d.Set("link_id", link.ID)
+	d.Set("cr_type", link.CrType)
+	d.Set("name", link.Name)
+	d.Set("link", flattenIAMTrustedProfileLinkTarget(link.Link))
+	d.Set("entity_tag", link.EntityTag)
+	d.Set("created_at", link.CreatedAt)
+	d.Set("modified_at", link.ModifiedAt)
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfileLinkDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := iamTrustedProfileRequest(context, iamIdentityClient, core.DELETE, iamTrustedProfileLinkPath(parts[0], parts[1]), nil, "", nil, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("Error deleting trusted profile link: %s %s", err, resp)
+		return diag.FromErr(fmt.Errorf("[ERROR] Error deleting trusted profile link: %s\n%s", err, resp))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMTrustedProfileLink_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_iam_trusted_profile_link.link"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckContainerCluster(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMTrustedProfileLinkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfileLinkBasic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cr_type", "IKS_SA"),
+					resource.TestCheckResourceAttr(resourceName, "link.0.namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "link.0.name", "terraform"),
+					resource.TestCheckResourceAttrPair(resourceName, "link.0.crn", "data.ibm_container_cluster.cluster", "crn"),
+					resource.TestCheckResourceAttrSet(resourceName, "link_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfileLinkDestroy(s *terraform.State) error {
+	iamIdentityClient, err := testAccProvider.Meta().(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_trusted_profile_link" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, resp, err := getIAMTrustedProfileLink(context.Background(), iamIdentityClient, parts[0], parts[1])
+		if err == nil {
+			return fmt.Errorf("Trusted profile link still exists: %s %s", rs.Primary.ID, resp)
+		} else if resp == nil || resp.StatusCode != 404 {
+			return fmt.Errorf("Error waiting for trusted profile link (%s) to be destroyed: %s %s", rs.Primary.ID, err, resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMTrustedProfileLinkBasic(name string) string {
+	return fmt.Sprintf(`
+		data "ibm_container_cluster" "cluster" {
+			cluster_name_id = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile" "profile" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile_link" "link" {
+			profile_id = ibm_iam_trusted_profile.profile.id
+			cr_type    = "IKS_SA"
+			name       = "%s"
+			link {
+				crn       = data.ibm_container_cluster.cluster.crn
+				namespace = "default"
+				name      = "terraform"
+			}
+		}
+	`, containerClusterName, name, name)
+}
@@ -0,0 +1,429 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMTrustedProfilePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMIAMTrustedProfilePolicyCreate,
+		Read:   resourceIBMIAMTrustedProfilePolicyRead,
+		Update: resourceIBMIAMTrustedProfilePolicyUpdate,
+		Delete: resourceIBMIAMTrustedProfilePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				resources, resourceAttributes, err := importTrustedProfilePolicy(d, meta)
+				if err != nil {
+					return nil, fmt.Errorf("[ERROR] Error reading resource ID: %s", err)
+				}
+				d.Set("resources", resources)
+				d.Set("resource_attributes", resourceAttributes)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"profile_id", "iam_id"},
+				Description:  "UUID of Trusted Profile",
+				ForceNew:     true,
+			},
+			"iam_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"profile_id", "iam_id"},
+				Description:  "IAM ID of Trusted Profile",
+				ForceNew:     true,
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Role names of the policy definition",
+			},
+
+			"resources": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"account_management", "resource_attributes"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Service name of the policy definition",
+						},
+
+						"resource_instance_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of resource instance of the policy definition",
+						},
+
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Region of the policy definition",
+						},
+
+						"resource_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource type of the policy definition",
+						},
+
+						"resource": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource of the policy definition",
+						},
+
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the resource group.",
+						},
+
+						"attributes": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Set resource attributes in the form of 'name=value,name=value....",
+							Elem:        schema.TypeString,
+						},
+					},
+				},
+			},
+
+			"resource_attributes": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Description:   "Set resource attributes.",
+				ConflictsWith: []string{"resources", "account_management"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of attribute.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value of attribute.",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "stringEquals",
+							Description: "Operator of attribute.",
+						},
+					},
+				},
+			},
+			"account_management": {
+				Type:          schema.TypeBool,
+				Default:       false,
+				Optional:      true,
+				Description:   "Give access to all account management services",
+				ConflictsWith: []string{"resources", "resource_attributes"},
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the Policy",
+			},
+		},
+	}
+}
+
+// getTrustedProfileIamID returns the IAM ID that is the subject of the policies of the trusted profile
+func getTrustedProfileIamID(d *schema.ResourceData, meta interface{}) (string, error) {
+	if v, ok := d.GetOk("iam_id"); ok && v != nil {
+		return v.(string), nil
+	}
+
+	iamClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return "", err
+	}
+	profile, resp, err := getIAMTrustedProfile(context.Background(), iamClient, d.Get("profile_id").(string))
+	if err != nil || profile.IamID == nil {
+		return "", fmt.Errorf("[ERROR] Error Getting Trusted Profile %s %s", err, resp)
+	}
+	return *profile.IamID, nil
+}
+
+func generateTrustedProfilePolicyOptions(d *schema.ResourceData, meta interface{}) (*iampolicymanagementv1.PolicySubject, iampolicymanagementv1.CreatePolicyOptions, *iampolicymanagementv1.PolicyResource, error) {
+	iamID, err := getTrustedProfileIamID(d, meta)
+	if err != nil {
+		return nil, iampolicymanagementv1.CreatePolicyOptions{}, nil, err
+	}
+
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, iampolicymanagementv1.CreatePolicyOptions{}, nil, err
+	}
+
+	policyOptions, err := generatePolicyOptions(d, meta)
+	if err != nil {
+		return nil, iampolicymanagementv1.CreatePolicyOptions{}, nil, err
+	}
+
+	policySubject := &iampolicymanagementv1.PolicySubject{
+		Attributes: []iampolicymanagementv1.SubjectAttribute{
+			{
+				Name:  core.StringPtr("iam_id"),
+				Value: &iamID,
+			},
+		},
+	}
+
+	accountIDResourceAttribute := iampolicymanagementv1.ResourceAttribute{
+		Name:     core.StringPtr("accountId"),
+		Value:    core.StringPtr(userDetails.userAccount),
+		Operator: core.StringPtr("stringEquals"),
+	}
+
+	policyResource := &iampolicymanagementv1.PolicyResource{
+		Attributes: append(policyOptions.Resources[0].Attributes, accountIDResourceAttribute),
+	}
+
+	return policySubject, policyOptions, policyResource, nil
+}
+
+func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	policySubject, policyOptions, policyResource, err := generateTrustedProfilePolicyOptions(d, meta)
+	if err != nil {
+		return err
+	}
+
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions(
+		"access",
+		[]iampolicymanagementv1.PolicySubject{*policySubject},
+		policyOptions.Roles,
+		[]iampolicymanagementv1.PolicyResource{*policyResource},
+	)
+
+	if desc, ok := d.GetOk("description"); ok {
+		des := desc.(string)
+		createPolicyOptions.Description = &des
+	}
+
+	profilePolicy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating trusted profile policy: %s %s", err, res)
+	}
+
+	subject := d.Get("profile_id").(string)
+	if v, ok := d.GetOk("iam_id"); ok && v != nil {
+		subject = v.(string)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", subject, *profilePolicy.ID))
+
+	return resourceIBMIAMTrustedProfilePolicyRead(d, meta)
+}
+
+func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of profileID(OR)iamID/PolicyID", d.Id())
+	}
+	profileIDUUID := parts[0]
+	profilePolicyID := parts[1]
+
+	profilePolicy := &iampolicymanagementv1.Policy{}
+	res := &core.DetailedResponse{}
+	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+		profilePolicyID,
+	)
+
+	// A new policy is not returned right away, the 404 is retried only while the policy is being created
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		var err error
+		profilePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		if err != nil || profilePolicy == nil {
+			if res != nil && res.StatusCode == 404 && d.IsNewResource() {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		profilePolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	}
+	if err != nil || profilePolicy == nil {
+		if res != nil && res.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s %s", err, res)
+	}
+	if profilePolicy.State != nil && *profilePolicy.State == "deleted" {
+		d.SetId("")
+		return nil
+	}
+
+	if strings.HasPrefix(profileIDUUID, "iam-") {
+		d.Set("iam_id", profileIDUUID)
+	} else {
+		d.Set("profile_id", profileIDUUID)
+	}
+
+	roles := make([]string, len(profilePolicy.Roles))
+	for i, role := range profilePolicy.Roles {
+		roles[i] = *role.DisplayName
+	}
+	d.Set("roles", roles)
+
+	if _, ok := d.GetOk("resources"); ok {
+		d.Set("resources", flattenPolicyResource(profilePolicy.Resources))
+	}
+	if _, ok := d.GetOk("resource_attributes"); ok {
+		d.Set("resource_attributes", flattenPolicyResourceAttributes(profilePolicy.Resources))
+	}
+	if len(profilePolicy.Resources) > 0 {
+		if *getResourceAttribute("serviceType", profilePolicy.Resources[0]) == "service" {
+			d.Set("account_management", false)
+		}
+		if *getResourceAttribute("serviceType", profilePolicy.Resources[0]) == "platform_service" {
+			d.Set("account_management", true)
+		}
+	}
+	if profilePolicy.Description != nil {
+		d.Set("description", *profilePolicy.Description)
+	}
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") {
+		parts, err := idParts(d.Id())
+		if err != nil {
+			return err
+		}
+		profilePolicyID := parts[1]
+
+		policySubject, policyOptions, policyResource, err := generateTrustedProfilePolicyOptions(d, meta)
+		if err != nil {
+			return err
+		}
+
+		iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+		if err != nil {
+			return err
+		}
+
+		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+			profilePolicyID,
+		)
+		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		if err != nil || policy == nil {
+			if response != nil && response.StatusCode == 404 {
+				return nil
+			}
+			return fmt.Errorf("[ERROR] Error retrieving Policy: %s\n%s", err, response)
+		}
+
+		profilePolicyETag := response.Headers.Get("ETag")
+		updatePolicyOptions := iamPolicyManagementClient.NewUpdatePolicyOptions(
+			profilePolicyID,
+			profilePolicyETag,
+			"access",
+			[]iampolicymanagementv1.PolicySubject{*policySubject},
+			policyOptions.Roles,
+			[]iampolicymanagementv1.PolicyResource{*policyResource},
+		)
+
+		if desc, ok := d.GetOk("description"); ok {
+			des := desc.(string)
+			updatePolicyOptions.Description = &des
+		}
+
+		_, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating trusted profile policy: %s", err)
+		}
+	}
+
+	return resourceIBMIAMTrustedProfilePolicyRead(d, meta)
+}
+
+func resourceIBMIAMTrustedProfilePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	profilePolicyID := parts[1]
+
+	deletePolicyOptions := iamPolicyManagementClient.NewDeletePolicyOptions(
+		profilePolicyID,
+	)
+
+	resp, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	if err != nil && (resp == nil || resp.StatusCode != 404) {
+		return fmt.Errorf("[ERROR] Error deleting trusted profile policy: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func importTrustedProfilePolicy(d *schema.ResourceData, meta interface{}) (interface{}, interface{}, error) {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, nil, err
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(parts) < 2 {
+		return nil, nil, fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of profileID(OR)iamID/PolicyID", d.Id())
+	}
+	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+		parts[1],
+	)
+	profilePolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	if err != nil {
+		return nil, nil, fmt.Errorf("[ERROR] Error retrieving trusted profile policy: %s", err)
+	}
+	resources := flattenPolicyResource(profilePolicy.Resources)
+	resourceAttributes := flattenPolicyResourceAttributes(profilePolicy.Resources)
+	return resources, resourceAttributes, nil
+}
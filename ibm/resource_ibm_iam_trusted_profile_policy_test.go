@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMTrustedProfilePolicy_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_iam_trusted_profile_policy.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMTrustedProfilePolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfilePolicyBasic(name, "Viewer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resources.0.service", "kms"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMTrustedProfilePolicyBasic(name, "Manager"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfilePolicyDestroy(s *terraform.State) error {
+	rsContClient, err := testAccProvider.Meta().(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_trusted_profile_policy" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		getPolicyOptions := rsContClient.NewGetPolicyOptions(
+			parts[1],
+		)
+
+		destroyedPolicy, response, err := rsContClient.GetPolicy(getPolicyOptions)
+		if err == nil && *destroyedPolicy.State != "deleted" {
+			return fmt.Errorf("Trusted profile policy still exists: %s\n", rs.Primary.ID)
+		} else if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("Error waiting for trusted profile policy (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMTrustedProfilePolicyBasic(name, role string) string {
+	roles := `["Viewer"]`
+	if role != "Viewer" {
+		roles = fmt.Sprintf(`["Viewer", "%s"]`, role)
+	}
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "profile" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile_policy" "policy" {
+			profile_id  = ibm_iam_trusted_profile.profile.id
+			roles       = %s
+			description = "IAM Trusted Profile Policy Creation for test scenario"
+			resources {
+				service = "kms"
+			}
+		}
+	`, name, roles)
+}
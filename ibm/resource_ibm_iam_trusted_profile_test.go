@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMTrustedProfile_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	updateName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_iam_trusted_profile.profile"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMTrustedProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMTrustedProfileBasic(name, "Profile for test scenario1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMTrustedProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "Profile for test scenario1"),
+					resource.TestCheckResourceAttrSet(resourceName, "iam_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMTrustedProfileBasic(updateName, "Profile for test scenario2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", updateName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Profile for test scenario2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfileDestroy(s *terraform.State) error {
+	iamIdentityClient, err := testAccProvider.Meta().(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_trusted_profile" {
+			continue
+		}
+
+		_, resp, err := getIAMTrustedProfile(context.Background(), iamIdentityClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Trusted profile still exists: %s %s", rs.Primary.ID, resp)
+		} else if resp == nil || resp.StatusCode != 404 {
+			return fmt.Errorf("Error waiting for trusted profile (%s) to be destroyed: %s %s", rs.Primary.ID, err, resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMTrustedProfileExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		iamIdentityClient, err := testAccProvider.Meta().(ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return err
+		}
+		_, resp, err := getIAMTrustedProfile(context.Background(), iamIdentityClient, rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error retrieving trusted profile: %s %s", err, resp)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMTrustedProfileBasic(name, description string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "profile" {
+			name        = "%s"
+			description = "%s"
+		}
+	`, name, description)
+}
@@ -22,6 +22,10 @@ func resourceIBMIAMUserPolicy() *schema.Resource {
 		Exists: resourceIBMIAMUserPolicyExists,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				parts, err := idParts(d.Id())
+				if err != nil || len(parts) < 2 {
+					return nil, fmt.Errorf("Incorrect ID %s: Id should be a combination of userEmail/PolicyID", d.Id())
+				}
 				resources, resourceAttributes, err := importServicePolicy(d, meta)
 				if err != nil {
 					return nil, fmt.Errorf("Error reading resource ID: %s", err)
@@ -145,6 +149,12 @@ func resourceIBMIAMUserPolicy() *schema.Resource {
 				Optional:    true,
 				Description: "Description of the Policy",
 			},
+
+			"rule_conditions": iamPolicyRuleConditionsSchema(),
+
+			"rule_operator": iamPolicyRuleOperatorSchema(),
+
+			"pattern": iamPolicyPatternSchema(),
 		},
 	}
 }
@@ -207,19 +217,15 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 		createPolicyOptions.Description = &des
 	}
 
-	userPolicy, _, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	userPolicy, _, err := createIAMPolicy(d, iamPolicyManagementClient, createPolicyOptions)
 
 	if err != nil {
 		return err
 	}
 
-	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
-		PolicyID: userPolicy.ID,
-	}
-
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, res, err := getIAMPolicy(d, meta, iamPolicyManagementClient, *userPolicy.ID)
 
 		if err != nil || policy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -231,7 +237,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 	})
 
 	if isResourceTimeoutError(err) {
-		_, _, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		_, _, _, err = getIAMPolicy(d, meta, iamPolicyManagementClient, *userPolicy.ID)
 	}
 	if err != nil {
 		d.SetId(fmt.Sprintf("%s/%s", userEmail, *userPolicy.ID))
@@ -260,14 +266,12 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
-		PolicyID: core.StringPtr(userPolicyID),
-	}
 	userPolicy := &iampolicymanagementv1.Policy{}
+	var userPolicyV2 *iamPolicyV2
 	res := &core.DetailedResponse{}
 	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
 		var err error
-		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		userPolicy, userPolicyV2, res, err = getIAMPolicy(d, meta, iamPolicyManagementClient, userPolicyID)
 
 		if err != nil || userPolicy == nil {
 			if res != nil && res.StatusCode == 404 {
@@ -279,7 +283,7 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	})
 
 	if isResourceTimeoutError(err) {
-		userPolicy, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		userPolicy, userPolicyV2, res, err = getIAMPolicy(d, meta, iamPolicyManagementClient, userPolicyID)
 	}
 	if err != nil || userPolicy == nil {
 		return fmt.Errorf("Error retrieving userPolicy: %s %s", err, res)
@@ -290,7 +294,7 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	for i, role := range userPolicy.Roles {
 		roles[i] = *role.DisplayName
 	}
-	d.Set("roles", roles)
+	d.Set("roles", orderIAMPolicyRoles(d.Get("roles").([]interface{}), roles))
 	if _, ok := d.GetOk("resources"); ok {
 		d.Set("resources", flattenPolicyResource(userPolicy.Resources))
 	}
@@ -308,6 +312,7 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 	if userPolicy.Description != nil {
 		d.Set("description", *userPolicy.Description)
 	}
+	setIAMPolicyRule(d, userPolicyV2)
 	return nil
 }
 
@@ -316,7 +321,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return err
 	}
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 		parts, err := idParts(d.Id())
 		if err != nil {
 			return err
@@ -359,10 +364,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			Attributes: []iampolicymanagementv1.SubjectAttribute{*subjectAttribute},
 		}
 
-		getPolicyOptions := &iampolicymanagementv1.GetPolicyOptions{
-			PolicyID: &userPolicyID,
-		}
-		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		policy, _, response, err := getIAMPolicy(d, meta, iamPolicyManagementClient, userPolicyID)
 		if err != nil || policy == nil {
 			if response != nil && response.StatusCode == 404 {
 				return nil
@@ -385,7 +387,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 			updatePolicyOptions.Description = &des
 		}
 
-		policy, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		_, err = updateIAMPolicy(d, iamPolicyManagementClient, updatePolicyOptions)
 		if err != nil {
 			return fmt.Errorf("Error updating user policy: %s", err)
 		}
@@ -406,10 +408,7 @@ func resourceIBMIAMUserPolicyDelete(d *schema.ResourceData, meta interface{}) er
 	}
 	userPolicyID := parts[1]
 
-	deletePolicyOptions := iamPolicyManagementClient.NewDeletePolicyOptions(
-		userPolicyID,
-	)
-	_, err = iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	_, err = deleteIAMPolicy(d, iamPolicyManagementClient, userPolicyID)
 	if err != nil {
 		return err
 	}
@@ -433,11 +432,7 @@ func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (b
 	userEmail := parts[0]
 	userPolicyID := parts[1]
 
-	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
-		userPolicyID,
-	)
-
-	userPolicy, resp, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	userPolicy, _, resp, err := getIAMPolicy(d, meta, iamPolicyManagementClient, userPolicyID)
 	if err != nil || userPolicy == nil {
 		if resp != nil && resp.StatusCode == 404 {
 			return false, nil
@@ -466,10 +461,7 @@ func importUserPolicy(d *schema.ResourceData, meta interface{}) (interface{}, in
 	}
 	userPolicyID := parts[1]
 
-	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
-		userPolicyID,
-	)
-	userPolicy, _, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	userPolicy, _, _, err := getIAMPolicy(d, meta, iamPolicyManagementClient, userPolicyID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("Error retrieving User Policy: %s", err)
 	}
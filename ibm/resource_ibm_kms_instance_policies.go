@@ -0,0 +1,359 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsInstancePolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsInstancePoliciesCreate,
+		ReadContext:   resourceIBMKmsInstancePoliciesRead,
+		UpdateContext: resourceIBMKmsInstancePoliciesUpdate,
+		DeleteContext: resourceIBMKmsInstancePoliciesDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+			},
+			"dual_auth_delete": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the dual authorization delete policy of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, a second user has to authorize the deletion of any key of the instance",
+						},
+					},
+				},
+			},
+			"allowed_network": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the allowed network policy of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, the instance is only reachable from the allowed network",
+						},
+						"network": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "public-and-private",
+							ValidateFunc: validateAllowedStringValue([]string{"public-and-private", "private-only"}),
+							Description:  "The network the instance is reachable from, public-and-private or private-only",
+						},
+					},
+				},
+			},
+			"allowed_ip": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the allowed IP policy of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, the instance is only reachable from the allowed IP addresses",
+						},
+						"ip_addresses": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The IP addresses and subnets in CIDR notation the instance is reachable from",
+						},
+					},
+				},
+			},
+			"metrics": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the metrics policy of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, operational metrics of the instance are sent to the monitoring instance of the region",
+						},
+					},
+				},
+			},
+			"key_create_import_access": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the key create and import access policy of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, only the allowed types of keys can be created or imported",
+						},
+						"create_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to false, root keys can't be created",
+						},
+						"create_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to false, standard keys can't be created",
+						},
+						"import_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to false, root keys can't be imported",
+						},
+						"import_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to false, standard keys can't be imported",
+						},
+						"enforce_token": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If set to true, keys can only be imported with an import token",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var kmsInstancePolicyTypes = []string{"dual_auth_delete", "allowed_network", "allowed_ip", "metrics", "key_create_import_access"}
+
+func resourceIBMKmsInstancePoliciesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	kpAPI, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := resourceHandleInstancePolicies(context, d, kpAPI); err != nil {
+		return diag.Errorf("Error while setting the policies of instance %s: %s", instanceID, err)
+	}
+	d.SetId(instanceID)
+
+	return resourceIBMKmsInstancePoliciesRead(context, d, meta)
+}
+
+func resourceIBMKmsInstancePoliciesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+	endpointType := d.Get("endpoint_type").(string)
+	if endpointType == "" {
+		endpointType = "public"
+	}
+	kpAPI, err := kmsInstanceAPI(meta, instanceID, endpointType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policies, err := kpAPI.GetInstancePolicies(context)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Failed to read the policies of instance %s: %s", instanceID, err)
+	}
+
+	for _, policy := range policies {
+		enabled := policy.PolicyData.Enabled != nil && *policy.PolicyData.Enabled
+		attributes := policy.PolicyData.Attributes
+		if attributes == nil {
+			attributes = &kp.Attributes{}
+		}
+		switch policy.PolicyType {
+		case kp.DualAuthDelete:
+			d.Set("dual_auth_delete", []map[string]interface{}{{"enabled": enabled}})
+		case kp.AllowedNetwork:
+			network := "public-and-private"
+			if attributes.AllowedNetwork != nil {
+				network = *attributes.AllowedNetwork
+			}
+			d.Set("allowed_network", []map[string]interface{}{{"enabled": enabled, "network": network}})
+		case kp.AllowedIP:
+			d.Set("allowed_ip", []map[string]interface{}{{"enabled": enabled, "ip_addresses": flattenStringList(attributes.AllowedIP)}})
+		case kp.Metrics:
+			d.Set("metrics", []map[string]interface{}{{"enabled": enabled}})
+		case kp.KeyCreateImportAccess:
+			d.Set("key_create_import_access", []map[string]interface{}{{
+				"enabled":             enabled,
+				"create_root_key":     kmsPolicyAttribute(attributes.CreateRootKey, true),
+				"create_standard_key": kmsPolicyAttribute(attributes.CreateStandardKey, true),
+				"import_root_key":     kmsPolicyAttribute(attributes.ImportRootKey, true),
+				"import_standard_key": kmsPolicyAttribute(attributes.ImportStandardKey, true),
+				"enforce_token":       kmsPolicyAttribute(attributes.EnforceToken, false),
+			}})
+		}
+	}
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", endpointType)
+
+	return nil
+}
+
+func resourceIBMKmsInstancePoliciesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+	kpAPI, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := resourceHandleInstancePolicies(context, d, kpAPI); err != nil {
+		return diag.Errorf("Error while updating the policies of instance %s: %s", instanceID, err)
+	}
+
+	return resourceIBMKmsInstancePoliciesRead(context, d, meta)
+}
+
+// resourceIBMKmsInstancePoliciesDelete disables the policies, instance policies can't be removed
+func resourceIBMKmsInstancePoliciesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+	kpAPI, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policies := kp.MultiplePolicies{}
+	if kmsPolicyEnabled(d, "dual_auth_delete") {
+		policies.DualAuthDelete = &kp.BasicPolicyData{Enabled: false}
+	}
+	if kmsPolicyEnabled(d, "allowed_network") {
+		policies.AllowedNetwork = &kp.AllowedNetworkPolicyData{Enabled: false, Network: "public-and-private"}
+	}
+	if kmsPolicyEnabled(d, "allowed_ip") {
+		policies.AllowedIP = &kp.AllowedIPPolicyData{Enabled: false}
+	}
+	if kmsPolicyEnabled(d, "metrics") {
+		policies.Metrics = &kp.BasicPolicyData{Enabled: false}
+	}
+	if kmsPolicyEnabled(d, "key_create_import_access") {
+		policies.KeyCreateImportAccess = &kp.KeyCreateImportAccessInstancePolicy{Enabled: false}
+	}
+	if policies != (kp.MultiplePolicies{}) {
+		if err := kpAPI.SetInstancePolicies(context, policies); err != nil {
+			if kpError, ok := err.(*kp.Error); !ok || kpError.StatusCode != 404 {
+				return diag.Errorf("Error while disabling the policies of instance %s: %s", instanceID, err)
+			}
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceHandleInstancePolicies sets the policies that changed. The key create and import access policy
+// is set on its own, SetInstancePolicies leaves out its attributes that are false.
+func resourceHandleInstancePolicies(context context.Context, d *schema.ResourceData, kpAPI *kp.Client) error {
+	policies := kp.MultiplePolicies{}
+	if policy, ok := kmsPolicyChange(d, "dual_auth_delete"); ok {
+		policies.DualAuthDelete = &kp.BasicPolicyData{Enabled: policy["enabled"].(bool)}
+	}
+	if policy, ok := kmsPolicyChange(d, "allowed_network"); ok {
+		policies.AllowedNetwork = &kp.AllowedNetworkPolicyData{
+			Enabled: policy["enabled"].(bool),
+			Network: policy["network"].(string),
+		}
+	}
+	if policy, ok := kmsPolicyChange(d, "allowed_ip"); ok {
+		policies.AllowedIP = &kp.AllowedIPPolicyData{
+			Enabled:     policy["enabled"].(bool),
+			IPAddresses: kp.IPAddresses(expandStringList(policy["ip_addresses"].(*schema.Set).List())),
+		}
+	}
+	if policy, ok := kmsPolicyChange(d, "metrics"); ok {
+		policies.Metrics = &kp.BasicPolicyData{Enabled: policy["enabled"].(bool)}
+	}
+	if policies != (kp.MultiplePolicies{}) {
+		if err := kpAPI.SetInstancePolicies(context, policies); err != nil {
+			return err
+		}
+	}
+
+	if policy, ok := kmsPolicyChange(d, "key_create_import_access"); ok {
+		attributes := map[string]bool{
+			kp.CreateRootKey:     policy["create_root_key"].(bool),
+			kp.CreateStandardKey: policy["create_standard_key"].(bool),
+			kp.ImportRootKey:     policy["import_root_key"].(bool),
+			kp.ImportStandardKey: policy["import_standard_key"].(bool),
+			kp.EnforceToken:      policy["enforce_token"].(bool),
+		}
+		if err := kpAPI.SetKeyCreateImportAccessInstancePolicy(context, policy["enabled"].(bool), attributes); err != nil {
+			return fmt.Errorf("key create and import access policy: %s", err)
+		}
+	}
+	return nil
+}
+
+// kmsPolicyChange returns the configured policy of the type when it changed
+func kmsPolicyChange(d *schema.ResourceData, policyType string) (map[string]interface{}, bool) {
+	if !d.HasChange(policyType) {
+		return nil, false
+	}
+	policy, ok := d.GetOk(policyType)
+	if !ok || len(policy.([]interface{})) == 0 || policy.([]interface{})[0] == nil {
+		return nil, false
+	}
+	return policy.([]interface{})[0].(map[string]interface{}), true
+}
+
+func kmsPolicyEnabled(d *schema.ResourceData, policyType string) bool {
+	policy := d.Get(policyType).([]interface{})
+	return len(policy) > 0 && policy[0] != nil && policy[0].(map[string]interface{})["enabled"].(bool)
+}
+
+func kmsPolicyAttribute(attribute *bool, defaultValue bool) bool {
+	if attribute == nil {
+		return defaultValue
+	}
+	return *attribute
+}
@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSInstancePolicies_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "dual_auth_delete.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "metrics.0.enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "allowed_ip.0.ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "key_create_import_access.0.import_standard_key", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "dual_auth_delete.0.enabled", "false"),
+					resource.TestCheckResourceAttr("ibm_kms_instance_policies.test", "key_create_import_access.0.import_standard_key", "true"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_kms_instance_policies.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsInstancePoliciesConfig(instanceName string, dualAuthDelete, importStandardKey bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_kms_instance_policies" "test" {
+		instance_id = ibm_resource_instance.kp_instance.guid
+		dual_auth_delete {
+			enabled = %t
+		}
+		metrics {
+			enabled = true
+		}
+		allowed_network {
+			enabled = true
+			network = "public-and-private"
+		}
+		allowed_ip {
+			enabled      = true
+			ip_addresses = ["0.0.0.0/0", "::/0"]
+		}
+		key_create_import_access {
+			enabled             = true
+			import_standard_key = %t
+		}
+	}
+`, instanceName, dualAuthDelete, importStandardKey)
+}
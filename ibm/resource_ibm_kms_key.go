@@ -16,6 +16,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	kmsKeyStateActive    = 1
+	kmsKeyStateSuspended = 2
+)
+
 func resourceIBMKmskey() *schema.Resource {
 	return &schema.Resource{
 		Create:   resourceIBMKmsKeyCreate,
@@ -45,8 +50,10 @@ func resourceIBMKmskey() *schema.Resource {
 			},
 			"key_id": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
-				Description: "Key ID",
+				ForceNew:    true,
+				Description: "Key ID. Set it to the ID of a deleted key to restore the key instead of creating a new one",
 			},
 			"key_name": {
 				Type:        schema.TypeString,
@@ -104,6 +111,58 @@ func resourceIBMKmskey() *schema.Resource {
 				Computed:    true,
 				Description: "Crn of the key",
 			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Set to false to disable the key, key operations cannot be performed with a disabled key",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any change of the value rotates the root key on demand",
+			},
+			"rotation_payload": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The new key material of an imported root key that is rotated",
+			},
+			"set_for_deletion": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set to true to authorize the deletion of a key with a dual authorization delete policy, a second user can then delete the key. Set to false to cancel the authorization",
+			},
+			"last_rotate_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the key material was last rotated. The date format follows RFC 3339.",
+			},
+			"key_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the current version of the key material",
+			},
+			"key_versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions of the key material of a root key, every rotation adds a version",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key version",
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the key version was created. The date format follows RFC 3339.",
+						},
+					},
+				},
+			},
 			"expiration_date": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -328,7 +387,15 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	var keyCRN string
-	if standardKey {
+	if v, ok := d.GetOk("key_id"); ok {
+		key, err := restoreKmsKey(d, kpAPI, meta, v.(string))
+		if err != nil {
+			return fmt.Errorf(
+				"Error while restoring key %s: %s", v.(string), err)
+		}
+		keyCRN = key.CRN
+		d.SetId(keyCRN)
+	} else if standardKey {
 		if v, ok := d.GetOk("payload"); ok {
 			//import standard key
 			payload := v.(string)
@@ -466,6 +533,28 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("type", instanceType)
 	d.Set("force_delete", d.Get("force_delete").(bool))
 	d.Set("key_ring_id", key.KeyRingID)
+	d.Set("enabled", key.State != kmsKeyStateSuspended)
+	if key.LastRotateDate != nil {
+		d.Set("last_rotate_date", key.LastRotateDate.Format(time.RFC3339))
+	} else {
+		d.Set("last_rotate_date", "")
+	}
+	if key.KeyVersion != nil {
+		d.Set("key_version_id", key.KeyVersion.ID)
+	}
+	if !key.Extractable {
+		kmsKeysClient, err := meta.(ClientSession).keyManagementV2API()
+		if err != nil {
+			return err
+		}
+		// The key versions are informational, a key can still be managed when they can't be listed
+		versions, err := kmsKeysClient.ListKeyVersions(context.Background(), kpAPI, keyid)
+		if err != nil {
+			log.Printf("[WARN] Failed to read the versions of key %s: %s", keyid, err)
+		} else {
+			d.Set("key_versions", flattenKmsKeyVersions(versions))
+		}
+	}
 	if key.Expiration != nil {
 		expiration := key.Expiration
 		d.Set("expiration_date", expiration.Format(time.RFC3339))
@@ -494,7 +583,8 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
-	if d.HasChange("policies") {
+	rotate := d.HasChange("rotation_trigger") && !d.IsNewResource()
+	if d.HasChange("policies") || d.HasChange("enabled") || d.HasChange("set_for_deletion") || rotate {
 
 		kpAPI, err := meta.(ClientSession).keyManagementAPI()
 		if err != nil {
@@ -561,9 +651,41 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		crnData = strings.Split(crn, ":")
 		key_id := crnData[len(crnData)-1]
 
-		err = handlePolicies(d, kpAPI, meta, key_id)
-		if err != nil {
-			return fmt.Errorf("Could not update policies: %s", err)
+		if d.HasChange("policies") {
+			err = handlePolicies(d, kpAPI, meta, key_id)
+			if err != nil {
+				return fmt.Errorf("Could not update policies: %s", err)
+			}
+		}
+
+		enabled := d.Get("enabled").(bool)
+		// A disabled key can't be rotated, it is enabled first and disabled last
+		if d.HasChange("enabled") && enabled {
+			if err := setKmsKeyEnabled(kpAPI, key_id, enabled); err != nil {
+				return fmt.Errorf("Error while enabling key %s: %s", key_id, err)
+			}
+		}
+		if rotate {
+			err = kpAPI.Rotate(context.Background(), key_id, d.Get("rotation_payload").(string))
+			if err != nil {
+				return fmt.Errorf("Error while rotating key %s: %s", key_id, err)
+			}
+		}
+		if d.HasChange("enabled") && !enabled {
+			if err := setKmsKeyEnabled(kpAPI, key_id, enabled); err != nil {
+				return fmt.Errorf("Error while disabling key %s: %s", key_id, err)
+			}
+		}
+
+		if d.HasChange("set_for_deletion") {
+			if d.Get("set_for_deletion").(bool) {
+				err = kpAPI.InitiateDualAuthDelete(context.Background(), key_id)
+			} else if !d.IsNewResource() {
+				err = kpAPI.CancelDualAuthDelete(context.Background(), key_id)
+			}
+			if err != nil {
+				return fmt.Errorf("Error while updating the deletion authorization of key %s: %s", key_id, err)
+			}
 		}
 	}
 	return resourceIBMKmsKeyRead(d, meta)
@@ -626,6 +748,15 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 
 	_, err1 := kpAPI.DeleteKey(context.Background(), keyid, kp.ReturnRepresentation, f)
 	if err1 != nil {
+		// Registrations are the usual reason a root key can't be deleted, name the resources that use it
+		if regs, err := kpAPI.ListRegistrations(context.Background(), keyid, ""); err == nil && len(regs.Registrations) > 0 {
+			resources := make([]string, 0, len(regs.Registrations))
+			for _, r := range regs.Registrations {
+				resources = append(resources, r.ResourceCrn)
+			}
+			return fmt.Errorf(
+				"Error while deleting: %s, the key is registered with the resources %s", err1, strings.Join(resources, ", "))
+		}
 		return fmt.Errorf(
 			"Error while deleting: %s", err1)
 	}
@@ -728,3 +859,51 @@ func handlePolicies(d *schema.ResourceData, kpAPI *kp.Client, meta interface{},
 	}
 	return nil
 }
+
+// restoreKmsKey restores a deleted key, the payload, encrypted_nonce and iv_value of the key resource are
+// the backup of the key material that imported keys are restored with
+func restoreKmsKey(d *schema.ResourceData, kpAPI *kp.Client, meta interface{}, keyID string) (*kp.Key, error) {
+	payload := d.Get("payload").(string)
+	if payload == "" {
+		return kpAPI.RestoreKey(context.Background(), keyID)
+	}
+	kmsKeysClient, err := meta.(ClientSession).keyManagementV2API()
+	if err != nil {
+		return nil, err
+	}
+	material := kmsRestoreKeyMaterial{
+		Payload:        payload,
+		EncryptedNonce: d.Get("encrypted_nonce").(string),
+		IV:             d.Get("iv_value").(string),
+	}
+	return kmsKeysClient.RestoreKey(context.Background(), kpAPI, keyID, material)
+}
+
+// setKmsKeyEnabled enables a suspended key or disables an active key, keys in other states are left as they are
+func setKmsKeyEnabled(kpAPI *kp.Client, keyID string, enabled bool) error {
+	key, err := kpAPI.GetKey(context.Background(), keyID)
+	if err != nil {
+		return err
+	}
+	if enabled && key.State == kmsKeyStateSuspended {
+		return kpAPI.EnableKey(context.Background(), keyID)
+	}
+	if !enabled && key.State == kmsKeyStateActive {
+		return kpAPI.DisableKey(context.Background(), keyID)
+	}
+	return nil
+}
+
+func flattenKmsKeyVersions(versions []kmsKeyVersion) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(versions))
+	for _, v := range versions {
+		version := map[string]interface{}{
+			"id": v.ID,
+		}
+		if v.CreationDate != nil {
+			version["creation_date"] = v.CreationDate.Format(time.RFC3339)
+		}
+		result = append(result, version)
+	}
+	return result
+}
@@ -209,6 +209,38 @@ func TestAccIBMKMSKeyPolicy_invalid_interval(t *testing.T) {
 	})
 }
 
+func TestAccIBMKMSResource_Key_Lifecycle(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMKmsKeyLifecycleConfig(instanceName, keyName, "1", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_versions.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMKmsKeyLifecycleConfig(instanceName, keyName, "2", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_versions.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key.test", "last_rotate_date"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMKmsKeyLifecycleConfig(instanceName, keyName, "2", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "resource_status", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMKmsResourceStandardConfig(instanceName, KeyName string) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "kms_instance" {
@@ -407,3 +439,23 @@ func testAccCheckIBMKmsKeyPolicyDualAuth(instanceName, KeyName string, dual_auth
 	  }
 `, instanceName, KeyName, dual_auth_delete)
 }
+
+func testAccCheckIBMKmsKeyLifecycleConfig(instanceName, KeyName, rotationTrigger string, enabled bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	  }
+
+	  resource "ibm_kms_key" "test" {
+		instance_id      = ibm_resource_instance.kp_instance.guid
+		key_name         = "%s"
+		standard_key     = false
+		rotation_trigger = "%s"
+		enabled          = %t
+		force_delete     = true
+	  }
+`, instanceName, KeyName, rotationTrigger, enabled)
+}
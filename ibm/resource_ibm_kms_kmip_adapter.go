@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsKMIPAdapter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKMIPAdapterCreate,
+		ReadContext:   resourceIBMKmsKMIPAdapterRead,
+		DeleteContext: resourceIBMKmsKMIPAdapterDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the KMIP adapter, it is generated when it is not set",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the KMIP adapter",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     kmsKMIPAdapterProfileNative,
+				Description: "The profile of the KMIP adapter",
+			},
+			"profile_data": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The data of the profile, the native_1.0 profile needs the ID of the root key that wraps the KMIP objects in crk_id",
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the KMIP adapter",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for the resource that created the adapter",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the adapter was created. The date format follows RFC 3339.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for the resource that updated the adapter",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the adapter was last updated. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKMIPAdapterCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kmsClient, err := meta.(ClientSession).keyManagementV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID := d.Get("instance_id").(string)
+	kpAPI, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	adapter := kmsKMIPAdapter{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Profile:     d.Get("profile").(string),
+	}
+	if v, ok := d.GetOk("profile_data"); ok {
+		profileData := v.(map[string]interface{})
+		if crkID, ok := profileData["crk_id"]; ok {
+			adapter.ProfileData = &kmsKMIPProfileData{CrkID: crkID.(string)}
+		}
+	}
+
+	created, err := kmsClient.CreateKMIPAdapter(context, kpAPI, adapter)
+	if err != nil {
+		return diag.Errorf("Error while creating KMIP adapter: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, created.ID))
+
+	return resourceIBMKmsKMIPAdapterRead(context, d, meta)
+}
+
+func resourceIBMKmsKMIPAdapterRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kmsClient, err := meta.(ClientSession).keyManagementV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil || len(parts) != 2 {
+		return diag.Errorf("Incorrect ID %s: Id should be a combination of instanceID/adapterID", d.Id())
+	}
+	instanceID, adapterID := parts[0], parts[1]
+	endpointType := d.Get("endpoint_type").(string)
+	if endpointType == "" {
+		endpointType = "public"
+	}
+	kpAPI, err := kmsInstanceAPI(meta, instanceID, endpointType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	adapter, response, err := kmsClient.GetKMIPAdapter(context, kpAPI, adapterID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error while reading KMIP adapter %s: %s", adapterID, err)
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", endpointType)
+	d.Set("adapter_id", adapter.ID)
+	d.Set("name", adapter.Name)
+	d.Set("description", adapter.Description)
+	d.Set("profile", adapter.Profile)
+	if adapter.ProfileData != nil && adapter.ProfileData.CrkID != "" {
+		d.Set("profile_data", map[string]interface{}{"crk_id": adapter.ProfileData.CrkID})
+	}
+	d.Set("created_by", adapter.CreatedBy)
+	d.Set("updated_by", adapter.UpdatedBy)
+	if adapter.CreatedAt != nil {
+		d.Set("created_at", adapter.CreatedAt.Format(time.RFC3339))
+	}
+	if adapter.UpdatedAt != nil {
+		d.Set("updated_at", adapter.UpdatedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceIBMKmsKMIPAdapterDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kmsClient, err := meta.(ClientSession).keyManagementV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil || len(parts) != 2 {
+		return diag.Errorf("Incorrect ID %s: Id should be a combination of instanceID/adapterID", d.Id())
+	}
+	instanceID, adapterID := parts[0], parts[1]
+	kpAPI, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := kmsClient.DeleteKMIPAdapter(context, kpAPI, adapterID)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.Errorf("Error while deleting KMIP adapter %s: %s", adapterID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
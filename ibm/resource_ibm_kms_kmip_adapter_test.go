@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKMIPAdapter_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	adapterName := fmt.Sprintf("tf-testacc-kmip-%d", acctest.RandIntRange(10, 100))
+	certificate := testAccSatelliteEndpointSelfSignedCert(t, "kmip-client.example.com")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMKmsKMIPAdapterConfig(instanceName, keyName, adapterName, certificate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_kmip_adapter.test", "name", adapterName),
+					resource.TestCheckResourceAttr("ibm_kms_kmip_adapter.test", "profile", "native_1.0"),
+					resource.TestCheckResourceAttrPair("ibm_kms_kmip_adapter.test", "profile_data.crk_id", "ibm_kms_key.test", "key_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_kmip_adapter.test", "adapter_id"),
+					resource.TestCheckResourceAttrSet("ibm_kms_kmip_client_cert.test", "cert_id"),
+					resource.TestCheckResourceAttr("ibm_kms_kmip_client_cert.test", "name", adapterName+"-client"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_kms_kmip_adapter.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_kms_kmip_client_cert.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKMIPAdapterConfig(instanceName, keyName, adapterName, certificate string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kp_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kp_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_kmip_adapter" "test" {
+		instance_id  = ibm_resource_instance.kp_instance.guid
+		name         = "%s"
+		description  = "storage arrays"
+		profile_data = {
+			crk_id = ibm_kms_key.test.key_id
+		}
+	}
+	resource "ibm_kms_kmip_client_cert" "test" {
+		instance_id = ibm_resource_instance.kp_instance.guid
+		adapter_id  = ibm_kms_kmip_adapter.test.adapter_id
+		name        = "${ibm_kms_kmip_adapter.test.name}-client"
+		certificate = <<EOT
+%sEOT
+	}
+`, instanceName, keyName, adapterName, certificate)
+}
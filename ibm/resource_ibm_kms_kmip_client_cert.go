@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsKMIPClientCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKMIPClientCertCreate,
+		ReadContext:   resourceIBMKmsKMIPClientCertRead,
+		DeleteContext: resourceIBMKmsKMIPClientCertDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the KMIP adapter the certificate authenticates clients of",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+			},
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressKMIPCertificateDiff,
+				Description:      "The PEM encoded client certificate",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the client certificate, it is generated when it is not set",
+			},
+			"cert_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the client certificate",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for the resource that created the certificate",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the certificate was created. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+// suppressKMIPCertificateDiff ignores the surrounding white space that the service trims from certificates
+func suppressKMIPCertificateDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
+func resourceIBMKmsKMIPClientCertCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kmsClient, err := meta.(ClientSession).keyManagementV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID := d.Get("instance_id").(string)
+	adapterID := d.Get("adapter_id").(string)
+	kpAPI, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cert := kmsKMIPClientCert{
+		Name:        d.Get("name").(string),
+		Certificate: d.Get("certificate").(string),
+	}
+	created, err := kmsClient.CreateKMIPClientCert(context, kpAPI, adapterID, cert)
+	if err != nil {
+		return diag.Errorf("Error while creating the client certificate of KMIP adapter %s: %s", adapterID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, adapterID, created.ID))
+
+	return resourceIBMKmsKMIPClientCertRead(context, d, meta)
+}
+
+func resourceIBMKmsKMIPClientCertRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kmsClient, err := meta.(ClientSession).keyManagementV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil || len(parts) != 3 {
+		return diag.Errorf("Incorrect ID %s: Id should be a combination of instanceID/adapterID/certID", d.Id())
+	}
+	instanceID, adapterID, certID := parts[0], parts[1], parts[2]
+	endpointType := d.Get("endpoint_type").(string)
+	if endpointType == "" {
+		endpointType = "public"
+	}
+	kpAPI, err := kmsInstanceAPI(meta, instanceID, endpointType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cert, response, err := kmsClient.GetKMIPClientCert(context, kpAPI, adapterID, certID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error while reading the client certificate %s of KMIP adapter %s: %s", certID, adapterID, err)
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("adapter_id", adapterID)
+	d.Set("endpoint_type", endpointType)
+	d.Set("cert_id", cert.ID)
+	d.Set("name", cert.Name)
+	d.Set("certificate", cert.Certificate)
+	d.Set("created_by", cert.CreatedBy)
+	if cert.CreatedAt != nil {
+		d.Set("created_at", cert.CreatedAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceIBMKmsKMIPClientCertDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kmsClient, err := meta.(ClientSession).keyManagementV2API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	parts, err := idParts(d.Id())
+	if err != nil || len(parts) != 3 {
+		return diag.Errorf("Incorrect ID %s: Id should be a combination of instanceID/adapterID/certID", d.Id())
+	}
+	instanceID, adapterID, certID := parts[0], parts[1], parts[2]
+	kpAPI, err := kmsInstanceAPI(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := kmsClient.DeleteKMIPClientCert(context, kpAPI, adapterID, certID)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.Errorf("Error while deleting the client certificate %s of KMIP adapter %s: %s", certID, adapterID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMObLoggingArchive() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMObLoggingArchiveCreate,
+		ReadContext:   resourceIBMObLoggingArchiveRead,
+		UpdateContext: resourceIBMObLoggingArchiveUpdate,
+		DeleteContext: resourceIBMObLoggingArchiveDelete,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID or CRN of the Log Analysis instance whose logs are archived",
+			},
+			"service_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "A service key of the Log Analysis instance",
+			},
+			"private_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the configuration API of the Log Analysis instance is called through the private endpoint",
+			},
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the Cloud Object Storage bucket the logs are archived to",
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The endpoint of the Cloud Object Storage bucket",
+			},
+			"cos_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the Cloud Object Storage instance of the bucket",
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The API key of a service ID with writer access to the bucket",
+			},
+		},
+	}
+}
+
+func expandObLoggingArchiving(d *schema.ResourceData) obLoggingArchiving {
+	return obLoggingArchiving{
+		Integration:        "ibm",
+		Bucket:             d.Get("bucket").(string),
+		Endpoint:           d.Get("endpoint").(string),
+		APIKey:             d.Get("api_key").(string),
+		ResourceInstanceID: d.Get("cos_instance_id").(string),
+	}
+}
+
+func resourceIBMObLoggingArchiveCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	obClient, err := obLoggingAPI(context, meta, instanceID, d.Get("service_key").(string), d.Get("private_endpoint").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err := obClient.CreateArchiving(context, expandObLoggingArchiving(d)); err != nil {
+		return diag.Errorf("Error configuring archiving of Log Analysis instance %s: %s", instanceID, err)
+	}
+	d.SetId(instanceID)
+
+	return resourceIBMObLoggingArchiveRead(context, d, meta)
+}
+
+func resourceIBMObLoggingArchiveRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+	obClient, err := obLoggingAPI(context, meta, instanceID, d.Get("service_key").(string), d.Get("private_endpoint").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	archiving, response, err := obClient.GetArchiving(context)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading archiving of Log Analysis instance %s: %s", instanceID, err)
+	}
+	d.Set("instance_id", instanceID)
+	d.Set("bucket", archiving.Bucket)
+	d.Set("endpoint", archiving.Endpoint)
+	d.Set("cos_instance_id", archiving.ResourceInstanceID)
+
+	return nil
+}
+
+func resourceIBMObLoggingArchiveUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+	obClient, err := obLoggingAPI(context, meta, instanceID, d.Get("service_key").(string), d.Get("private_endpoint").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("bucket", "endpoint", "cos_instance_id", "api_key") {
+		if _, err := obClient.UpdateArchiving(context, expandObLoggingArchiving(d)); err != nil {
+			return diag.Errorf("Error updating archiving of Log Analysis instance %s: %s", instanceID, err)
+		}
+	}
+
+	return resourceIBMObLoggingArchiveRead(context, d, meta)
+}
+
+func resourceIBMObLoggingArchiveDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+	obClient, err := obLoggingAPI(context, meta, instanceID, d.Get("service_key").(string), d.Get("private_endpoint").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := obClient.DeleteArchiving(context)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.Errorf("Error deleting archiving of Log Analysis instance %s: %s", instanceID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
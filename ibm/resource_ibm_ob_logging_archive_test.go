@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMObLoggingArchive_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-logging-archive-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMObLoggingArchiveConfig(name, "ibm_cos_bucket.bucket1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ibm_ob_logging_archive.archive", "bucket", "ibm_cos_bucket.bucket1", "bucket_name"),
+					resource.TestCheckResourceAttrPair("ibm_ob_logging_archive.archive", "cos_instance_id", "ibm_resource_instance.cos", "id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMObLoggingArchiveConfig(name, "ibm_cos_bucket.bucket2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ibm_ob_logging_archive.archive", "bucket", "ibm_cos_bucket.bucket2", "bucket_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMObLoggingArchiveConfig(name, bucket string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "group" {
+		is_default = true
+	}
+
+	resource "ibm_resource_instance" "logging" {
+		name              = "%[1]s"
+		service           = "logdna"
+		plan              = "7-day"
+		location          = "us-south"
+		resource_group_id = data.ibm_resource_group.group.id
+	}
+
+	resource "ibm_resource_key" "logging_key" {
+		name                 = "%[1]s"
+		resource_instance_id = ibm_resource_instance.logging.id
+		role                 = "Manager"
+	}
+
+	resource "ibm_resource_instance" "cos" {
+		name              = "%[1]s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.group.id
+	}
+
+	resource "ibm_resource_key" "cos_key" {
+		name                 = "%[1]s-cos"
+		resource_instance_id = ibm_resource_instance.cos.id
+		role                 = "Writer"
+	}
+
+	resource "ibm_cos_bucket" "bucket1" {
+		bucket_name          = "%[1]s-1"
+		resource_instance_id = ibm_resource_instance.cos.id
+		region_location      = "us-south"
+		storage_class        = "standard"
+	}
+
+	resource "ibm_cos_bucket" "bucket2" {
+		bucket_name          = "%[1]s-2"
+		resource_instance_id = ibm_resource_instance.cos.id
+		region_location      = "us-south"
+		storage_class        = "standard"
+	}
+
+	resource "ibm_ob_logging_archive" "archive" {
+		instance_id     = ibm_resource_instance.logging.id
+		service_key     = ibm_resource_key.logging_key.credentials["service_key"]
+		bucket          = %[2]s.bucket_name
+		endpoint        = %[2]s.s3_endpoint_public
+		cos_instance_id = ibm_resource_instance.cos.id
+		api_key         = ibm_resource_key.cos_key.credentials["apikey"]
+	}`, name, bucket)
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMObLoggingIngestionKey() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMObLoggingIngestionKeyCreate,
+		ReadContext:   resourceIBMObLoggingIngestionKeyRead,
+		DeleteContext: resourceIBMObLoggingIngestionKeyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID or CRN of the Log Analysis instance",
+			},
+			"service_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "A service key of the Log Analysis instance",
+			},
+			"private_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Whether the configuration API of the Log Analysis instance is called through the private endpoint",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the ingestion key",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The ingestion key, it is used by the logging agents such as the one of ibm_ob_logging",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation time of the ingestion key",
+			},
+		},
+	}
+}
+
+func resourceIBMObLoggingIngestionKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	obClient, err := obLoggingAPI(context, meta, instanceID, d.Get("service_key").(string), d.Get("private_endpoint").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	key, _, err := obClient.CreateIngestionKey(context)
+	if err != nil {
+		return diag.Errorf("Error creating ingestion key of Log Analysis instance %s: %s", instanceID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, key.ID))
+
+	return resourceIBMObLoggingIngestionKeyRead(context, d, meta)
+}
+
+func resourceIBMObLoggingIngestionKeyRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, keyID, err := obResourceIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	obClient, err := obLoggingAPI(context, meta, instanceID, d.Get("service_key").(string), d.Get("private_endpoint").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	key, response, err := obClient.GetIngestionKey(context, keyID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("Error reading ingestion key %s of Log Analysis instance %s: %s", keyID, instanceID, err)
+	}
+	d.Set("instance_id", instanceID)
+	d.Set("key_id", keyID)
+	d.Set("key", key.Key)
+	if key.Created != 0 {
+		d.Set("created", time.Unix(key.Created, 0).UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+func resourceIBMObLoggingIngestionKeyDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, keyID, err := obResourceIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	obClient, err := obLoggingAPI(context, meta, instanceID, d.Get("service_key").(string), d.Get("private_endpoint").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := obClient.DeleteIngestionKey(context, keyID)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.Errorf("Error deleting ingestion key %s of Log Analysis instance %s: %s", keyID, instanceID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMObLoggingIngestionKey_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-logging-key-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMObLoggingIngestionKeyConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_ob_logging_ingestion_key.key", "key_id"),
+					resource.TestCheckResourceAttrSet("ibm_ob_logging_ingestion_key.key", "key"),
+					resource.TestCheckResourceAttrSet("ibm_ob_logging_ingestion_key.key", "created"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMObLoggingIngestionKeyConfig(name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "group" {
+		is_default = true
+	}
+
+	resource "ibm_resource_instance" "logging" {
+		name              = "%[1]s"
+		service           = "logdna"
+		plan              = "7-day"
+		location          = "us-south"
+		resource_group_id = data.ibm_resource_group.group.id
+	}
+
+	resource "ibm_resource_key" "logging_key" {
+		name                 = "%[1]s"
+		resource_instance_id = ibm_resource_instance.logging.id
+		role                 = "Manager"
+	}
+
+	resource "ibm_ob_logging_ingestion_key" "key" {
+		instance_id = ibm_resource_instance.logging.id
+		service_key = ibm_resource_key.logging_key.credentials["service_key"]
+	}`, name)
+}
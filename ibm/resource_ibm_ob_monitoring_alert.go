@@ -0,0 +1,12 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMObMonitoringAlert() *schema.Resource {
+	return resourceIBMObMonitoringObject(obMonitoringAlert, "alert_json", "alert_id")
+}
@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMObMonitoringAlert_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-alert-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMObMonitoringObjectDestroy("ibm_ob_monitoring_alert", obMonitoringAlert),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMObMonitoringAlertConfig(name, 90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_ob_monitoring_alert.alert", "alert_id"),
+					resource.TestCheckResourceAttrSet("ibm_ob_monitoring_alert.alert", "version"),
+				),
+			},
+			{
+				Config: testAccCheckIBMObMonitoringAlertConfig(name, 80),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_ob_monitoring_alert.alert", "alert_id"),
+				),
+			},
+			{
+				ResourceName:            "ibm_ob_monitoring_alert.alert",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"alert_json"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMObMonitoringAlertConfig(name string, threshold int) string {
+	return testAccCheckIBMObMonitoringInstanceConfig(name) + fmt.Sprintf(`
+
+	resource "ibm_ob_monitoring_notification_channel" "channel" {
+		instance_id               = ibm_resource_instance.monitoring.id
+		notification_channel_json = jsonencode({
+			type    = "EMAIL"
+			name    = "%[1]s"
+			enabled = true
+			options = {
+				emailRecipients = ["ops@example.com"]
+			}
+		})
+	}
+
+	resource "ibm_ob_monitoring_alert" "alert" {
+		instance_id = ibm_resource_instance.monitoring.id
+		alert_json  = jsonencode({
+			name                   = "%[1]s"
+			type                   = "MANUAL"
+			enabled                = true
+			severity               = 4
+			timespan               = 600000000
+			condition              = "avg(avg(cpu.used.percent)) > %[2]d"
+			notificationChannelIds = [tonumber(ibm_ob_monitoring_notification_channel.channel.notification_channel_id)]
+		})
+	}`, name, threshold)
+}
@@ -0,0 +1,101 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMObMonitoringDashboard_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-dashboard-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMObMonitoringObjectDestroy("ibm_ob_monitoring_dashboard", obMonitoringDashboard),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMObMonitoringDashboardConfig(name, "CPU"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_ob_monitoring_dashboard.dashboard", "dashboard_id"),
+					resource.TestCheckResourceAttr("ibm_ob_monitoring_dashboard.dashboard", "version", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMObMonitoringDashboardConfig(name, "CPU usage"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_ob_monitoring_dashboard.dashboard", "version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMObMonitoringObjectDestroy(resourceType string, kind obMonitoringObjectKind) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			instanceID, objectID, err := obResourceIDParts(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			obClient, err := obMonitoringAPI(context.Background(), testAccProvider.Meta(), instanceID, false)
+			if err != nil {
+				// The instance is destroyed with the objects
+				continue
+			}
+			_, response, err := obClient.GetMonitoringObject(context.Background(), kind, objectID)
+			if err == nil {
+				return fmt.Errorf("%s still exists: %s", kind.Name, rs.Primary.ID)
+			} else if response == nil || response.StatusCode != 404 {
+				return fmt.Errorf("Error checking if %s (%s) has been destroyed: %s", kind.Name, rs.Primary.ID, err)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMObMonitoringInstanceConfig(name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "group" {
+		is_default = true
+	}
+
+	resource "ibm_resource_instance" "monitoring" {
+		name              = "%s"
+		service           = "sysdig-monitor"
+		plan              = "graduated-tier"
+		location          = "us-south"
+		resource_group_id = data.ibm_resource_group.group.id
+	}`, name)
+}
+
+func testAccCheckIBMObMonitoringDashboardConfig(name, panelName string) string {
+	return testAccCheckIBMObMonitoringInstanceConfig(name) + fmt.Sprintf(`
+
+	resource "ibm_ob_monitoring_dashboard" "dashboard" {
+		instance_id    = ibm_resource_instance.monitoring.id
+		dashboard_json = jsonencode({
+			name   = "%s"
+			public = false
+			panels = [{
+				id   = 1
+				type = "advancedTimechart"
+				name = "%s"
+				advancedQueries = [{
+					enabled = true
+					query   = "avg(avg_over_time(sysdig_host_cpu_used_percent[$__interval]))"
+				}]
+			}]
+			layout = [{ panelId = 1, x = 0, y = 0, w = 12, h = 6 }]
+		})
+	}`, name, panelName)
+}
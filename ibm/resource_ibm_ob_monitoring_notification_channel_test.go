@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMObMonitoringNotificationChannel_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-channel-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMObMonitoringObjectDestroy("ibm_ob_monitoring_notification_channel", obMonitoringNotificationChannel),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMObMonitoringNotificationChannelConfig(name, "https://example.com/hooks/1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_ob_monitoring_notification_channel.channel", "notification_channel_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMObMonitoringNotificationChannelConfig(name, "https://example.com/hooks/2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_ob_monitoring_notification_channel.channel", "notification_channel_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMObMonitoringNotificationChannelConfig(name, url string) string {
+	return testAccCheckIBMObMonitoringInstanceConfig(name) + fmt.Sprintf(`
+
+	resource "ibm_ob_monitoring_notification_channel" "channel" {
+		instance_id               = ibm_resource_instance.monitoring.id
+		notification_channel_json = jsonencode({
+			type    = "WEBHOOK"
+			name    = "%s"
+			enabled = true
+			options = {
+				url = "%s"
+			}
+		})
+	}`, name, url)
+}
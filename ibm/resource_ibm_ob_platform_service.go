@@ -0,0 +1,150 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+// obPlatformServices maps the service of the instance to the data it receives from the platform
+var obPlatformServices = map[string]string{
+	obServiceLogging:    "logs",
+	obServiceMonitoring: "metrics",
+}
+
+func resourceIBMObPlatformService() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMObPlatformServiceCreate,
+		ReadContext:   resourceIBMObPlatformServiceRead,
+		DeleteContext: resourceIBMObPlatformServiceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID or CRN of the Log Analysis or Monitoring instance that receives the platform logs or metrics of its region",
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The platform data received by the instance, logs for a Log Analysis instance or metrics for a Monitoring instance",
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The region of the platform logs or metrics received by the instance",
+			},
+		},
+	}
+}
+
+// obPlatformInstance returns the instance and the platform data it receives, the instance must be a Log Analysis or
+// a Monitoring instance
+func obPlatformInstance(context context.Context, meta interface{}, instanceID string) (*rc.ResourceInstance, string, error) {
+	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, "", err
+	}
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(context, &rc.GetResourceInstanceOptions{
+		ID: core.StringPtr(instanceID),
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil, "", nil
+		}
+		return nil, "", fmt.Errorf("Error retrieving instance %s: %s\n%s", instanceID, err, response)
+	}
+	if instance.CRN == nil {
+		return nil, "", fmt.Errorf("Instance %s has no CRN", instanceID)
+	}
+	platformService, ok := obPlatformServices[obInstanceService(*instance.CRN)]
+	if !ok {
+		return nil, "", fmt.Errorf("Instance %s is not a Log Analysis or Monitoring instance", instanceID)
+	}
+	return instance, platformService, nil
+}
+
+func setObPlatformDefaultReceiver(context context.Context, meta interface{}, instanceID string, enabled bool) error {
+	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	_, response, err := rsConClient.UpdateResourceInstanceWithContext(context, &rc.UpdateResourceInstanceOptions{
+		ID: core.StringPtr(instanceID),
+		Parameters: map[string]interface{}{
+			"default_receiver": enabled,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating instance %s: %s\n%s", instanceID, err, response)
+	}
+	return nil
+}
+
+func resourceIBMObPlatformServiceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	instance, _, err := obPlatformInstance(context, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if instance == nil {
+		return diag.Errorf("Instance %s not found", instanceID)
+	}
+
+	if err := setObPlatformDefaultReceiver(context, meta, instanceID, true); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(instanceID)
+
+	return resourceIBMObPlatformServiceRead(context, d, meta)
+}
+
+func resourceIBMObPlatformServiceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+	instance, platformService, err := obPlatformInstance(context, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// The platform data is not received anymore when the instance is deleted or the setting is turned off
+	if instance == nil || (instance.State != nil && *instance.State == "removed") {
+		d.SetId("")
+		return nil
+	}
+	if enabled, ok := instance.Parameters["default_receiver"].(bool); !ok || !enabled {
+		log.Printf("[WARN] Platform %s are not received by instance %s anymore", platformService, instanceID)
+		d.SetId("")
+		return nil
+	}
+	d.Set("instance_id", instanceID)
+	d.Set("service", platformService)
+	d.Set("region", instance.RegionID)
+
+	return nil
+}
+
+func resourceIBMObPlatformServiceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Id()
+	instance, _, err := obPlatformInstance(context, meta, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if instance != nil && (instance.State == nil || *instance.State != "removed") {
+		if err := setObPlatformDefaultReceiver(context, meta, instanceID, false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMObPlatformService_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-platform-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMObPlatformServiceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_ob_platform_service.logs", "service", "logs"),
+					resource.TestCheckResourceAttr("ibm_ob_platform_service.logs", "region", "eu-de"),
+					resource.TestCheckResourceAttr("ibm_ob_platform_service.metrics", "service", "metrics"),
+					resource.TestCheckResourceAttr("ibm_ob_platform_service.metrics", "region", "eu-de"),
+				),
+			},
+			{
+				ResourceName:      "ibm_ob_platform_service.logs",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMObPlatformServiceConfig(name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "group" {
+		is_default = true
+	}
+
+	resource "ibm_resource_instance" "logging" {
+		name              = "%[1]s-logging"
+		service           = "logdna"
+		plan              = "7-day"
+		location          = "eu-de"
+		resource_group_id = data.ibm_resource_group.group.id
+	}
+
+	resource "ibm_resource_instance" "monitoring" {
+		name              = "%[1]s-monitoring"
+		service           = "sysdig-monitor"
+		plan              = "graduated-tier"
+		location          = "eu-de"
+		resource_group_id = data.ibm_resource_group.group.id
+	}
+
+	resource "ibm_ob_platform_service" "logs" {
+		instance_id = ibm_resource_instance.logging.id
+	}
+
+	resource "ibm_ob_platform_service" "metrics" {
+		instance_id = ibm_resource_instance.monitoring.id
+	}`, name)
+}
@@ -0,0 +1,299 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMResourceAlias() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMResourceAliasCreate,
+		Read:     resourceIBMResourceAliasRead,
+		Update:   resourceIBMResourceAliasUpdate,
+		Delete:   resourceIBMResourceAliasDelete,
+		Exists:   resourceIBMResourceAliasExists,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the resource alias",
+			},
+
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID or GUID of the resource instance to create the alias for",
+			},
+
+			"target": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the target namespace, for example a Cloud Foundry space or a Kubernetes cluster namespace",
+			},
+
+			"target_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the target namespace in the specific environment",
+			},
+
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the resource alias",
+			},
+
+			"guid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The GUID of the resource alias",
+			},
+
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative path to the resource alias",
+			},
+
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An alpha-numeric value identifying the account ID.",
+			},
+
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The short ID of the resource group.",
+			},
+
+			"resource_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique ID of the offering.",
+			},
+
+			"region_instance_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID in the specific target environment, for example service_instance_id in a given IBM Cloud environment.",
+			},
+
+			"region_instance_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the instance in the specific target environment.",
+			},
+
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the resource alias.",
+			},
+
+			"migrated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Specifies whether the alias is migrated from resource controller v1.",
+			},
+
+			"resource_instance_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative path to the resource instance.",
+			},
+
+			"resource_bindings_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative path to the resource bindings of the alias.",
+			},
+
+			"resource_keys_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative path to the resource keys of the alias.",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the alias was created.",
+			},
+
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the alias was last updated.",
+			},
+
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subject who created the alias.",
+			},
+
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subject who updated the alias.",
+			},
+		},
+	}
+}
+
+func resourceIBMResourceAliasCreate(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	instanceID := d.Get("resource_instance_id").(string)
+	target := d.Get("target").(string)
+
+	resourceAliasCreate := rc.CreateResourceAliasOptions{
+		Name:   &name,
+		Source: &instanceID,
+		Target: &target,
+	}
+	resourceAlias, resp, err := rsContClient.CreateResourceAlias(&resourceAliasCreate)
+	if err != nil {
+		return fmt.Errorf("Error creating resource alias: %s with resp code: %s", err, resp)
+	}
+
+	d.SetId(*resourceAlias.ID)
+
+	return resourceIBMResourceAliasRead(d, meta)
+}
+
+func resourceIBMResourceAliasRead(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	resourceAliasID := d.Id()
+	resourceAliasGet := rc.GetResourceAliasOptions{
+		ID: &resourceAliasID,
+	}
+
+	resourceAlias, resp, err := rsContClient.GetResourceAlias(&resourceAliasGet)
+	if err != nil || resourceAlias == nil {
+		return fmt.Errorf("Error retrieving resource alias: %s with resp : %s", err, resp)
+	}
+
+	d.Set("name", resourceAlias.Name)
+	d.Set("target_crn", resourceAlias.TargetCRN)
+	if _, ok := d.GetOk("target"); !ok {
+		d.Set("target", resourceAlias.TargetCRN)
+	}
+	if _, ok := d.GetOk("resource_instance_id"); !ok {
+		d.Set("resource_instance_id", resourceAlias.ResourceInstanceID)
+	}
+	d.Set("crn", resourceAlias.CRN)
+	d.Set("guid", resourceAlias.GUID)
+	d.Set("url", resourceAlias.URL)
+	d.Set("account_id", resourceAlias.AccountID)
+	d.Set("resource_group_id", resourceAlias.ResourceGroupID)
+	d.Set("resource_id", resourceAlias.ResourceID)
+	d.Set("region_instance_id", resourceAlias.RegionInstanceID)
+	d.Set("region_instance_crn", resourceAlias.RegionInstanceCRN)
+	d.Set("state", resourceAlias.State)
+	d.Set("migrated", resourceAlias.Migrated)
+	d.Set("resource_instance_url", resourceAlias.ResourceInstanceURL)
+	d.Set("resource_bindings_url", resourceAlias.ResourceBindingsURL)
+	d.Set("resource_keys_url", resourceAlias.ResourceKeysURL)
+	if resourceAlias.CreatedAt != nil {
+		d.Set("created_at", resourceAlias.CreatedAt.String())
+	} else {
+		d.Set("created_at", "")
+	}
+	if resourceAlias.UpdatedAt != nil {
+		d.Set("updated_at", resourceAlias.UpdatedAt.String())
+	} else {
+		d.Set("updated_at", "")
+	}
+	d.Set("created_by", resourceAlias.CreatedBy)
+	d.Set("updated_by", resourceAlias.UpdatedBy)
+
+	return nil
+}
+
+func resourceIBMResourceAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("name") {
+		resourceAliasID := d.Id()
+		name := d.Get("name").(string)
+		resourceAliasUpdate := rc.UpdateResourceAliasOptions{
+			ID:   &resourceAliasID,
+			Name: &name,
+		}
+		_, resp, err := rsContClient.UpdateResourceAlias(&resourceAliasUpdate)
+		if err != nil {
+			return fmt.Errorf("Error updating resource alias: %s with resp code: %s", err, resp)
+		}
+	}
+
+	return resourceIBMResourceAliasRead(d, meta)
+}
+
+func resourceIBMResourceAliasDelete(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	resourceAliasID := d.Id()
+	resourceAliasDelete := rc.DeleteResourceAliasOptions{
+		ID: &resourceAliasID,
+	}
+
+	resp, err := rsContClient.DeleteResourceAlias(&resourceAliasDelete)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 410 {
+			return nil
+		}
+		return fmt.Errorf("Error deleting resource alias: %s with resp code: %s", err, resp)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMResourceAliasExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return false, err
+	}
+	resourceAliasID := d.Id()
+	resourceAliasGet := rc.GetResourceAliasOptions{
+		ID: &resourceAliasID,
+	}
+
+	resourceAlias, resp, err := rsContClient.GetResourceAlias(&resourceAliasGet)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error communicating with the API: %s with resp code: %s", err, resp)
+	}
+	if resourceAlias.State != nil && *resourceAlias.State == "removed" {
+		return false, nil
+	}
+
+	return *resourceAlias.ID == resourceAliasID, nil
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMResourceAlias_Basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf-cloudant-%d", acctest.RandIntRange(10, 100))
+	aliasName := fmt.Sprintf("tf-alias-%d", acctest.RandIntRange(10, 100))
+	updateName := fmt.Sprintf("tf-alias-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMResourceAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceAliasBasic(instanceName, aliasName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceAliasExists("ibm_resource_alias.alias"),
+					resource.TestCheckResourceAttr("ibm_resource_alias.alias", "name", aliasName),
+					resource.TestCheckResourceAttr("ibm_resource_alias.alias", "state", "active"),
+					resource.TestCheckResourceAttrSet("ibm_resource_alias.alias", "crn"),
+					resource.TestCheckResourceAttrSet("ibm_resource_alias.alias", "region_instance_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMResourceAliasBasic(instanceName, updateName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceAliasExists("ibm_resource_alias.alias"),
+					resource.TestCheckResourceAttr("ibm_resource_alias.alias", "name", updateName),
+				),
+			},
+			{
+				ResourceName:      "ibm_resource_alias.alias",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"resource_instance_id", "target"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceAliasDestroy(s *terraform.State) error {
+	rsContClient, err := testAccProvider.Meta().(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_resource_alias" {
+			continue
+		}
+
+		resourceAliasID := rs.Primary.ID
+		resourceAliasGet := rc.GetResourceAliasOptions{
+			ID: &resourceAliasID,
+		}
+
+		alias, resp, err := rsContClient.GetResourceAlias(&resourceAliasGet)
+		if err == nil {
+			if alias.State != nil && *alias.State == "active" {
+				return fmt.Errorf("Resource alias still exists: %s", rs.Primary.ID)
+			}
+		} else if resp == nil || (resp.StatusCode != 404 && resp.StatusCode != 410) {
+			return fmt.Errorf("Error checking if resource alias (%s) has been destroyed: %s with resp code: %s", rs.Primary.ID, err, resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMResourceAliasExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		rsContClient, err := testAccProvider.Meta().(ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return err
+		}
+		resourceAliasID := rs.Primary.ID
+		resourceAliasGet := rc.GetResourceAliasOptions{
+			ID: &resourceAliasID,
+		}
+
+		_, resp, err := rsContClient.GetResourceAlias(&resourceAliasGet)
+		if err != nil {
+			return fmt.Errorf("Get resource alias error: %s with resp code: %s", err, resp)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIBMResourceAliasBasic(instanceName, aliasName string) string {
+	return fmt.Sprintf(`
+	data "ibm_org" "org" {
+		org = "%s"
+	}
+
+	data "ibm_space" "space" {
+		org   = "%s"
+		space = "%s"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name     = "%s"
+		service  = "cloudantnosqldb"
+		plan     = "lite"
+		location = "us-south"
+	}
+
+	resource "ibm_resource_alias" "alias" {
+		name                 = "%s"
+		resource_instance_id = ibm_resource_instance.instance.guid
+		target               = "crn:v1:bluemix:public:cf:us-south:o/${data.ibm_org.org.id}::cf-space:${data.ibm_space.space.id}"
+	}
+	`, cfOrganization, cfOrganization, cfSpace, instanceName, aliasName)
+}
@@ -0,0 +1,373 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMResourceBinding() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMResourceBindingCreate,
+		Read:     resourceIBMResourceBindingRead,
+		Update:   resourceIBMResourceBindingUpdate,
+		Delete:   resourceIBMResourceBindingDelete,
+		Exists:   resourceIBMResourceBindingExists,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the resource binding",
+			},
+
+			"resource_alias_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID or GUID of the resource alias to bind",
+			},
+
+			"target": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the application to bind to in a Cloud Foundry space, or of the Kubernetes cluster namespace",
+			},
+
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the user role. Valid roles are Writer, Reader, Manager, Administrator, Operator, Viewer, Editor and Custom Roles. If not set, the service default role is used.",
+			},
+
+			"parameters": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				DiffSuppressFunc: applyOnce,
+				Description:      "Arbitrary parameters to pass. Must be a JSON object",
+			},
+
+			"credentials": {
+				Description: "Credentials asociated with the binding",
+				Type:        schema.TypeMap,
+				Sensitive:   true,
+				Computed:    true,
+			},
+
+			"target_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the target the binding is associated with",
+			},
+
+			"source_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the resource alias associated to the binding",
+			},
+
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the resource binding",
+			},
+
+			"guid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The GUID of the resource binding",
+			},
+
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative path to the resource binding",
+			},
+
+			"region_binding_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the binding in the specific target environment, for example service_binding_id in a given IBM Cloud environment.",
+			},
+
+			"region_binding_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the binding in the specific target environment.",
+			},
+
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An alpha-numeric value identifying the account ID.",
+			},
+
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The short ID of the resource group.",
+			},
+
+			"resource_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique ID of the offering.",
+			},
+
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the binding.",
+			},
+
+			"iam_compatible": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Specifies whether the binding’s credentials support IAM.",
+			},
+
+			"migrated": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Specifies whether the binding is migrated from resource controller v1.",
+			},
+
+			"resource_alias_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The relative path to the resource alias.",
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the binding was created.",
+			},
+
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the binding was last updated.",
+			},
+
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subject who created the binding.",
+			},
+
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The subject who updated the binding.",
+			},
+		},
+	}
+}
+
+func resourceIBMResourceBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	aliasID := d.Get("resource_alias_id").(string)
+	target := d.Get("target").(string)
+
+	bindingParameters := rc.ResourceBindingPostParameters{}
+	if parameters, ok := d.GetOk("parameters"); ok {
+		temp := parameters.(map[string]interface{})
+		for k, v := range temp {
+			if v == "true" || v == "false" {
+				b, _ := strconv.ParseBool(v.(string))
+				bindingParameters.SetProperty(k, b)
+			} else {
+				bindingParameters.SetProperty(k, v)
+			}
+		}
+	}
+
+	resourceBindingCreate := rc.CreateResourceBindingOptions{
+		Source:     &aliasID,
+		Target:     &target,
+		Parameters: &bindingParameters,
+	}
+
+	if name, ok := d.GetOk("name"); ok {
+		resourceBindingCreate.Name = ptrToString(name.(string))
+	}
+
+	if role, ok := d.GetOk("role"); ok {
+		resourceAliasGet := rc.GetResourceAliasOptions{
+			ID: &aliasID,
+		}
+		alias, resp, err := rsContClient.GetResourceAlias(&resourceAliasGet)
+		if err != nil {
+			return fmt.Errorf("Error creating resource binding when get alias: %s with resp code: %s", err, resp)
+		}
+
+		rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
+		if err != nil {
+			return fmt.Errorf("Error creating resource binding when get ResourceCatalogAPI: %s", err)
+		}
+		service, err := rsCatClient.ResourceCatalog().Get(*alias.ResourceID, true)
+		if err != nil {
+			return fmt.Errorf("Error creating resource binding when get service: %s", err)
+		}
+		serviceRole, err := getRoleFromName(role.(string), service.Name, meta)
+		if err != nil {
+			return fmt.Errorf("Error creating resource binding when get role: %s", err)
+		}
+		resourceBindingCreate.Role = serviceRole.RoleID
+	}
+
+	resourceBinding, resp, err := rsContClient.CreateResourceBinding(&resourceBindingCreate)
+	if err != nil {
+		return fmt.Errorf("Error creating resource binding: %s with resp code: %s", err, resp)
+	}
+
+	d.SetId(*resourceBinding.ID)
+
+	return resourceIBMResourceBindingRead(d, meta)
+}
+
+func resourceIBMResourceBindingRead(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	resourceBindingID := d.Id()
+	resourceBindingGet := rc.GetResourceBindingOptions{
+		ID: &resourceBindingID,
+	}
+
+	resourceBinding, resp, err := rsContClient.GetResourceBinding(&resourceBindingGet)
+	if err != nil || resourceBinding == nil {
+		return fmt.Errorf("Error retrieving resource binding: %s with resp : %s", err, resp)
+	}
+
+	var credInterface map[string]interface{}
+	cred, _ := json.Marshal(resourceBinding.Credentials)
+	json.Unmarshal(cred, &credInterface)
+	d.Set("credentials", Flatten(credInterface))
+	if resourceBinding.Credentials != nil && resourceBinding.Credentials.IamRoleCRN != nil {
+		roleName := getRoleNameFromCRN(meta, *resourceBinding.Credentials.IamRoleCRN, resourceBinding.CRN, resourceBinding.AccountID)
+		if roleName != "" {
+			d.Set("role", roleName)
+		}
+	}
+
+	d.Set("name", resourceBinding.Name)
+	d.Set("target_crn", resourceBinding.TargetCRN)
+	if _, ok := d.GetOk("target"); !ok {
+		d.Set("target", resourceBinding.TargetCRN)
+	}
+	d.Set("source_crn", resourceBinding.SourceCRN)
+	d.Set("crn", resourceBinding.CRN)
+	d.Set("guid", resourceBinding.GUID)
+	d.Set("url", resourceBinding.URL)
+	d.Set("region_binding_id", resourceBinding.RegionBindingID)
+	d.Set("region_binding_crn", resourceBinding.RegionBindingCRN)
+	d.Set("account_id", resourceBinding.AccountID)
+	d.Set("resource_group_id", resourceBinding.ResourceGroupID)
+	d.Set("resource_id", resourceBinding.ResourceID)
+	d.Set("state", resourceBinding.State)
+	d.Set("iam_compatible", resourceBinding.IamCompatible)
+	d.Set("migrated", resourceBinding.Migrated)
+	d.Set("resource_alias_url", resourceBinding.ResourceAliasURL)
+	if resourceBinding.CreatedAt != nil {
+		d.Set("created_at", resourceBinding.CreatedAt.String())
+	} else {
+		d.Set("created_at", "")
+	}
+	if resourceBinding.UpdatedAt != nil {
+		d.Set("updated_at", resourceBinding.UpdatedAt.String())
+	} else {
+		d.Set("updated_at", "")
+	}
+	d.Set("created_by", resourceBinding.CreatedBy)
+	d.Set("updated_by", resourceBinding.UpdatedBy)
+
+	return nil
+}
+
+func resourceIBMResourceBindingUpdate(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("name") {
+		resourceBindingID := d.Id()
+		name := d.Get("name").(string)
+		resourceBindingUpdate := rc.UpdateResourceBindingOptions{
+			ID:   &resourceBindingID,
+			Name: &name,
+		}
+		_, resp, err := rsContClient.UpdateResourceBinding(&resourceBindingUpdate)
+		if err != nil {
+			return fmt.Errorf("Error updating resource binding: %s with resp code: %s", err, resp)
+		}
+	}
+
+	return resourceIBMResourceBindingRead(d, meta)
+}
+
+func resourceIBMResourceBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	resourceBindingID := d.Id()
+	resourceBindingDelete := rc.DeleteResourceBindingOptions{
+		ID: &resourceBindingID,
+	}
+
+	resp, err := rsContClient.DeleteResourceBinding(&resourceBindingDelete)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 410 {
+			return nil
+		}
+		return fmt.Errorf("Error deleting resource binding: %s with resp code: %s", err, resp)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceIBMResourceBindingExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return false, err
+	}
+	resourceBindingID := d.Id()
+	resourceBindingGet := rc.GetResourceBindingOptions{
+		ID: &resourceBindingID,
+	}
+
+	resourceBinding, resp, err := rsContClient.GetResourceBinding(&resourceBindingGet)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
+			return false, nil
+		}
+		return false, fmt.Errorf("Error communicating with the API: %s with resp code: %s", err, resp)
+	}
+	if resourceBinding.State != nil && *resourceBinding.State == "removed" {
+		return false, nil
+	}
+
+	return *resourceBinding.ID == resourceBindingID, nil
+}
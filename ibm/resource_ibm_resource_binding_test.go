@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMResourceBinding_Basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf-cloudant-%d", acctest.RandIntRange(10, 100))
+	aliasName := fmt.Sprintf("tf-alias-%d", acctest.RandIntRange(10, 100))
+	appName := fmt.Sprintf("tf-app-%d", acctest.RandIntRange(10, 100))
+	bindingName := fmt.Sprintf("tf-binding-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMResourceBindingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceBindingBasic(instanceName, aliasName, appName, bindingName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceBindingExists("ibm_resource_binding.binding"),
+					resource.TestCheckResourceAttr("ibm_resource_binding.binding", "name", bindingName),
+					resource.TestCheckResourceAttr("ibm_resource_binding.binding", "role", "Reader"),
+					resource.TestCheckResourceAttr("ibm_resource_binding.binding", "state", "active"),
+					resource.TestCheckResourceAttrSet("ibm_resource_binding.binding", "credentials.%"),
+				),
+			},
+			{
+				ResourceName:      "ibm_resource_binding.binding",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"resource_alias_id", "target"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMResourceBindingDestroy(s *terraform.State) error {
+	rsContClient, err := testAccProvider.Meta().(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_resource_binding" {
+			continue
+		}
+
+		resourceBindingID := rs.Primary.ID
+		resourceBindingGet := rc.GetResourceBindingOptions{
+			ID: &resourceBindingID,
+		}
+
+		binding, resp, err := rsContClient.GetResourceBinding(&resourceBindingGet)
+		if err == nil {
+			if binding.State != nil && *binding.State == "active" {
+				return fmt.Errorf("Resource binding still exists: %s", rs.Primary.ID)
+			}
+		} else if resp == nil || (resp.StatusCode != 404 && resp.StatusCode != 410) {
+			return fmt.Errorf("Error checking if resource binding (%s) has been destroyed: %s with resp code: %s", rs.Primary.ID, err, resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMResourceBindingExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		rsContClient, err := testAccProvider.Meta().(ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return err
+		}
+		resourceBindingID := rs.Primary.ID
+		resourceBindingGet := rc.GetResourceBindingOptions{
+			ID: &resourceBindingID,
+		}
+
+		_, resp, err := rsContClient.GetResourceBinding(&resourceBindingGet)
+		if err != nil {
+			return fmt.Errorf("Get resource binding error: %s with resp code: %s", err, resp)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIBMResourceBindingBasic(instanceName, aliasName, appName, bindingName string) string {
+	return testAccCheckIBMResourceAliasBasic(instanceName, aliasName) + fmt.Sprintf(`
+	resource "ibm_app" "app" {
+		name              = "%s"
+		space_guid        = data.ibm_space.space.id
+		app_path          = "test-fixtures/app1.zip"
+		wait_time_minutes = 90
+		buildpack         = "sdk-for-nodejs"
+	}
+
+	resource "ibm_resource_binding" "binding" {
+		name              = "%s"
+		resource_alias_id = ibm_resource_alias.alias.guid
+		target            = "crn:v1:bluemix:public:cf:us-south:s/${data.ibm_space.space.id}::cf-application:${ibm_app.app.id}"
+		role              = "Reader"
+	}
+	`, appName, bindingName)
+}
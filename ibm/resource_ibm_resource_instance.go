@@ -7,11 +7,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -28,6 +30,9 @@ const (
 	rsInstanceFailStatus         = "failed"
 	rsInstanceRemovedStatus      = "removed"
 	rsInstanceReclamation        = "pending_reclamation"
+	rsReclamationScheduled       = "SCHEDULED"
+	rsReclamationRestoreAction   = "restore"
+	rsReclamationReclaimAction   = "reclaim"
 )
 
 func resourceIBMResourceInstance() *schema.Resource {
@@ -92,6 +97,20 @@ func resourceIBMResourceInstance() *schema.Resource {
 				Description: "Arbitrary parameters to pass. Must be a JSON object",
 			},
 
+			"hard_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reclaim the instance on destroy instead of leaving it in pending_reclamation state",
+			},
+
+			"restore_if_reclaimed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Restore an instance with the same name and plan that is pending reclamation instead of creating a new one",
+			},
+
 			"tags": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -450,21 +469,63 @@ func resourceIBMResourceInstanceCreate(d *schema.ResourceData, meta interface{})
 
 	rsInst.Parameters = params
 
-	//Start to create resource instance
-	instance, resp, err := rsConClient.CreateResourceInstance(&rsInst)
-	if err != nil {
-		log.Printf(
-			"Error when creating resource instance: %s, Instance info  NAME->%s, LOCATION->%s, GROUP_ID->%s, PLAN_ID->%s",
-			err, *rsInst.Name, *rsInst.Target, *rsInst.ResourceGroup, *rsInst.ResourcePlanID)
-		return fmt.Errorf("Error when creating resource instance: %s with resp code: %s", err, resp)
+	var instance *rc.ResourceInstance
+	if d.Get("restore_if_reclaimed").(bool) {
+		instance, err = findReclaimedResourceInstance(rsConClient, rsInst)
+		if err != nil {
+			return err
+		}
 	}
 
-	d.SetId(*instance.ID)
+	if instance != nil {
+		log.Printf("[INFO] Restoring resource instance %s which is pending reclamation", *instance.ID)
+		d.SetId(*instance.ID)
 
-	_, err = waitForResourceInstanceCreate(d, meta)
-	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
+		err = runResourceInstanceReclamationAction(rsConClient, *instance.ID, rsReclamationRestoreAction)
+		if err != nil {
+			return err
+		}
+
+		_, err = waitForResourceInstanceRestore(d, meta)
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for resource instance (%s) to be restored: %s", d.Id(), err)
+		}
+
+		if _, ok := d.GetOk("parameters"); ok {
+			resourceInstanceUpdate := rc.UpdateResourceInstanceOptions{
+				ID:         instance.ID,
+				Parameters: params,
+			}
+			_, resp, err := rsConClient.UpdateResourceInstance(&resourceInstanceUpdate)
+			if err != nil {
+				return fmt.Errorf("Error updating restored resource instance: %s with resp code: %s", err, resp)
+			}
+
+			_, err = waitForResourceInstanceUpdate(d, meta)
+			if err != nil {
+				return fmt.Errorf(
+					"Error waiting for update resource instance (%s) to be succeeded: %s", d.Id(), err)
+			}
+		}
+	} else {
+		//Start to create resource instance
+		var resp *core.DetailedResponse
+		instance, resp, err = rsConClient.CreateResourceInstance(&rsInst)
+		if err != nil {
+			log.Printf(
+				"Error when creating resource instance: %s, Instance info  NAME->%s, LOCATION->%s, GROUP_ID->%s, PLAN_ID->%s",
+				err, *rsInst.Name, *rsInst.Target, *rsInst.ResourceGroup, *rsInst.ResourcePlanID)
+			return fmt.Errorf("Error when creating resource instance: %s with resp code: %s", err, resp)
+		}
+
+		d.SetId(*instance.ID)
+
+		_, err = waitForResourceInstanceCreate(d, meta)
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for create resource instance (%s) to be succeeded: %s", d.Id(), err)
+		}
 	}
 
 	v := os.Getenv("IC_ENV_TAGS")
@@ -740,6 +801,19 @@ func resourceIBMResourceInstanceDelete(d *schema.ResourceData, meta interface{})
 			"Error waiting for resource instance (%s) to be deleted: %s", d.Id(), err)
 	}
 
+	if d.Get("hard_delete").(bool) {
+		err = runResourceInstanceReclamationAction(rsConClient, id, rsReclamationReclaimAction)
+		if err != nil {
+			return err
+		}
+
+		_, err = waitForResourceInstanceReclaim(d, meta)
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for resource instance (%s) to be reclaimed: %s", d.Id(), err)
+		}
+	}
+
 	d.SetId("")
 
 	return nil
@@ -871,6 +945,153 @@ func waitForResourceInstanceDelete(d *schema.ResourceData, meta interface{}) (in
 	return stateConf.WaitForState()
 }
 
+func waitForResourceInstanceRestore(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return false, err
+	}
+	instanceID := d.Id()
+	resourceInstanceGet := rc.GetResourceInstanceOptions{
+		ID: &instanceID,
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rsInstanceReclamation, rsInstanceProgressStatus, rsInstanceInactiveStatus},
+		Target:  []string{rsInstanceSuccessStatus},
+		Refresh: func() (interface{}, string, error) {
+			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+			if err != nil {
+				if resp != nil && resp.StatusCode == 404 {
+					return nil, "", fmt.Errorf("The resource instance %s does not exist anymore: %v", d.Id(), err)
+				}
+				return nil, "", fmt.Errorf("Get the resource instance %s failed with resp code: %s, err: %v", d.Id(), resp, err)
+			}
+			if *instance.State == rsInstanceFailStatus {
+				return instance, *instance.State, fmt.Errorf("The resource instance %s failed to restore: %v", d.Id(), err)
+			}
+			return instance, *instance.State, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func waitForResourceInstanceReclaim(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return false, err
+	}
+	instanceID := d.Id()
+	resourceInstanceGet := rc.GetResourceInstanceOptions{
+		ID: &instanceID,
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rsInstanceReclamation},
+		Target:  []string{rsInstanceRemovedStatus},
+		Refresh: func() (interface{}, string, error) {
+			instance, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+			if err != nil {
+				if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
+					return instanceID, rsInstanceRemovedStatus, nil
+				}
+				return nil, "", fmt.Errorf("Get the resource instance %s failed with resp code: %s, err: %v", d.Id(), resp, err)
+			}
+			if *instance.State == rsInstanceFailStatus {
+				return instance, *instance.State, fmt.Errorf("The resource instance %s failed to reclaim: %v", d.Id(), err)
+			}
+			return instance, *instance.State, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+// findReclaimedResourceInstance returns the instance pending reclamation that
+// matches the name, plan, resource group and deployment of rsInst, if any.
+func findReclaimedResourceInstance(client *rc.ResourceControllerV2, rsInst rc.CreateResourceInstanceOptions) (*rc.ResourceInstance, error) {
+	state := rsInstanceReclamation
+	listOptions := &rc.ListResourceInstancesOptions{
+		Name:            rsInst.Name,
+		ResourceGroupID: rsInst.ResourceGroup,
+		ResourcePlanID:  rsInst.ResourcePlanID,
+		State:           &state,
+	}
+
+	var start *string
+	for {
+		listOptions.Start = start
+		instances, resp, err := client.ListResourceInstances(listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("Error listing resource instances pending reclamation: %s with resp code: %s", err, resp)
+		}
+		for _, instance := range instances.Resources {
+			if instance.State == nil || *instance.State != rsInstanceReclamation {
+				continue
+			}
+			if instance.TargetCRN != nil && rsInst.Target != nil && *instance.TargetCRN != *rsInst.Target {
+				continue
+			}
+			found := instance
+			return &found, nil
+		}
+		start = getResourceInstanceNextStart(instances.NextURL)
+		if start == nil {
+			return nil, nil
+		}
+	}
+}
+
+// getResourceInstanceNextStart extracts the start token from the next_url of a
+// resource instance listing.
+func getResourceInstanceNextStart(next *string) *string {
+	if next == nil || *next == "" {
+		return nil
+	}
+	u, err := url.Parse(*next)
+	if err != nil {
+		return nil
+	}
+	start := u.Query().Get("start")
+	if start == "" {
+		return nil
+	}
+	return &start
+}
+
+// runResourceInstanceReclamationAction runs a restore or reclaim action on the
+// scheduled reclamation of a resource instance. It is a no-op when the
+// instance has no scheduled reclamation.
+func runResourceInstanceReclamationAction(client *rc.ResourceControllerV2, instanceID, action string) error {
+	listOptions := &rc.ListReclamationsOptions{
+		ResourceInstanceID: &instanceID,
+	}
+	reclamations, resp, err := client.ListReclamations(listOptions)
+	if err != nil {
+		return fmt.Errorf("Error listing reclamations of resource instance %s: %s with resp code: %s", instanceID, err, resp)
+	}
+
+	for _, reclamation := range reclamations.Resources {
+		if reclamation.State == nil || *reclamation.State != rsReclamationScheduled {
+			continue
+		}
+		runOptions := client.NewRunReclamationActionOptions(*reclamation.ID, action)
+		_, resp, err := client.RunReclamationAction(runOptions)
+		if err != nil {
+			return fmt.Errorf("Error running %s on reclamation %s of resource instance %s: %s with resp code: %s", action, *reclamation.ID, instanceID, err, resp)
+		}
+		return nil
+	}
+
+	log.Printf("[WARN] No scheduled reclamation found for resource instance %s, skipping %s", instanceID, action)
+	return nil
+}
+
 func filterDeployments(deployments []models.ServiceDeployment, location string) ([]models.ServiceDeployment, map[string]bool) {
 	supportedDeployments := []models.ServiceDeployment{}
 	supportedLocations := make(map[string]bool)
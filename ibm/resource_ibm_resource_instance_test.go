@@ -89,7 +89,7 @@ func TestAccIBMResourceInstanceImport(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"wait_time_minutes", "parameters"},
+					"wait_time_minutes", "parameters", "hard_delete", "restore_if_reclaimed"},
 			},
 		},
 	})
@@ -142,6 +142,146 @@ func TestAccIBMResourceInstanceWithResourceGroup(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceInstanceHardDelete(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMResourceInstanceReclaimed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceInstanceReclamation(serviceName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists("ibm_resource_instance.instance"),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "hard_delete", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMResourceInstanceRestoreIfReclaimed(t *testing.T) {
+	serviceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	var instanceID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMResourceInstanceReclaimed,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceInstanceBasic(serviceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists("ibm_resource_instance.instance"),
+					testAccCheckIBMResourceInstanceID("ibm_resource_instance.instance", &instanceID),
+				),
+			},
+			{
+				Config: testAccCheckIBMResourceInstanceReclaimedOnly(),
+				Check:  testAccCheckIBMResourceInstanceScheduledReclamation(&instanceID),
+			},
+			{
+				Config: testAccCheckIBMResourceInstanceReclamation(serviceName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceInstanceExists("ibm_resource_instance.instance"),
+					resource.TestCheckResourceAttrPtr("ibm_resource_instance.instance", "id", &instanceID),
+					resource.TestCheckResourceAttr("ibm_resource_instance.instance", "status", "active"),
+					resource.TestCheckResourceAttrSet("ibm_resource_instance.instance", "restored_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestGetResourceInstanceNextStart(t *testing.T) {
+	cases := []struct {
+		next     string
+		expected string
+	}{
+		{"", ""},
+		{"/v2/resource_instances?limit=100", ""},
+		{"/v2/resource_instances?limit=100&start=abc123", "abc123"},
+	}
+	for _, c := range cases {
+		next := c.next
+		start := getResourceInstanceNextStart(&next)
+		if c.expected == "" {
+			if start != nil {
+				t.Errorf("getResourceInstanceNextStart(%q) = %q, expected nil", c.next, *start)
+			}
+			continue
+		}
+		if start == nil || *start != c.expected {
+			t.Errorf("getResourceInstanceNextStart(%q) = %v, expected %q", c.next, start, c.expected)
+		}
+	}
+	if start := getResourceInstanceNextStart(nil); start != nil {
+		t.Errorf("getResourceInstanceNextStart(nil) = %q, expected nil", *start)
+	}
+}
+
+func testAccCheckIBMResourceInstanceID(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckIBMResourceInstanceScheduledReclamation(id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rsContClient, err := testAccProvider.Meta().(ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return err
+		}
+		listReclamationsOptions := &rc.ListReclamationsOptions{
+			ResourceInstanceID: id,
+		}
+		reclamations, resp, err := rsContClient.ListReclamations(listReclamationsOptions)
+		if err != nil {
+			return fmt.Errorf("Error listing reclamations of Resource Instance (%s): %s with resp code: %s", *id, err, resp)
+		}
+		for _, reclamation := range reclamations.Resources {
+			if *reclamation.State == rsReclamationScheduled {
+				return nil
+			}
+		}
+		return fmt.Errorf("No scheduled reclamation found for Resource Instance %s", *id)
+	}
+}
+
+func testAccCheckIBMResourceInstanceReclaimed(s *terraform.State) error {
+	rsContClient, err := testAccProvider.Meta().(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_resource_instance" {
+			continue
+		}
+
+		instanceID := rs.Primary.ID
+		resourceInstanceGet := rc.GetResourceInstanceOptions{
+			ID: &instanceID,
+		}
+
+		instance, resp, err := rsContClient.GetResourceInstance(&resourceInstanceGet)
+		if err == nil {
+			if *instance.State != rsInstanceRemovedStatus {
+				return fmt.Errorf("Resource Instance %s was not reclaimed, state is %s", rs.Primary.ID, *instance.State)
+			}
+		} else if resp == nil || (resp.StatusCode != 404 && resp.StatusCode != 410) {
+			return fmt.Errorf("Error checking if Resource Instance (%s) has been reclaimed: %s with resp code: %s", rs.Primary.ID, err, resp)
+		}
+	}
+
+	return nil
+}
+
 func testAccCheckIBMResourceInstanceDestroy(s *terraform.State) error {
 	rsContClient, err := testAccProvider.Meta().(ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -306,3 +446,27 @@ func testAccCheckIBMResourceInstanceServiceendpoints(serviceName string) string
 			
 	`, serviceName)
 }
+
+func testAccCheckIBMResourceInstanceReclamation(serviceName string, restore bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "instance" {
+		name                 = "%s"
+		service              = "cloud-object-storage"
+		plan                 = "standard"
+		location             = "global"
+		hard_delete          = true
+		restore_if_reclaimed = %t
+		parameters = {
+		  "HMAC" = true
+		}
+	}
+	`, serviceName, restore)
+}
+
+func testAccCheckIBMResourceInstanceReclaimedOnly() string {
+	return `
+	data "ibm_resource_group" "group" {
+		is_default = true
+	}
+	`
+}
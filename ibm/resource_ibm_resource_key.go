@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
@@ -32,6 +34,12 @@ func resourceIBMResourceKey() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMResourceKeyRotationCustomizeDiff(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -43,8 +51,7 @@ func resourceIBMResourceKey() *schema.Resource {
 			"role": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
-				Description: "Name of the user role.Valid roles are Writer, Reader, Manager, Administrator, Operator, Viewer, Editor and Custom Roles.",
+				Description: "Name of the user role.Valid roles are Writer, Reader, Manager, Administrator, Operator, Viewer, Editor and Custom Roles. Changing the role rotates the key when rotation is configured and replaces it otherwise.",
 				// ValidateFunc: validateRole,
 			},
 
@@ -67,10 +74,52 @@ func resourceIBMResourceKey() *schema.Resource {
 			"parameters": {
 				Type:             schema.TypeMap,
 				Optional:         true,
-				DiffSuppressFunc: applyOnce,
+				DiffSuppressFunc: resourceIBMResourceKeyParametersDiffSuppress,
 				Description:      "Arbitrary parameters to pass. Must be a JSON object",
 			},
 
+			"rotation": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Rotate the key by creating a new one instead of replacing it. The previous key is kept for a grace period and deleted on the next apply after the grace period.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"trigger": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "An arbitrary value that rotates the key whenever it changes, for example the ID of a time_rotating resource.",
+						},
+						"grace_period": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "24h",
+							ValidateFunc: validateResourceKeyGracePeriod,
+							Description:  "How long the previous key is kept after a rotation, as a duration such as 24h or 30m.",
+						},
+					},
+				},
+			},
+
+			"previous_key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the key that was replaced by the last rotation, until it is deleted.",
+			},
+
+			"previous_credentials": {
+				Type:        schema.TypeMap,
+				Sensitive:   true,
+				Computed:    true,
+				Description: "Credentials of the key that was replaced by the last rotation, until it is deleted.",
+			},
+
+			"previous_key_expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time after which the previous key is deleted on the next apply.",
+			},
+
 			"credentials": {
 				Description: "Credentials asociated with the key",
 				Type:        schema.TypeMap,
@@ -184,10 +233,11 @@ func resourceIBMResourceKey() *schema.Resource {
 	}
 }
 
-func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
+// createResourceKey creates a resource key from the configuration in d.
+func createResourceKey(d *schema.ResourceData, meta interface{}) (*rc.ResourceKey, error) {
 	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	name := d.Get("name").(string)
 	role := d.Get("role").(string)
@@ -202,7 +252,7 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if instanceID == "" && aliasID == "" {
-		return fmt.Errorf("Provide either `resource_instance_id` or `resource_alias_id`")
+		return nil, fmt.Errorf("Provide either `resource_instance_id` or `resource_alias_id`")
 	}
 
 	keyParameters := rc.ResourceKeyPostParameters{}
@@ -221,23 +271,23 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 
 	resourceInstance, sourceCRN, err := getResourceInstanceAndCRN(d, meta)
 	if err != nil {
-		return fmt.Errorf("Error creating resource key when get instance and CRN: %s", err)
+		return nil, fmt.Errorf("Error creating resource key when get instance and CRN: %s", err)
 	}
 
 	serviceID := resourceInstance.ResourceID
 
 	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
 	if err != nil {
-		return fmt.Errorf("Error creating resource key when get ResourceCatalogAPI: %s", err)
+		return nil, fmt.Errorf("Error creating resource key when get ResourceCatalogAPI: %s", err)
 	}
 
 	service, err := rsCatClient.ResourceCatalog().Get(*serviceID, true)
 	if err != nil {
-		return fmt.Errorf("Error creating resource key when get service: %s", err)
+		return nil, fmt.Errorf("Error creating resource key when get service: %s", err)
 	}
 	serviceRole, err := getRoleFromName(role, service.Name, meta)
 	if err != nil {
-		return fmt.Errorf("Error creating resource key when get role: %s", err)
+		return nil, fmt.Errorf("Error creating resource key when get role: %s", err)
 	}
 
 	keyParameters.SetProperty("role_crn", serviceRole.RoleID)
@@ -250,7 +300,16 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 	}
 	resourceKey, resp, err := rsContClient.CreateResourceKey(&resourceKeyCreate)
 	if err != nil {
-		return fmt.Errorf("Error creating resource key: %s with resp code: %s", err, resp)
+		return nil, fmt.Errorf("Error creating resource key: %s with resp code: %s", err, resp)
+	}
+
+	return resourceKey, nil
+}
+
+func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	resourceKey, err := createResourceKey(d, meta)
+	if err != nil {
+		return err
 	}
 
 	d.SetId(*resourceKey.ID)
@@ -259,9 +318,142 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceIBMResourceKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	if resourceIBMResourceKeyRotationRequired(d) {
+		return resourceIBMResourceKeyRotate(d, meta)
+	}
+
+	if d.HasChange("previous_key_id") && d.Get("previous_key_id").(string) == "" {
+		oldID, _ := d.GetChange("previous_key_id")
+		if err := deleteResourceKey(meta, oldID.(string)); err != nil {
+			return err
+		}
+		d.Set("previous_credentials", map[string]interface{}{})
+		d.Set("previous_key_expires_at", "")
+	}
+
+	return resourceIBMResourceKeyRead(d, meta)
+}
+
+// resourceIBMResourceKeyRotate creates a new key for d, keeps the current
+// key as the previous key for the grace period and deletes the key that was
+// kept by an earlier rotation.
+func resourceIBMResourceKeyRotate(d *schema.ResourceData, meta interface{}) error {
+	gracePeriod, _ := time.ParseDuration(d.Get("rotation.0.grace_period").(string))
+	currentID := d.Id()
+	currentCredentials, _ := d.GetChange("credentials")
+	olderID, _ := d.GetChange("previous_key_id")
+
+	resourceKey, err := createResourceKey(d, meta)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Rotated resource key %s to %s", currentID, *resourceKey.ID)
+	d.SetId(*resourceKey.ID)
+	d.Set("previous_key_id", currentID)
+	d.Set("previous_credentials", currentCredentials)
+	d.Set("previous_key_expires_at", time.Now().Add(gracePeriod).UTC().Format(time.RFC3339))
+
+	if olderID.(string) != "" {
+		if err := deleteResourceKey(meta, olderID.(string)); err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMResourceKeyRead(d, meta)
+}
+
+// resourceIBMResourceKeyRotationRequired reports whether the pending change
+// of an existing key with rotation configured rotates the key.
+func resourceIBMResourceKeyRotationRequired(d resourceDiffer) bool {
+	if d.Id() == "" {
+		return false
+	}
+	oldRotation, newRotation := d.GetChange("rotation")
+	if len(newRotation.([]interface{})) == 0 {
+		return false
+	}
+	if d.HasChange("role") || d.HasChange("parameters") {
+		return true
+	}
+	return len(oldRotation.([]interface{})) > 0 && d.HasChange("rotation.0.trigger")
+}
+
+// resourceDiffer is implemented by both schema.ResourceData and
+// schema.ResourceDiff.
+type resourceDiffer interface {
+	Id() string
+	Get(string) interface{}
+	GetChange(string) (interface{}, interface{})
+	HasChange(string) bool
+}
+
+func resourceIBMResourceKeyRotationCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if len(diff.Get("rotation").([]interface{})) == 0 {
+		if diff.HasChange("role") {
+			return diff.ForceNew("role")
+		}
+		return nil
+	}
+
+	if resourceIBMResourceKeyRotationRequired(diff) {
+		for _, key := range []string{"credentials", "crn", "guid", "url", "created_at", "previous_key_id", "previous_credentials", "previous_key_expires_at"} {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if resourceKeyPreviousKeyExpired(diff.Get("previous_key_id").(string), diff.Get("previous_key_expires_at").(string), time.Now()) {
+		if err := diff.SetNew("previous_key_id", ""); err != nil {
+			return err
+		}
+		if err := diff.SetNew("previous_credentials", map[string]interface{}{}); err != nil {
+			return err
+		}
+		return diff.SetNew("previous_key_expires_at", "")
+	}
+
 	return nil
 }
 
+// resourceKeyPreviousKeyExpired reports whether the grace period of the
+// previous key ended before now.
+func resourceKeyPreviousKeyExpired(previousKeyID, expiresAt string, now time.Time) bool {
+	if previousKeyID == "" || expiresAt == "" {
+		return false
+	}
+	expires, err := time.Parse(time.RFC3339, expiresAt)
+	if err != nil {
+		return true
+	}
+	return !now.Before(expires)
+}
+
+func resourceIBMResourceKeyParametersDiffSuppress(k, o, n string, d *schema.ResourceData) bool {
+	if len(d.Get("rotation").([]interface{})) > 0 {
+		return false
+	}
+	return applyOnce(k, o, n, d)
+}
+
+func validateResourceKeyGracePeriod(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration such as 24h or 30m: %s", k, err))
+		return
+	}
+	if duration < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	}
+	return
+}
+
 func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error {
 	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -283,36 +475,8 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("name", *resourceKey.Name)
 	d.Set("status", *resourceKey.State)
 	if resourceKey.Credentials != nil && resourceKey.Credentials.IamRoleCRN != nil {
-		roleCrn := *resourceKey.Credentials.IamRoleCRN
-		roleName := roleCrn[strings.LastIndex(roleCrn, ":")+1:]
-
-		// TODO.S: update client
-		if strings.Contains(roleCrn, ":customRole:") {
-			iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
-			if err == nil {
-				var resourceCRN string
-				if resourceKey.CRN != nil {
-					serviceName := strings.Split(*resourceKey.CRN, ":")
-					if len(serviceName) > 4 {
-						resourceCRN = serviceName[4]
-					}
-				}
-				listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
-					AccountID:   resourceKey.AccountID,
-					ServiceName: &resourceCRN,
-				}
-				roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
-				roles := roleList.CustomRoles
-				if err == nil && len(roles) > 0 {
-					for _, role := range roles {
-						if *role.Name == roleName {
-							customRoleName := role.DisplayName
-							d.Set("role", customRoleName)
-						}
-					}
-				}
-			}
-		} else {
+		roleName := getRoleNameFromCRN(meta, *resourceKey.Credentials.IamRoleCRN, resourceKey.CRN, resourceKey.AccountID)
+		if roleName != "" {
 			d.Set("role", roleName)
 		}
 	}
@@ -351,6 +515,22 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("updated_by", *resourceKey.UpdatedBy)
 	d.Set("deleted_by", *resourceKey.DeletedBy)
 
+	if previousKeyID := d.Get("previous_key_id").(string); previousKeyID != "" {
+		previousKeyGet := rc.GetResourceKeyOptions{
+			ID: &previousKeyID,
+		}
+		previousKey, resp, err := rsContClient.GetResourceKey(&previousKeyGet)
+		if err != nil && (resp == nil || (resp.StatusCode != 404 && resp.StatusCode != 410)) {
+			return fmt.Errorf("Error retrieving previous resource key: %s with resp : %s", err, resp)
+		}
+		if err != nil || (previousKey.State != nil && *previousKey.State == "removed") {
+			log.Printf("[WARN] Previous resource key %s no longer exists", previousKeyID)
+			d.Set("previous_key_id", "")
+			d.Set("previous_credentials", map[string]interface{}{})
+			d.Set("previous_key_expires_at", "")
+		}
+	}
+
 	return nil
 }
 
@@ -370,11 +550,38 @@ func resourceIBMResourceKeyDelete(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error deleting resource key: %s with resp code: %s", err, resp)
 	}
 
+	if previousKeyID := d.Get("previous_key_id").(string); previousKeyID != "" {
+		if err := deleteResourceKey(meta, previousKeyID); err != nil {
+			return err
+		}
+	}
+
 	d.SetId("")
 
 	return nil
 }
 
+// deleteResourceKey deletes the key with the given ID, ignoring keys that are
+// already gone.
+func deleteResourceKey(meta interface{}, resourceKeyID string) error {
+	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	resourceKeyDelete := rc.DeleteResourceKeyOptions{
+		ID: &resourceKeyID,
+	}
+	resp, err := rsContClient.DeleteResourceKey(&resourceKeyDelete)
+	if err != nil {
+		if resp != nil && (resp.StatusCode == 404 || resp.StatusCode == 410) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting previous resource key %s: %s with resp code: %s", resourceKeyID, err, resp)
+	}
+	return nil
+}
+
 func resourceIBMResourceKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -399,6 +606,42 @@ func resourceIBMResourceKeyExists(d *schema.ResourceData, meta interface{}) (boo
 	return *resourceKey.ID == resourceKeyID, nil
 }
 
+// getRoleNameFromCRN returns the display name of the role in the credentials
+// of a resource key or binding identified by crn. An empty string is returned
+// when a custom role can't be resolved.
+func getRoleNameFromCRN(meta interface{}, roleCrn string, crn, accountID *string) string {
+	roleName := roleCrn[strings.LastIndex(roleCrn, ":")+1:]
+	if !strings.Contains(roleCrn, ":customRole:") {
+		return roleName
+	}
+
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return ""
+	}
+	var resourceCRN string
+	if crn != nil {
+		serviceName := strings.Split(*crn, ":")
+		if len(serviceName) > 4 {
+			resourceCRN = serviceName[4]
+		}
+	}
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID:   accountID,
+		ServiceName: &resourceCRN,
+	}
+	roleList, _, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	if err != nil || roleList == nil {
+		return ""
+	}
+	for _, role := range roleList.CustomRoles {
+		if *role.Name == roleName {
+			return *role.DisplayName
+		}
+	}
+	return ""
+}
+
 func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.ResourceInstance, *string, error) {
 	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -176,6 +177,111 @@ func testAccCheckIBMResourceKeyDestroy(s *terraform.State) error {
 	return nil
 }
 
+func TestAccIBMResourceKey_Rotation(t *testing.T) {
+	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	var keyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMResourceKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceKeyRotation(resourceName, resourceKey, "Reader", "1", "24h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					testAccCheckIBMResourceInstanceID("ibm_resource_key.resourceKey", &keyID),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "previous_key_id", ""),
+				),
+			},
+			{
+				// Changing the role rotates the key instead of replacing it.
+				Config: testAccCheckIBMResourceKeyRotation(resourceName, resourceKey, "Writer", "1", "24h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "role", "Writer"),
+					resource.TestCheckResourceAttrPtr("ibm_resource_key.resourceKey", "previous_key_id", &keyID),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "previous_credentials.apikey"),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "previous_key_expires_at"),
+				),
+			},
+			{
+				// A new trigger rotates again and deletes the oldest key; with
+				// no grace period the previous key expires right away.
+				Config: testAccCheckIBMResourceKeyRotation(resourceName, resourceKey, "Writer", "2", "0s"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "previous_key_id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckIBMResourceKeyRotation(resourceName, resourceKey, "Writer", "2", "0s"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "previous_key_id", ""),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "previous_credentials.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceKeyPreviousKeyExpired(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		previousKeyID string
+		expiresAt     string
+		expected      bool
+	}{
+		{"", "", false},
+		{"key", "", false},
+		{"", "2021-06-01T11:00:00Z", false},
+		{"key", "2021-06-01T11:00:00Z", true},
+		{"key", "2021-06-01T12:00:00Z", true},
+		{"key", "2021-06-01T13:00:00Z", false},
+		{"key", "invalid", true},
+	}
+	for _, c := range cases {
+		if expired := resourceKeyPreviousKeyExpired(c.previousKeyID, c.expiresAt, now); expired != c.expected {
+			t.Errorf("resourceKeyPreviousKeyExpired(%q, %q) = %t, expected %t", c.previousKeyID, c.expiresAt, expired, c.expected)
+		}
+	}
+}
+
+func TestValidateResourceKeyGracePeriod(t *testing.T) {
+	for _, v := range []string{"24h", "30m", "0s", "1h30m"} {
+		if _, errs := validateResourceKeyGracePeriod(v, "grace_period"); len(errs) > 0 {
+			t.Errorf("validateResourceKeyGracePeriod(%q) returned %v", v, errs)
+		}
+	}
+	for _, v := range []string{"", "1d", "-1h", "tomorrow"} {
+		if _, errs := validateResourceKeyGracePeriod(v, "grace_period"); len(errs) == 0 {
+			t.Errorf("validateResourceKeyGracePeriod(%q) should have failed", v)
+		}
+	}
+}
+
+func testAccCheckIBMResourceKeyRotation(resourceName, resourceKey, role, trigger, gracePeriod string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "resource" {
+			name              = "%s"
+			service           = "cloud-object-storage"
+			plan              = "standard"
+			location          = "global"
+		}
+		resource "ibm_resource_key" "resourceKey" {
+			name = "%s"
+			resource_instance_id = ibm_resource_instance.resource.id
+			role = "%s"
+			rotation {
+				trigger      = "%s"
+				grace_period = "%s"
+			}
+		}
+	`, resourceName, resourceKey, role, trigger, gracePeriod)
+}
+
 func testAccCheckIBMResourceKeyBasic(resourceName, resourceKey string) string {
 	return fmt.Sprintf(`
 		
@@ -24,6 +24,7 @@ const (
 	tagType      = "tag_type"
 	acccountID   = "acccount_id"
 	service      = "service"
+	replace      = "replace"
 	crnRegex     = "^crn:v1(:[a-zA-Z0-9 \\-\\._~\\*\\+,;=!$&'\\(\\)\\/\\?#\\[\\]@]*){8}$|^[0-9]+$"
 )
 
@@ -68,6 +69,11 @@ func resourceIBMResourceTag() *schema.Resource {
 				ValidateFunc: validateAllowedStringValue([]string{"service", "access", "user"}),
 				Description:  "Type of the tag. Only allowed values are: user, or service or access (default value : user)",
 			},
+			replace: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If true, tags of the tag type that are attached to the resource but not declared in tags are detached. If false, only the declared tags are tracked. If not set, all attached tags are tracked",
+			},
 			acccountID: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -154,7 +160,16 @@ func resourceIBMResourceTagCreate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if len(add) > 0 {
+	if d.Get(replace).(bool) {
+		current, err := GetGlobalTagsUsingCRN(meta, resourceID, rType, tType)
+		if err != nil {
+			return fmt.Errorf("Error getting resource tags for: %s with error : %s\n", resourceID, err)
+		}
+		err = UpdateGlobalTagsUsingCRN(current, d.Get(tags), meta, resourceID, rType, tType)
+		if err != nil {
+			return fmt.Errorf("Error replacing resource tags : %s", err)
+		}
+	} else if len(add) > 0 {
 		_, resp, err := gtClient.AttachTag(AttachTagOptions)
 		if err != nil {
 			return fmt.Errorf("Error attaching resource tags : %v\n%s", resp, err)
@@ -211,7 +226,7 @@ func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	tagList, err := GetGlobalTagsUsingCRN(meta, rID, resourceType, tType)
+	tagList, err := GetGlobalTagsUsingCRN(meta, rID, rType, tType)
 	if err != nil {
 		if apierr, ok := err.(bmxerror.RequestFailure); ok && apierr.StatusCode() == 404 {
 			d.SetId("")
@@ -220,6 +235,15 @@ func resourceIBMResourceTagRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error getting resource tags for: %s with error : %s\n", rID, err)
 	}
 
+	// With replace explicitly set to false, only track the tags that are
+	// managed by this resource. On import nothing is managed yet, so track
+	// all of them.
+	if r, ok := d.GetOkExists(replace); ok && !r.(bool) {
+		if managed, ok := d.GetOk(tags); ok {
+			tagList = tagList.Intersection(managed.(*schema.Set))
+		}
+	}
+
 	d.Set(resourceID, rID)
 	d.Set(resourceType, rType)
 	d.Set(tags, tagList)
@@ -252,6 +276,12 @@ func resourceIBMResourceTagUpdate(d *schema.ResourceData, meta interface{}) erro
 
 	if _, ok := d.GetOk(tags); ok {
 		oldList, newList := d.GetChange(tags)
+		if d.Get(replace).(bool) && d.HasChange(replace) {
+			oldList, err = GetGlobalTagsUsingCRN(meta, rID, rType, tType)
+			if err != nil {
+				return fmt.Errorf("Error getting resource tags for: %s with error : %s\n", rID, err)
+			}
+		}
 		err := UpdateGlobalTagsUsingCRN(oldList, newList, meta, rID, rType, tType)
 		if err != nil {
 			return fmt.Errorf(
@@ -263,7 +293,7 @@ func resourceIBMResourceTagUpdate(d *schema.ResourceData, meta interface{}) erro
 }
 
 func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) error {
-	var rID, rType string
+	var rID, rType, tType string
 
 	crn, err := regexp.Compile(crnRegex)
 	if err != nil {
@@ -302,18 +332,34 @@ func resourceIBMResourceTagDelete(d *schema.ResourceData, meta interface{}) erro
 			Resources: resources,
 			TagNames:  remove,
 		}
+		if v, ok := d.GetOk(tagType); ok && v != nil {
+			tType = v.(string)
+			detachTagOptions.TagType = ptrToString(tType)
+			if tType == service {
+				detachTagOptions.AccountID = ptrToString(d.Get(acccountID).(string))
+			}
+		}
 
 		_, resp, err := gtClient.DetachTag(detachTagOptions)
 		if err != nil {
 			return fmt.Errorf("Error detaching resource tags %v: %s\n%s", remove, err, resp)
 		}
-		for _, v := range remove {
-			delTagOptions := &globaltaggingv1.DeleteTagOptions{
-				TagName: ptrToString(v),
-			}
-			_, resp, err := gtClient.DeleteTag(delTagOptions)
-			if err != nil {
-				return fmt.Errorf("Error deleting resource tag %v: %s\n%s", v, err, resp)
+		// Access tags are referenced by IAM policies, keep them in the account
+		if tType != "access" {
+			for _, v := range remove {
+				delTagOptions := &globaltaggingv1.DeleteTagOptions{
+					TagName: ptrToString(v),
+				}
+				if tType != "" {
+					delTagOptions.TagType = ptrToString(tType)
+					if tType == service {
+						delTagOptions.AccountID = ptrToString(d.Get(acccountID).(string))
+					}
+				}
+				_, resp, err := gtClient.DeleteTag(delTagOptions)
+				if err != nil {
+					return fmt.Errorf("Error deleting resource tag %v: %s\n%s", v, err, resp)
+				}
 			}
 		}
 	}
@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -39,6 +40,100 @@ func TestAccResourceTag_Basic(t *testing.T) {
 	})
 }
 
+func TestAccResourceTag_Replace(t *testing.T) {
+	var crn string
+	name := fmt.Sprintf("tf-tag-cos-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckResourceTagReplace(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						crn = s.RootModule().Resources["ibm_resource_instance.instance"].Primary.Attributes["crn"]
+						return nil
+					},
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "tags.#", "1"),
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "replace", "false"),
+				),
+			},
+			resource.TestStep{
+				PreConfig: testAccResourceTagAttach(&crn, "team:tf"),
+				Config:    testAccCheckResourceTagReplace(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "tags.#", "1"),
+					testAccCheckResourceTagCount("ibm_resource_instance.instance", "", 2),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckResourceTagReplace(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "tags.#", "1"),
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "replace", "true"),
+					testAccCheckResourceTagCount("ibm_resource_instance.instance", "", 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceTag_AccessTags(t *testing.T) {
+	name := fmt.Sprintf("tf-tag-cos-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckResourceTagAccess(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "tags.#", "1"),
+					resource.TestCheckResourceAttr("ibm_resource_tag.tag", "tag_type", "access"),
+					testAccCheckResourceTagCount("ibm_resource_instance.instance", "access", 1),
+					resource.TestCheckResourceAttr("data.ibm_resource_tag.read_tag", "tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// testAccResourceTagAttach attaches a tag outside of Terraform.
+func testAccResourceTagAttach(crn *string, tag string) func() {
+	return func() {
+		gtClient, err := testAccProvider.Meta().(ClientSession).GlobalTaggingAPIv1()
+		if err != nil {
+			log.Printf("Error getting global tagging client: %s", err)
+			return
+		}
+		attachTagOptions := &globaltaggingv1.AttachTagOptions{
+			Resources: []globaltaggingv1.Resource{{ResourceID: crn}},
+			TagNames:  []string{tag},
+		}
+		if _, _, err := gtClient.AttachTag(attachTagOptions); err != nil {
+			log.Printf("Error attaching tag %s to %s: %s", tag, *crn, err)
+		}
+	}
+}
+
+func testAccCheckResourceTagCount(n, tagType string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		tags, err := GetGlobalTagsUsingCRN(testAccProvider.Meta(), rs.Primary.Attributes["crn"], "", tagType)
+		if err != nil {
+			return err
+		}
+		if tags.Len() != count {
+			return fmt.Errorf("Expected %d tags on %s, got %v", count, n, tags.List())
+		}
+		return nil
+	}
+}
+
 func testAccCheckResourceTagExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		var resourceID string
@@ -90,3 +185,44 @@ func testAccCheckResourceTagCreate(name, managed_from string) string {
 	}
 `, name, managed_from)
 }
+
+func testAccCheckResourceTagReplace(name string, replace bool) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_resource_instance" "instance" {
+		name     = "%s"
+		service  = "cloud-object-storage"
+		plan     = "lite"
+		location = "global"
+	}
+
+	resource "ibm_resource_tag" "tag" {
+		resource_id = ibm_resource_instance.instance.crn
+		tags        = ["env:dev"]
+		replace     = %t
+	}
+`, name, replace)
+}
+
+func testAccCheckResourceTagAccess(name string) string {
+	return fmt.Sprintf(`
+
+	resource "ibm_resource_instance" "instance" {
+		name     = "%s"
+		service  = "cloud-object-storage"
+		plan     = "lite"
+		location = "global"
+	}
+
+	resource "ibm_resource_tag" "tag" {
+		resource_id = ibm_resource_instance.instance.crn
+		tags        = ["project:tf"]
+		tag_type    = "access"
+	}
+
+	data "ibm_resource_tag" "read_tag" {
+		resource_id = ibm_resource_tag.tag.resource_id
+		tag_type    = "access"
+	}
+`, name)
+}
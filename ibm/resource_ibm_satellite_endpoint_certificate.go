@@ -0,0 +1,289 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	satelliteEndpointClientCert    = "client_cert"
+	satelliteEndpointServerCert    = "server_cert"
+	satelliteEndpointConnectorCert = "connector_cert"
+	satelliteEndpointConnectorKey  = "connector_key"
+)
+
+func resourceIbmSatelliteEndpointCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSatelliteEndpointCertificateCreate,
+		ReadContext:   resourceIbmSatelliteEndpointCertificateRead,
+		UpdateContext: resourceIbmSatelliteEndpointCertificateUpdate,
+		DeleteContext: resourceIbmSatelliteEndpointCertificateDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"location": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Location ID.",
+			},
+			"endpoint_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Endpoint ID.",
+			},
+			satelliteEndpointClientCert: &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{satelliteEndpointClientCert, satelliteEndpointServerCert, satelliteEndpointConnectorCert},
+				StateFunc:    normalizeSatelliteEndpointCert,
+				Description:  "The PEM content of the CA cert which Satellite Link trust when receiving the connection from the client application.",
+			},
+			satelliteEndpointServerCert: &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				StateFunc:   normalizeSatelliteEndpointCert,
+				Description: "The PEM content of the CA cert which Satellite Link trust when sending the connection to server application.",
+			},
+			satelliteEndpointConnectorCert: &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{satelliteEndpointConnectorKey},
+				StateFunc:    normalizeSatelliteEndpointCert,
+				Description:  "The PEM content of the end-entity cert which Satellite Link connector provide to identify itself for connecting to the client/server application.",
+			},
+			satelliteEndpointConnectorKey: &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{satelliteEndpointConnectorCert},
+				Description:  "The PEM content of the key of the connector cert.",
+			},
+			"client_cert_filename": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The filename of the client cert of the endpoint.",
+			},
+			"server_cert_filename": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The filename of the server cert of the endpoint.",
+			},
+			"connector_cert_filename": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The filename of the connector cert of the endpoint.",
+			},
+		},
+	}
+}
+
+func normalizeSatelliteEndpointCert(v interface{}) string {
+	return strings.TrimSpace(v.(string))
+}
+
+func resourceIbmSatelliteEndpointCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	location := d.Get("location").(string)
+	endpointID := d.Get("endpoint_id").(string)
+
+	err := uploadSatelliteEndpointCerts(context, d, meta, location, endpointID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", location, endpointID))
+
+	return resourceIbmSatelliteEndpointCertificateRead(context, d, meta)
+}
+
+func uploadSatelliteEndpointCerts(context context.Context, d *schema.ResourceData, meta interface{}, location, endpointID string) error {
+	satelliteLinkClient, err := meta.(ClientSession).SatellitLinkClientSession()
+	if err != nil {
+		return err
+	}
+
+	uploadEndpointCertsOptions := &satellitelinkv1.UploadEndpointCertsOptions{}
+	uploadEndpointCertsOptions.SetLocationID(location)
+	uploadEndpointCertsOptions.SetEndpointID(endpointID)
+	if v, ok := d.GetOk(satelliteEndpointClientCert); ok {
+		uploadEndpointCertsOptions.SetClientCert(ioutil.NopCloser(strings.NewReader(v.(string))))
+	}
+	if v, ok := d.GetOk(satelliteEndpointServerCert); ok {
+		uploadEndpointCertsOptions.SetServerCert(ioutil.NopCloser(strings.NewReader(v.(string))))
+	}
+	if v, ok := d.GetOk(satelliteEndpointConnectorCert); ok {
+		uploadEndpointCertsOptions.SetConnectorCert(ioutil.NopCloser(strings.NewReader(v.(string))))
+	}
+	if v, ok := d.GetOk(satelliteEndpointConnectorKey); ok {
+		uploadEndpointCertsOptions.SetConnectorKey(ioutil.NopCloser(strings.NewReader(v.(string))))
+	}
+
+	_, response, err := satelliteLinkClient.UploadEndpointCertsWithContext(context, uploadEndpointCertsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UploadEndpointCertsWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("UploadEndpointCertsWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func resourceIbmSatelliteEndpointCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satelliteLinkClient, err := meta.(ClientSession).SatellitLinkClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := sepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 2 {
+		return diag.FromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of location/endpointID", d.Id()))
+	}
+
+	getEndpointsOptions := &satellitelinkv1.GetEndpointsOptions{}
+	getEndpointsOptions.SetLocationID(parts[0])
+	getEndpointsOptions.SetEndpointID(parts[1])
+
+	endpoint, response, err := satelliteLinkClient.GetEndpointsWithContext(context, getEndpointsOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetEndpointsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetEndpointsWithContext failed %s\n%s", err, response))
+	}
+
+	var clientCert, serverCert, connectorCert *string
+	if endpoint.Certs != nil {
+		if endpoint.Certs.Client != nil && endpoint.Certs.Client.Cert != nil {
+			clientCert = endpoint.Certs.Client.Cert.Filename
+		}
+		if endpoint.Certs.Server != nil && endpoint.Certs.Server.Cert != nil {
+			serverCert = endpoint.Certs.Server.Cert.Filename
+		}
+		if endpoint.Certs.Connector != nil && endpoint.Certs.Connector.Cert != nil {
+			connectorCert = endpoint.Certs.Connector.Cert.Filename
+		}
+	}
+	if clientCert == nil && serverCert == nil && connectorCert == nil {
+		d.SetId("")
+		return nil
+	}
+
+	// The API returns the content of the uploaded certs, but not of the connector key
+	getEndpointCertsOptions := &satellitelinkv1.GetEndpointCertsOptions{}
+	getEndpointCertsOptions.SetLocationID(parts[0])
+	getEndpointCertsOptions.SetEndpointID(parts[1])
+	getEndpointCertsOptions.SetNoZip(true)
+
+	certs, response, err := satelliteLinkClient.GetEndpointCertsWithContext(context, getEndpointCertsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetEndpointCertsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetEndpointCertsWithContext failed %s\n%s", err, response))
+	}
+	downloaded := map[string]string{}
+	for _, cert := range certs.Certs {
+		if cert.Name != nil && cert.Content != nil {
+			downloaded[satelliteEndpointCertKind(*cert.Name)] = *cert.Content
+		}
+	}
+
+	for kind, filename := range map[string]*string{
+		satelliteEndpointClientCert:    clientCert,
+		satelliteEndpointServerCert:    serverCert,
+		satelliteEndpointConnectorCert: connectorCert,
+	} {
+		if err = d.Set(kind+"_filename", filename); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting %s_filename: %s", kind, err))
+		}
+		if filename == nil {
+			// The cert was removed outside of Terraform
+			d.Set(kind, "")
+			continue
+		}
+		if content, ok := downloaded[kind]; ok {
+			d.Set(kind, normalizeSatelliteEndpointCert(content))
+		}
+	}
+
+	d.Set("location", parts[0])
+	d.Set("endpoint_id", parts[1])
+
+	return nil
+}
+
+// satelliteEndpointCertKind maps the name of a downloaded cert to the argument that it was uploaded with
+func satelliteEndpointCertKind(name string) string {
+	name = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+	switch {
+	case strings.Contains(name, "client"):
+		return satelliteEndpointClientCert
+	case strings.Contains(name, "server"):
+		return satelliteEndpointServerCert
+	case strings.Contains(name, "connector") && strings.Contains(name, "key"):
+		return satelliteEndpointConnectorKey
+	case strings.Contains(name, "connector"):
+		return satelliteEndpointConnectorCert
+	}
+	return name
+}
+
+func resourceIbmSatelliteEndpointCertificateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange(satelliteEndpointClientCert) || d.HasChange(satelliteEndpointServerCert) || d.HasChange(satelliteEndpointConnectorCert) || d.HasChange(satelliteEndpointConnectorKey) {
+		// An uploaded cert can't be removed on its own, so all certs are deleted and the configured ones uploaded again
+		err := deleteSatelliteEndpointCerts(context, meta, d.Get("location").(string), d.Get("endpoint_id").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = uploadSatelliteEndpointCerts(context, d, meta, d.Get("location").(string), d.Get("endpoint_id").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmSatelliteEndpointCertificateRead(context, d, meta)
+}
+
+func deleteSatelliteEndpointCerts(context context.Context, meta interface{}, location, endpointID string) error {
+	satelliteLinkClient, err := meta.(ClientSession).SatellitLinkClientSession()
+	if err != nil {
+		return err
+	}
+
+	deleteEndpointCertsOptions := &satellitelinkv1.DeleteEndpointCertsOptions{}
+	deleteEndpointCertsOptions.SetLocationID(location)
+	deleteEndpointCertsOptions.SetEndpointID(endpointID)
+
+	_, response, err := satelliteLinkClient.DeleteEndpointCertsWithContext(context, deleteEndpointCertsOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteEndpointCertsWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("DeleteEndpointCertsWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func resourceIbmSatelliteEndpointCertificateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := deleteSatelliteEndpointCerts(context, meta, d.Get("location").(string), d.Get("endpoint_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmSatelliteEndpointCertificateBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-endpoint-cert-%d", acctest.RandIntRange(10, 100))
+	clientCert := testAccSatelliteEndpointSelfSignedCert(t, "client.example.com")
+	serverCert := testAccSatelliteEndpointSelfSignedCert(t, "db.example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckSatelliteLocation(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSatelliteEndpointCertificateConfig(name, fmt.Sprintf("client_cert = <<EOT\n%sEOT", clientCert)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_satellite_endpoint_certificate.certificate", "client_cert_filename"),
+					resource.TestCheckResourceAttr("ibm_satellite_endpoint_certificate.certificate", "server_cert_filename", ""),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmSatelliteEndpointCertificateConfig(name, fmt.Sprintf("server_cert = <<EOT\n%sEOT", serverCert)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_endpoint_certificate.certificate", "client_cert_filename", ""),
+					resource.TestCheckResourceAttrSet("ibm_satellite_endpoint_certificate.certificate", "server_cert_filename"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_satellite_endpoint_certificate.certificate",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSatelliteEndpointCertKind(t *testing.T) {
+	for name, kind := range map[string]string{
+		"client_cert.pem":    satelliteEndpointClientCert,
+		"serverCert.pem":     satelliteEndpointServerCert,
+		"connector-cert.pem": satelliteEndpointConnectorCert,
+		"connector_key.pem":  satelliteEndpointConnectorKey,
+	} {
+		if got := satelliteEndpointCertKind(name); got != kind {
+			t.Errorf("satelliteEndpointCertKind(%q) = %q, want %q", name, got, kind)
+		}
+	}
+}
+
+func testAccSatelliteEndpointSelfSignedCert(t *testing.T, commonName string) string {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func testAccCheckIbmSatelliteEndpointCertificateConfig(name, certs string) string {
+	return fmt.Sprintf(`
+		resource "ibm_satellite_endpoint" "endpoint" {
+			location           = "%s"
+			connection_type    = "location"
+			display_name       = "%s"
+			server_host        = "db.example.com"
+			server_port        = 5432
+			client_protocol    = "tls"
+			client_mutual_auth = true
+			server_protocol    = "tls"
+		}
+
+		resource "ibm_satellite_endpoint_certificate" "certificate" {
+			location    = ibm_satellite_endpoint.endpoint.location
+			endpoint_id = ibm_satellite_endpoint.endpoint.endpoint_id
+			%s
+		}
+	`, satelliteLocationID, name, certs)
+}
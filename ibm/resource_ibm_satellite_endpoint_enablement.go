@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIbmSatelliteEndpointEnablement() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSatelliteEndpointEnablementCreate,
+		ReadContext:   resourceIbmSatelliteEndpointEnablementRead,
+		UpdateContext: resourceIbmSatelliteEndpointEnablementUpdate,
+		DeleteContext: resourceIbmSatelliteEndpointEnablementDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"location": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Location ID.",
+			},
+			"endpoint_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Endpoint ID.",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Enable or disable the endpoint.",
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the endpoint.",
+			},
+		},
+	}
+}
+
+func resourceIbmSatelliteEndpointEnablementCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	location := d.Get("location").(string)
+	endpointID := d.Get("endpoint_id").(string)
+
+	err := updateSatelliteEndpointEnablement(context, meta, location, endpointID, d.Get("enabled").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", location, endpointID))
+
+	return resourceIbmSatelliteEndpointEnablementRead(context, d, meta)
+}
+
+func updateSatelliteEndpointEnablement(context context.Context, meta interface{}, location, endpointID string, enabled bool) error {
+	satelliteLinkClient, err := meta.(ClientSession).SatellitLinkClientSession()
+	if err != nil {
+		return err
+	}
+
+	getEndpointsOptions := &satellitelinkv1.GetEndpointsOptions{}
+	getEndpointsOptions.SetLocationID(location)
+	getEndpointsOptions.SetEndpointID(endpointID)
+
+	endpoint, response, err := satelliteLinkClient.GetEndpointsWithContext(context, getEndpointsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetEndpointsWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("GetEndpointsWithContext failed %s\n%s", err, response)
+	}
+
+	updateEndpointsOptions := &satellitelinkv1.UpdateEndpointsOptions{}
+	updateEndpointsOptions.SetLocationID(location)
+	updateEndpointsOptions.SetEndpointID(endpointID)
+	updateEndpointsOptions.SetEnabled(enabled)
+	// The server protocol changes to its default value when it is omitted, even though the update is a PATCH
+	if endpoint.ServerProtocol != nil {
+		updateEndpointsOptions.SetServerProtocol(*endpoint.ServerProtocol)
+	}
+
+	_, response, err = satelliteLinkClient.UpdateEndpointsWithContext(context, updateEndpointsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateEndpointsWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("UpdateEndpointsWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func resourceIbmSatelliteEndpointEnablementRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satelliteLinkClient, err := meta.(ClientSession).SatellitLinkClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := sepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getEndpointsOptions := &satellitelinkv1.GetEndpointsOptions{}
+	getEndpointsOptions.SetLocationID(parts[0])
+	getEndpointsOptions.SetEndpointID(parts[1])
+
+	endpoint, response, err := satelliteLinkClient.GetEndpointsWithContext(context, getEndpointsOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetEndpointsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetEndpointsWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("location", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting location: %s", err))
+	}
+	if err = d.Set("endpoint_id", parts[1]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting endpoint_id: %s", err))
+	}
+	if err = d.Set("status", endpoint.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+	if err = d.Set("enabled", endpoint.Status != nil && *endpoint.Status == satellitelinkv1.Endpoint_Status_Enabled); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting enabled: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSatelliteEndpointEnablementUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("enabled") {
+		err := updateSatelliteEndpointEnablement(context, meta, d.Get("location").(string), d.Get("endpoint_id").(string), d.Get("enabled").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmSatelliteEndpointEnablementRead(context, d, meta)
+}
+
+// An endpoint is enabled when it is created, so the endpoint is enabled again when the resource is destroyed
+func resourceIbmSatelliteEndpointEnablementDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("enabled").(bool) {
+		err := updateSatelliteEndpointEnablement(context, meta, d.Get("location").(string), d.Get("endpoint_id").(string), true)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmSatelliteEndpointEnablementBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-endpoint-enablement-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckSatelliteLocation(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSatelliteEndpointEnablementConfig(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_endpoint_enablement.enablement", "enabled", "false"),
+					resource.TestCheckResourceAttr("ibm_satellite_endpoint_enablement.enablement", "status", "disabled"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmSatelliteEndpointEnablementConfig(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_endpoint_enablement.enablement", "enabled", "true"),
+					resource.TestCheckResourceAttr("ibm_satellite_endpoint_enablement.enablement", "status", "enabled"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_satellite_endpoint_enablement.enablement",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSatelliteEndpointEnablementConfig(name string, enabled bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_satellite_endpoint" "endpoint" {
+			location        = "%s"
+			connection_type = "location"
+			display_name    = "%s"
+			server_host     = "db.example.com"
+			server_port     = 5432
+			client_protocol = "tcp"
+		}
+
+		resource "ibm_satellite_endpoint_enablement" "enablement" {
+			location    = ibm_satellite_endpoint.endpoint.location
+			endpoint_id = ibm_satellite_endpoint.endpoint.endpoint_id
+			enabled     = %t
+		}
+	`, satelliteLocationID, name, enabled)
+}
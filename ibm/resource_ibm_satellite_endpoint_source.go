@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIbmSatelliteEndpointSource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSatelliteEndpointSourceCreate,
+		ReadContext:   resourceIbmSatelliteEndpointSourceRead,
+		UpdateContext: resourceIbmSatelliteEndpointSourceUpdate,
+		DeleteContext: resourceIbmSatelliteEndpointSourceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"location": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Location ID.",
+			},
+			"endpoint_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Endpoint ID.",
+			},
+			"source_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Source ID.",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the source is enabled for the endpoint.",
+			},
+			"pending": &schema.Schema{
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the change of the source is still being applied to the endpoint.",
+			},
+			"last_change": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last time the source of the endpoint was changed.",
+			},
+		},
+	}
+}
+
+func resourceIbmSatelliteEndpointSourceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	location := d.Get("location").(string)
+	endpointID := d.Get("endpoint_id").(string)
+	sourceID := d.Get("source_id").(string)
+
+	err := updateSatelliteEndpointSource(context, meta, location, endpointID, sourceID, d.Get("enabled").(bool))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", location, endpointID, sourceID))
+
+	return resourceIbmSatelliteEndpointSourceRead(context, d, meta)
+}
+
+func updateSatelliteEndpointSource(context context.Context, meta interface{}, location, endpointID, sourceID string, enabled bool) error {
+	satelliteLinkClient, err := meta.(ClientSession).SatellitLinkClientSession()
+	if err != nil {
+		return err
+	}
+
+	source := satellitelinkv1.SourceStatusRequestObject{}
+	source.SourceID = &sourceID
+	source.Enabled = &enabled
+
+	updateEndpointSourcesOptions := &satellitelinkv1.UpdateEndpointSourcesOptions{}
+	updateEndpointSourcesOptions.SetLocationID(location)
+	updateEndpointSourcesOptions.SetEndpointID(endpointID)
+	updateEndpointSourcesOptions.SetSources([]satellitelinkv1.SourceStatusRequestObject{source})
+
+	_, response, err := satelliteLinkClient.UpdateEndpointSourcesWithContext(context, updateEndpointSourcesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateEndpointSourcesWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("UpdateEndpointSourcesWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func resourceIbmSatelliteEndpointSourceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satelliteLinkClient, err := meta.(ClientSession).SatellitLinkClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := sepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 3 {
+		return diag.FromErr(fmt.Errorf("Incorrect ID %s: ID should be a combination of location/endpointID/sourceID", d.Id()))
+	}
+
+	listEndpointSourcesOptions := &satellitelinkv1.ListEndpointSourcesOptions{}
+	listEndpointSourcesOptions.SetLocationID(parts[0])
+	listEndpointSourcesOptions.SetEndpointID(parts[1])
+
+	sources, response, err := satelliteLinkClient.ListEndpointSourcesWithContext(context, listEndpointSourcesOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] ListEndpointSourcesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListEndpointSourcesWithContext failed %s\n%s", err, response))
+	}
+
+	var source *satellitelinkv1.SourceStatusObject
+	for i := range sources.Sources {
+		if sources.Sources[i].SourceID != nil && *sources.Sources[i].SourceID == parts[2] {
+			source = &sources.Sources[i]
+		}
+	}
+	if source == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("location", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting location: %s", err))
+	}
+	if err = d.Set("endpoint_id", parts[1]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting endpoint_id: %s", err))
+	}
+	if err = d.Set("source_id", source.SourceID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting source_id: %s", err))
+	}
+	if err = d.Set("enabled", source.Enabled != nil && *source.Enabled); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting enabled: %s", err))
+	}
+	if err = d.Set("pending", source.Pending != nil && *source.Pending); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting pending: %s", err))
+	}
+	if err = d.Set("last_change", source.LastChange); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting last_change: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSatelliteEndpointSourceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("enabled") {
+		err := updateSatelliteEndpointSource(context, meta, d.Get("location").(string), d.Get("endpoint_id").(string), d.Get("source_id").(string), d.Get("enabled").(bool))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmSatelliteEndpointSourceRead(context, d, meta)
+}
+
+// The source can't be detached from the endpoint, so it is disabled
+func resourceIbmSatelliteEndpointSourceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	err := updateSatelliteEndpointSource(context, meta, d.Get("location").(string), d.Get("endpoint_id").(string), d.Get("source_id").(string), false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
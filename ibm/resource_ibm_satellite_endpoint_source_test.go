@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmSatelliteEndpointSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-endpoint-source-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckSatelliteLocation(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSatelliteEndpointSourceConfig(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_endpoint_source.endpoint_source", "enabled", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmSatelliteEndpointSourceConfig(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_endpoint_source.endpoint_source", "enabled", "false"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_satellite_endpoint_source.endpoint_source",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSatelliteEndpointSourceConfig(name string, enabled bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_satellite_endpoint" "endpoint" {
+			location        = "%[1]s"
+			connection_type = "location"
+			display_name    = "%[2]s"
+			server_host     = "db.example.com"
+			server_port     = 5432
+			client_protocol = "tcp"
+		}
+
+		resource "ibm_satellite_link_source" "source" {
+			location    = "%[1]s"
+			type        = "user"
+			source_name = "%[2]s"
+			addresses   = ["10.0.0.0/24"]
+		}
+
+		resource "ibm_satellite_endpoint_source" "endpoint_source" {
+			location    = "%[1]s"
+			endpoint_id = ibm_satellite_endpoint.endpoint.endpoint_id
+			source_id   = ibm_satellite_link_source.source.source_id
+			enabled     = %[3]t
+		}
+	`, satelliteLocationID, name, enabled)
+}
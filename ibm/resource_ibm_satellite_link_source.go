@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/satellitelinkv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func resourceIbmSatelliteLinkSource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSatelliteLinkSourceCreate,
+		ReadContext:   resourceIbmSatelliteLinkSourceRead,
+		UpdateContext: resourceIbmSatelliteLinkSourceUpdate,
+		DeleteContext: resourceIbmSatelliteLinkSourceDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"location": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Location ID.",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_satellite_link_source", "type"),
+				Description:  "The type of the source. A 'user' source allow-lists IP addresses or CIDRs, a 'service' source allow-lists service CRNs.",
+			},
+			"source_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the source, should be unique under each location. Source names must start with a letter and end with an alphanumeric character, can contain letters, numbers, and hyphen (-), and must be 63 characters or fewer.",
+			},
+			"addresses": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IP addresses or CIDRs of a 'user' source, or the service CRNs of a 'service' source.",
+			},
+			"source_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Source ID.",
+			},
+			"created_at": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of creation of the source.",
+			},
+			"last_change": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp of the last change of the source.",
+			},
+		},
+	}
+}
+
+func resourceIbmSatelliteLinkSourceValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "service, user",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_satellite_link_source", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIbmSatelliteLinkSourceCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satelliteLinkClient, err := meta.(ClientSession).SatellitLinkClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	createSourcesOptions := &satellitelinkv1.CreateSourcesOptions{}
+	createSourcesOptions.SetLocationID(d.Get("location").(string))
+	createSourcesOptions.SetType(d.Get("type").(string))
+	createSourcesOptions.SetSourceName(d.Get("source_name").(string))
+	createSourcesOptions.SetAddresses(expandStringList(d.Get("addresses").(*schema.Set).List()))
+
+	source, response, err := satelliteLinkClient.CreateSourcesWithContext(context, createSourcesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSourcesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateSourcesWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", *createSourcesOptions.LocationID, *source.SourceID))
+
+	return resourceIbmSatelliteLinkSourceRead(context, d, meta)
+}
+
+func resourceIbmSatelliteLinkSourceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satelliteLinkClient, err := meta.(ClientSession).SatellitLinkClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := sepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	source, response, err := getSatelliteLinkSource(context, satelliteLinkClient, parts[0], parts[1])
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] ListSourcesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListSourcesWithContext failed %s\n%s", err, response))
+	}
+	if source == nil {
+		d.SetId("")
+		return nil
+	}
+
+	if err = d.Set("location", parts[0]); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting location: %s", err))
+	}
+	if err = d.Set("type", source.Type); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting type: %s", err))
+	}
+	if err = d.Set("source_name", source.SourceName); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting source_name: %s", err))
+	}
+	if err = d.Set("addresses", newStringSet(schema.HashString, source.Addresses)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting addresses: %s", err))
+	}
+	if err = d.Set("source_id", source.SourceID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting source_id: %s", err))
+	}
+	if err = d.Set("created_at", source.CreatedAt); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_at: %s", err))
+	}
+	if err = d.Set("last_change", source.LastChange); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting last_change: %s", err))
+	}
+
+	return nil
+}
+
+// getSatelliteLinkSource looks up a source of the location, the API has no call to get a single source
+func getSatelliteLinkSource(context context.Context, satelliteLinkClient *satellitelinkv1.SatelliteLinkV1, location, sourceID string) (*satellitelinkv1.Source, *core.DetailedResponse, error) {
+	listSourcesOptions := &satellitelinkv1.ListSourcesOptions{}
+	listSourcesOptions.SetLocationID(location)
+
+	sources, response, err := satelliteLinkClient.ListSourcesWithContext(context, listSourcesOptions)
+	if err != nil {
+		return nil, response, err
+	}
+	for _, source := range sources.Sources {
+		if source.SourceID != nil && *source.SourceID == sourceID {
+			return &source, response, nil
+		}
+	}
+	return nil, response, nil
+}
+
+func resourceIbmSatelliteLinkSourceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satelliteLinkClient, err := meta.(ClientSession).SatellitLinkClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := sepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("source_name") || d.HasChange("addresses") {
+		updateSourcesOptions := &satellitelinkv1.UpdateSourcesOptions{}
+		updateSourcesOptions.SetLocationID(parts[0])
+		updateSourcesOptions.SetSourceID(parts[1])
+		updateSourcesOptions.SetSourceName(d.Get("source_name").(string))
+		updateSourcesOptions.SetAddresses(expandStringList(d.Get("addresses").(*schema.Set).List()))
+
+		_, response, err := satelliteLinkClient.UpdateSourcesWithContext(context, updateSourcesOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSourcesWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateSourcesWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIbmSatelliteLinkSourceRead(context, d, meta)
+}
+
+func resourceIbmSatelliteLinkSourceDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	satelliteLinkClient, err := meta.(ClientSession).SatellitLinkClientSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := sepIdParts(d.Id(), "/")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteSourcesOptions := &satellitelinkv1.DeleteSourcesOptions{}
+	deleteSourcesOptions.SetLocationID(parts[0])
+	deleteSourcesOptions.SetSourceID(parts[1])
+
+	_, response, err := satelliteLinkClient.DeleteSourcesWithContext(context, deleteSourcesOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteSourcesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteSourcesWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmSatelliteLinkSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-source-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckSatelliteLocation(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSatelliteLinkSourceConfig(name, `["10.0.0.0/24"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_link_source.source", "type", "user"),
+					resource.TestCheckResourceAttr("ibm_satellite_link_source.source", "addresses.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_satellite_link_source.source", "source_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmSatelliteLinkSourceConfig(name, `["10.0.0.0/24", "192.168.10.5"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_satellite_link_source.source", "addresses.#", "2"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_satellite_link_source.source",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSatelliteLinkSourceConfig(name, addresses string) string {
+	return fmt.Sprintf(`
+		resource "ibm_satellite_link_source" "source" {
+			location    = "%s"
+			type        = "user"
+			source_name = "%s"
+			addresses   = %s
+		}
+	`, satelliteLocationID, name, addresses)
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMSmArbitrarySecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSmArbitrarySecretCreate,
+		ReadContext:   resourceIBMSmArbitrarySecretRead,
+		UpdateContext: resourceIBMSmArbitrarySecretUpdate,
+		DeleteContext: resourceIBMSmSecretDelete(smSecretTypeArbitrary),
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMSmExpirationDateDiff,
+
+		Schema: resourceIBMSmSecretSchema(map[string]*schema.Schema{
+			"payload": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The secret data. Changing the payload creates a new version of the secret. The payload is not read back from the instance.",
+			},
+			"expiration_date": resourceIBMSmExpirationDateSchema(),
+		}),
+	}
+}
+
+func resourceIBMSmArbitrarySecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret := &smSecret{
+		Payload: d.Get("payload").(string),
+	}
+	if v, ok := d.GetOk("expiration_date"); ok {
+		secret.ExpirationDate = ptrToString(v.(string))
+	}
+
+	if _, _, err := resourceIBMSmSecretCreate(context, d, meta, smSecretTypeArbitrary, secret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSmArbitrarySecretRead(context, d, meta)
+}
+
+func resourceIBMSmArbitrarySecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := resourceIBMSmSecretRead(context, d, meta, smSecretTypeArbitrary)
+	if err != nil || secret == nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("expiration_date", secret.ExpirationDate)
+
+	return nil
+}
+
+func resourceIBMSmArbitrarySecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, secretID, err := resourceIBMSmSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = resourceIBMSmSecretUpdateMetadata(context, d, client, smSecretTypeArbitrary, secretID, resourceIBMSmExpirationDateMetadata(d)); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("payload") {
+		body := map[string]interface{}{"payload": d.Get("payload").(string)}
+		if err = resourceIBMSmSecretRotate(context, client, smSecretTypeArbitrary, secretID, body); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSmArbitrarySecretRead(context, d, meta)
+}
@@ -0,0 +1,98 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMSmArbitrarySecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-arbitrary-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSmSecretDestroy("ibm_sm_arbitrary_secret", smSecretTypeArbitrary),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSmArbitrarySecretConfig(name, "secret-payload"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.secret", "name", name),
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.secret", "labels.#", "2"),
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.secret", "versions_total", "1"),
+					resource.TestCheckResourceAttrPair("ibm_sm_arbitrary_secret.secret", "secret_group_id", "ibm_sm_secret_group.group", "secret_group_id"),
+					resource.TestCheckResourceAttrSet("ibm_sm_arbitrary_secret.secret", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSmArbitrarySecretConfig(name, "secret-payload-rotated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.secret", "versions_total", "2"),
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.secret", "versions.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "ibm_sm_arbitrary_secret.secret",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"payload"},
+			},
+		},
+	})
+}
+
+// testAccCheckIBMSmSecretDestroy checks that the secrets of the resource type are gone, deleted secrets
+// are either not found or destroyed.
+func testAccCheckIBMSmSecretDestroy(resourceType, secretType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			instanceID, secretID, err := secretsManagerIDParts(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			client, err := getSecretsManagerInstanceClient(testAccProvider.Meta(), instanceID, "public")
+			if err != nil {
+				return err
+			}
+			secret, response, err := client.GetSecretMetadata(context.Background(), secretType, secretID)
+			if err == nil {
+				if secret.State != nil && *secret.State == smSecretStateDestroyed {
+					continue
+				}
+				return fmt.Errorf("Secret still exists: %s", rs.Primary.ID)
+			} else if response == nil || response.StatusCode != 404 {
+				return fmt.Errorf("Error checking for secret (%s) has been destroyed: %s", rs.Primary.ID, err)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMSmArbitrarySecretConfig(name, payload string) string {
+	return fmt.Sprintf(`
+	resource "ibm_sm_secret_group" "group" {
+		instance_id = "%[1]s"
+		name        = "%[2]s-group"
+	}
+
+	resource "ibm_sm_arbitrary_secret" "secret" {
+		instance_id     = "%[1]s"
+		secret_group_id = ibm_sm_secret_group.group.secret_group_id
+		name            = "%[2]s"
+		description     = "Arbitrary secret created by terraform"
+		labels          = ["terraform", "test"]
+		payload         = "%[3]s"
+		expiration_date = "2030-01-01T00:00:00Z"
+	}
+	`, secretsManagerInstanceID, name, payload)
+}
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMSmIamCredentialsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSmIamCredentialsConfigurationCreate,
+		ReadContext:   resourceIBMSmIamCredentialsConfigurationRead,
+		UpdateContext: resourceIBMSmIamCredentialsConfigurationCreate,
+		DeleteContext: resourceIBMSmIamCredentialsConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The GUID of the Secrets Manager instance.",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "public",
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "The endpoint type to communicate with the instance, public or private.",
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "An IBM Cloud API key that can create and manage service IDs, the instance uses it to generate the API keys of iam_credentials secrets.",
+			},
+		},
+	}
+}
+
+func resourceIBMSmIamCredentialsConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	client, err := getSecretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config := map[string]interface{}{"api_key": d.Get("api_key").(string)}
+	response, err := client.PutConfig(context, smSecretTypeIamCredentials, config)
+	if err != nil {
+		log.Printf("[DEBUG] PutConfig failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutConfig failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, smSecretTypeIamCredentials))
+
+	return resourceIBMSmIamCredentialsConfigurationRead(context, d, meta)
+}
+
+func resourceIBMSmIamCredentialsConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, _, err := secretsManagerIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config, response, err := client.GetConfig(context, smSecretTypeIamCredentials)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetConfig failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetConfig failed %s\n%s", err, response))
+	}
+	if _, ok := config["api_key"]; !ok {
+		d.SetId("")
+		return nil
+	}
+
+	// The API key is not read back, the instance returns it masked
+	d.Set("instance_id", instanceID)
+	if d.Get("endpoint_type").(string) == "" {
+		d.Set("endpoint_type", "public")
+	}
+
+	return nil
+}
+
+func resourceIBMSmIamCredentialsConfigurationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The secrets engine configuration can't be removed from the instance, it is only removed from
+	// the state.
+	log.Printf("[WARN] The iam_credentials configuration stays in the instance, removing it from state only")
+	d.SetId("")
+
+	return nil
+}
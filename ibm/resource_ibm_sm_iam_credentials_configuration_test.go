@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSmIamCredentialsConfigurationBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-sm-engine-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSmIamCredentialsConfigurationConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_iam_credentials_configuration.config", "id", fmt.Sprintf("%s/iam_credentials", secretsManagerInstanceID)),
+				),
+			},
+			{
+				ResourceName:            "ibm_sm_iam_credentials_configuration.config",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSmIamCredentialsConfigurationConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_service_id" "engine" {
+		name = "%[2]s"
+	}
+
+	resource "ibm_iam_service_api_key" "engine" {
+		name           = "%[2]s"
+		iam_service_id = ibm_iam_service_id.engine.iam_id
+	}
+
+	resource "ibm_sm_iam_credentials_configuration" "config" {
+		instance_id = "%[1]s"
+		api_key     = ibm_iam_service_api_key.engine.apikey
+	}
+	`, secretsManagerInstanceID, name)
+}
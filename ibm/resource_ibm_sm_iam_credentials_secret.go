@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMSmIamCredentialsSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSmIamCredentialsSecretCreate,
+		ReadContext:   resourceIBMSmIamCredentialsSecretRead,
+		UpdateContext: resourceIBMSmIamCredentialsSecretUpdate,
+		DeleteContext: resourceIBMSmSecretDelete(smSecretTypeIamCredentials),
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: resourceIBMSmSecretSchema(map[string]*schema.Schema{
+			"ttl": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateSmTTL,
+				DiffSuppressFunc: suppressEquivalentSmTTL,
+				Description:      "The time-to-live of the generated API keys, either a number of seconds or a duration such as 120m or 24h.",
+			},
+			"access_groups": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the access groups that define the capabilities of the generated service ID and API keys.",
+			},
+			"reuse_api_key": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Reuse the service ID and API key for future read operations. If false, a new API key is generated each time the secret is read.",
+			},
+			"service_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The service ID under which the API keys are created.",
+			},
+			"api_key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the API key that is generated for the secret.",
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key that is generated for the secret, only set when reuse_api_key is true.",
+			},
+			"next_rotation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the secret is scheduled for automatic rotation.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSmIamCredentialsSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	reuseAPIKey := d.Get("reuse_api_key").(bool)
+	secret := &smSecret{
+		TTL:          d.Get("ttl").(string),
+		AccessGroups: expandStringList(d.Get("access_groups").([]interface{})),
+		ReuseAPIKey:  &reuseAPIKey,
+	}
+
+	client, created, err := resourceIBMSmSecretCreate(context, d, meta, smSecretTypeIamCredentials, secret)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The API key is generated when the secret is read, it is only kept when it is reused
+	if reuseAPIKey {
+		credentials, response, err := client.GetSecret(context, smSecretTypeIamCredentials, *created.ID)
+		if err != nil {
+			log.Printf("[DEBUG] GetSecret failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetSecret failed %s\n%s", err, response))
+		}
+		d.Set("api_key", credentials.APIKey)
+		if credentials.APIKey == nil {
+			if v, ok := credentials.SecretData["api_key"]; ok {
+				d.Set("api_key", fmt.Sprint(v))
+			}
+		}
+	}
+
+	return resourceIBMSmIamCredentialsSecretRead(context, d, meta)
+}
+
+func resourceIBMSmIamCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := resourceIBMSmSecretRead(context, d, meta, smSecretTypeIamCredentials)
+	if err != nil || secret == nil {
+		return diag.FromErr(err)
+	}
+
+	if secret.TTL != nil {
+		d.Set("ttl", flattenSmTTL(secret.TTL))
+	}
+	if secret.AccessGroups != nil {
+		d.Set("access_groups", secret.AccessGroups)
+	}
+	if secret.ReuseAPIKey != nil {
+		d.Set("reuse_api_key", secret.ReuseAPIKey)
+	}
+	if secret.ServiceID != nil {
+		d.Set("service_id", secret.ServiceID)
+	}
+	if secret.APIKeyID != nil {
+		d.Set("api_key_id", secret.APIKeyID)
+	}
+	d.Set("next_rotation_date", secret.NextRotationDate)
+
+	return nil
+}
+
+func resourceIBMSmIamCredentialsSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, secretID, err := resourceIBMSmSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var metadata *smSecret
+	if d.HasChange("ttl") {
+		metadata = &smSecret{TTL: d.Get("ttl").(string)}
+	}
+	if err = resourceIBMSmSecretUpdateMetadata(context, d, client, smSecretTypeIamCredentials, secretID, metadata); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSmIamCredentialsSecretRead(context, d, meta)
+}
+
+// smTTLSeconds returns the TTL in seconds, the TTL is either a number of seconds or a duration.
+func smTTLSeconds(ttl string) (int64, error) {
+	if seconds, err := strconv.ParseInt(ttl, 10, 64); err == nil {
+		return seconds, nil
+	}
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		return 0, err
+	}
+	return int64(duration.Seconds()), nil
+}
+
+// flattenSmTTL formats the TTL of a secret, which is returned either as a number of seconds or as a duration.
+// A number is decoded as float64, which fmt would print in exponent notation from 1e6 seconds.
+func flattenSmTTL(ttl interface{}) string {
+	if seconds, ok := ttl.(float64); ok {
+		return strconv.FormatInt(int64(seconds), 10)
+	}
+	return fmt.Sprint(ttl)
+}
+
+func validateSmTTL(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := smTTLSeconds(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a number of seconds or a duration such as 24h, got %q", k, v.(string)))
+	}
+	return
+}
+
+// suppressEquivalentSmTTL suppresses the diff between a duration and the number of seconds that the
+// instance returns.
+func suppressEquivalentSmTTL(k, old, new string, d *schema.ResourceData) bool {
+	oldSeconds, err := smTTLSeconds(old)
+	if err != nil {
+		return false
+	}
+	newSeconds, err := smTTLSeconds(new)
+	if err != nil {
+		return false
+	}
+	return oldSeconds == newSeconds
+}
@@ -0,0 +1,107 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSmIamCredentialsSecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-iam-credentials-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSmSecretDestroy("ibm_sm_iam_credentials_secret", smSecretTypeIamCredentials),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSmIamCredentialsSecretConfig(name, "24h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_iam_credentials_secret.secret", "ttl", "24h"),
+					resource.TestCheckResourceAttr("ibm_sm_iam_credentials_secret.secret", "access_groups.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_sm_iam_credentials_secret.secret", "service_id"),
+					resource.TestCheckResourceAttrSet("ibm_sm_iam_credentials_secret.secret", "api_key"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSmIamCredentialsSecretConfig(name, "172800"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_iam_credentials_secret.secret", "ttl", "172800"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSmIamCredentialsSecretConfig(name, ttl string) string {
+	return fmt.Sprintf(`
+	resource "ibm_iam_access_group" "group" {
+		name = "%[2]s"
+	}
+
+	resource "ibm_sm_iam_credentials_secret" "secret" {
+		instance_id   = "%[1]s"
+		name          = "%[2]s"
+		ttl           = "%[3]s"
+		access_groups = [ibm_iam_access_group.group.id]
+	}
+	`, secretsManagerInstanceID, name, ttl)
+}
+
+func TestSmTTLSeconds(t *testing.T) {
+	testCases := []struct {
+		ttl     string
+		seconds int64
+		err     bool
+	}{
+		{ttl: "3600", seconds: 3600},
+		{ttl: "1h", seconds: 3600},
+		{ttl: "90m", seconds: 5400},
+		{ttl: "24h", seconds: 86400},
+		{ttl: "1d", err: true},
+		{ttl: "", err: true},
+	}
+	for _, tc := range testCases {
+		seconds, err := smTTLSeconds(tc.ttl)
+		if tc.err {
+			if err == nil {
+				t.Errorf("smTTLSeconds(%q) expected an error", tc.ttl)
+			}
+			continue
+		}
+		if err != nil || seconds != tc.seconds {
+			t.Errorf("smTTLSeconds(%q) = %d, %v, want %d", tc.ttl, seconds, err, tc.seconds)
+		}
+	}
+
+	if !suppressEquivalentSmTTL("ttl", "86400", "24h", nil) {
+		t.Errorf("expected 86400 and 24h to be equivalent")
+	}
+	if suppressEquivalentSmTTL("ttl", "86400", "12h", nil) {
+		t.Errorf("expected 86400 and 12h to differ")
+	}
+}
+
+func TestFlattenSmTTL(t *testing.T) {
+	testCases := []struct {
+		ttl      interface{}
+		expected string
+	}{
+		{ttl: float64(3600), expected: "3600"},
+		{ttl: float64(7776000), expected: "7776000"},
+		{ttl: "2160h", expected: "2160h"},
+	}
+	for _, tc := range testCases {
+		if ttl := flattenSmTTL(tc.ttl); ttl != tc.expected {
+			t.Errorf("flattenSmTTL(%v) = %q, want %q", tc.ttl, ttl, tc.expected)
+		}
+	}
+	if !suppressEquivalentSmTTL("ttl", flattenSmTTL(float64(7776000)), "2160h", nil) {
+		t.Errorf("expected a TTL of 7776000 seconds and 2160h to be equivalent")
+	}
+}
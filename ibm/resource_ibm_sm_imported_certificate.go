@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMSmImportedCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSmImportedCertificateCreate,
+		ReadContext:   resourceIBMSmImportedCertificateRead,
+		UpdateContext: resourceIBMSmImportedCertificateUpdate,
+		DeleteContext: resourceIBMSmSecretDelete(smSecretTypeImportedCert),
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: resourceIBMSmCertificateSchema(map[string]*schema.Schema{
+			"certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PEM encoded certificate to import. Changing the certificate, private key or intermediate certificate creates a new version of the secret.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key of the certificate. The private key is not read back from the instance.",
+			},
+			"intermediate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The PEM encoded intermediate certificate of the certificate.",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fully qualified domain name or host domain name of the certificate.",
+			},
+			"alt_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The alternative names of the certificate.",
+			},
+			"key_algorithm": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier for the cryptographic algorithm used to generate the public key of the certificate.",
+			},
+			"intermediate_included": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the certificate was imported with an intermediate certificate.",
+			},
+			"private_key_included": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates whether the certificate was imported with a private key.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSmImportedCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret := &smSecret{
+		Certificate: ptrToString(d.Get("certificate").(string)),
+	}
+	if v, ok := d.GetOk("private_key"); ok {
+		secret.PrivateKey = ptrToString(v.(string))
+	}
+	if v, ok := d.GetOk("intermediate"); ok {
+		secret.Intermediate = ptrToString(v.(string))
+	}
+
+	if _, _, err := resourceIBMSmSecretCreate(context, d, meta, smSecretTypeImportedCert, secret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSmImportedCertificateRead(context, d, meta)
+}
+
+func resourceIBMSmImportedCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := resourceIBMSmSecretRead(context, d, meta, smSecretTypeImportedCert)
+	if err != nil || secret == nil {
+		return diag.FromErr(err)
+	}
+
+	if err = setSmCertificateAttributes(d, secret); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("common_name", secret.CommonName)
+	d.Set("alt_names", secret.AltNames)
+	d.Set("key_algorithm", secret.KeyAlgorithm)
+	d.Set("intermediate_included", secret.IntermediateIncluded)
+	d.Set("private_key_included", secret.PrivateKeyIncluded)
+
+	return nil
+}
+
+func resourceIBMSmImportedCertificateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, secretID, err := resourceIBMSmSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = resourceIBMSmSecretUpdateMetadata(context, d, client, smSecretTypeImportedCert, secretID, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges("certificate", "private_key", "intermediate") {
+		body := map[string]interface{}{"certificate": d.Get("certificate").(string)}
+		if v, ok := d.GetOk("private_key"); ok {
+			body["private_key"] = v.(string)
+		}
+		if v, ok := d.GetOk("intermediate"); ok {
+			body["intermediate"] = v.(string)
+		}
+		if err = resourceIBMSmSecretRotate(context, client, smSecretTypeImportedCert, secretID, body); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSmImportedCertificateRead(context, d, meta)
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSmImportedCertificateBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-imported-cert-%d", acctest.RandIntRange(10, 100))
+	commonName := "tf.example.com"
+	certificate, privateKey := testAccSmSelfSignedCertificate(t, commonName)
+	certificateUpdate, privateKeyUpdate := testAccSmSelfSignedCertificate(t, commonName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSmSecretDestroy("ibm_sm_imported_certificate", smSecretTypeImportedCert),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSmImportedCertificateConfig(name, certificate, privateKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_imported_certificate.cert", "common_name", commonName),
+					resource.TestCheckResourceAttr("ibm_sm_imported_certificate.cert", "private_key_included", "true"),
+					resource.TestCheckResourceAttr("ibm_sm_imported_certificate.cert", "intermediate_included", "false"),
+					resource.TestCheckResourceAttrSet("ibm_sm_imported_certificate.cert", "serial_number"),
+					resource.TestCheckResourceAttrSet("ibm_sm_imported_certificate.cert", "validity.0.not_after"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSmImportedCertificateConfig(name, certificateUpdate, privateKeyUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_imported_certificate.cert", "versions_total", "2"),
+				),
+			},
+			{
+				ResourceName:            "ibm_sm_imported_certificate.cert",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"certificate", "private_key", "intermediate"},
+			},
+		},
+	})
+}
+
+// testAccSmSelfSignedCertificate returns a PEM encoded self signed certificate and its private key.
+func testAccSmSelfSignedCertificate(t *testing.T, commonName string) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Error generating the private key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().AddDate(1, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Error creating the certificate: %s", err)
+	}
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return string(certificate), string(privateKey)
+}
+
+func testAccCheckIBMSmImportedCertificateConfig(name, certificate, privateKey string) string {
+	return fmt.Sprintf(`
+	resource "ibm_sm_imported_certificate" "cert" {
+		instance_id = "%s"
+		name        = "%s"
+		certificate = <<EOT
+%sEOT
+		private_key = <<EOT
+%sEOT
+	}
+	`, secretsManagerInstanceID, name, certificate, privateKey)
+}
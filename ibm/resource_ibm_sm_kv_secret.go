@@ -0,0 +1,70 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMSmKvSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSmKvSecretCreate,
+		ReadContext:   resourceIBMSmKvSecretRead,
+		UpdateContext: resourceIBMSmKvSecretUpdate,
+		DeleteContext: resourceIBMSmSecretDelete(smSecretTypeKv),
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: resourceIBMSmSecretSchema(map[string]*schema.Schema{
+			"payload": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The key-value pairs of the secret. Changing the payload creates a new version of the secret. The payload is not read back from the instance.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSmKvSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret := &smSecret{
+		Payload: d.Get("payload").(map[string]interface{}),
+	}
+
+	if _, _, err := resourceIBMSmSecretCreate(context, d, meta, smSecretTypeKv, secret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSmKvSecretRead(context, d, meta)
+}
+
+func resourceIBMSmKvSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if _, err := resourceIBMSmSecretRead(context, d, meta, smSecretTypeKv); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceIBMSmKvSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, secretID, err := resourceIBMSmSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = resourceIBMSmSecretUpdateMetadata(context, d, client, smSecretTypeKv, secretID, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("payload") {
+		body := map[string]interface{}{"payload": d.Get("payload").(map[string]interface{})}
+		if err = resourceIBMSmSecretRotate(context, client, smSecretTypeKv, secretID, body); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSmKvSecretRead(context, d, meta)
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSmKvSecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-kv-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSmSecretDestroy("ibm_sm_kv_secret", smSecretTypeKv),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSmKvSecretConfig(name, "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_kv_secret.secret", "name", name),
+					resource.TestCheckResourceAttr("ibm_sm_kv_secret.secret", "versions_total", "1"),
+					resource.TestCheckResourceAttrSet("ibm_sm_kv_secret.secret", "secret_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSmKvSecretConfig(name, "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_kv_secret.secret", "versions_total", "2"),
+				),
+			},
+			{
+				ResourceName:            "ibm_sm_kv_secret.secret",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"payload"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSmKvSecretConfig(name, version string) string {
+	return fmt.Sprintf(`
+	resource "ibm_sm_kv_secret" "secret" {
+		instance_id = "%s"
+		name        = "%s"
+		payload = {
+			host    = "db.example.com"
+			version = "%s"
+		}
+	}
+	`, secretsManagerInstanceID, name, version)
+}
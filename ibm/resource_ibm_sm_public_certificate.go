@@ -0,0 +1,269 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMSmPublicCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSmPublicCertificateCreate,
+		ReadContext:   resourceIBMSmPublicCertificateRead,
+		UpdateContext: resourceIBMSmPublicCertificateUpdate,
+		DeleteContext: resourceIBMSmSecretDelete(smSecretTypePublicCert),
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: resourceIBMSmCertificateSchema(map[string]*schema.Schema{
+			"common_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The fully qualified domain name or host domain name of the certificate.",
+			},
+			"alt_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The alternative names of the certificate.",
+			},
+			"key_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "RSA2048",
+				ValidateFunc: validateAllowedStringValue([]string{"RSA2048", "RSA4096", "EC256", "EC384"}),
+				Description:  "The identifier for the cryptographic algorithm used to generate the public key of the certificate.",
+			},
+			"ca": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the certificate authority configuration to order the certificate with.",
+			},
+			"dns": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the DNS provider configuration to validate the domain with.",
+			},
+			"bundle_certs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Bundle the issued certificate with the intermediate certificate.",
+			},
+			"rotation": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The rotation policy of the certificate. Set auto_rotate to false to turn off automatic rotation.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_rotate": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Renew the certificate automatically 31 days before it expires.",
+						},
+						"rotate_keys": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Generate a new private key on each rotation.",
+						},
+					},
+				},
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded issued certificate.",
+			},
+			"intermediate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded intermediate certificate.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key of the certificate.",
+			},
+			"next_rotation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the certificate is scheduled for automatic rotation.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSmPublicCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bundleCerts := d.Get("bundle_certs").(bool)
+	secret := &smSecret{
+		CommonName:   ptrToString(d.Get("common_name").(string)),
+		AltNames:     expandStringList(d.Get("alt_names").([]interface{})),
+		KeyAlgorithm: ptrToString(d.Get("key_algorithm").(string)),
+		CA:           ptrToString(d.Get("ca").(string)),
+		DNS:          ptrToString(d.Get("dns").(string)),
+		BundleCerts:  &bundleCerts,
+		Rotation:     expandSmPublicCertificateRotation(d),
+	}
+
+	client, created, err := resourceIBMSmSecretCreate(context, d, meta, smSecretTypePublicCert, secret)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, err = waitForSmPublicCertificate(context, client, *created.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error waiting for certificate (%s) to be issued: %s", d.Id(), err))
+	}
+
+	return resourceIBMSmPublicCertificateRead(context, d, meta)
+}
+
+func resourceIBMSmPublicCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := resourceIBMSmSecretRead(context, d, meta, smSecretTypePublicCert)
+	if err != nil || secret == nil {
+		return diag.FromErr(err)
+	}
+
+	if err = setSmCertificateAttributes(d, secret); err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("common_name", secret.CommonName)
+	if secret.AltNames != nil {
+		d.Set("alt_names", secret.AltNames)
+	}
+	if secret.KeyAlgorithm != nil {
+		d.Set("key_algorithm", secret.KeyAlgorithm)
+	}
+	if secret.CA != nil {
+		d.Set("ca", secret.CA)
+	}
+	if secret.DNS != nil {
+		d.Set("dns", secret.DNS)
+	}
+	if secret.BundleCerts != nil {
+		d.Set("bundle_certs", secret.BundleCerts)
+	}
+	d.Set("next_rotation_date", secret.NextRotationDate)
+	if err = d.Set("rotation", flattenSmPublicCertificateRotation(secret.Rotation)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rotation %s", err))
+	}
+
+	// The certificate is only readable once it is issued
+	if secret.State != nil && *secret.State == smSecretStateActive {
+		client, secretID, err := resourceIBMSmSecretClient(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		certificate, response, err := client.GetSecret(context, smSecretTypePublicCert, secretID)
+		if err != nil {
+			log.Printf("[DEBUG] GetSecret failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetSecret failed %s\n%s", err, response))
+		}
+		for _, k := range []string{"certificate", "intermediate", "private_key"} {
+			if v, ok := certificate.SecretData[k]; ok && v != nil {
+				d.Set(k, fmt.Sprint(v))
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSmPublicCertificateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, secretID, err := resourceIBMSmSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = resourceIBMSmSecretUpdateMetadata(context, d, client, smSecretTypePublicCert, secretID, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("rotation") {
+		rotation := expandSmPublicCertificateRotation(d)
+		response, err := client.PutRotationPolicy(context, smSecretTypePublicCert, secretID, rotation)
+		if err != nil {
+			log.Printf("[DEBUG] PutRotationPolicy failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("PutRotationPolicy failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMSmPublicCertificateRead(context, d, meta)
+}
+
+// expandSmPublicCertificateRotation returns the rotation policy, automatic rotation is off unless it is
+// configured.
+func expandSmPublicCertificateRotation(d *schema.ResourceData) *smRotation {
+	autoRotate, rotateKeys := false, false
+	if v, ok := d.GetOk("rotation"); ok && v.([]interface{})[0] != nil {
+		r := v.([]interface{})[0].(map[string]interface{})
+		autoRotate = r["auto_rotate"].(bool)
+		rotateKeys = r["rotate_keys"].(bool)
+	}
+	return &smRotation{AutoRotate: &autoRotate, RotateKeys: &rotateKeys}
+}
+
+func flattenSmPublicCertificateRotation(rotation *smRotation) []interface{} {
+	if rotation == nil || rotation.AutoRotate == nil {
+		return []interface{}{}
+	}
+	r := map[string]interface{}{
+		"auto_rotate": *rotation.AutoRotate,
+		"rotate_keys": false,
+	}
+	if rotation.RotateKeys != nil {
+		r["rotate_keys"] = *rotation.RotateKeys
+	}
+	return []interface{}{r}
+}
+
+// waitForSmPublicCertificate waits until the certificate is issued, the order fails when the domain
+// can't be validated.
+func waitForSmPublicCertificate(context context.Context, client *secretsManagerInstanceV1, secretID string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"pre_activation"},
+		Target:  []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			secret, response, err := client.GetSecretMetadata(context, smSecretTypePublicCert, secretID)
+			if err != nil {
+				return nil, "", fmt.Errorf("GetSecretMetadata failed %s\n%s", err, response)
+			}
+			if secret.IssuanceInfo != nil && secret.IssuanceInfo.ErrorCode != nil {
+				message := ""
+				if secret.IssuanceInfo.ErrorMessage != nil {
+					message = *secret.IssuanceInfo.ErrorMessage
+				}
+				return secret, "", fmt.Errorf("The certificate order failed with %s: %s", *secret.IssuanceInfo.ErrorCode, message)
+			}
+			if secret.State != nil && *secret.State == smSecretStateActive {
+				return secret, "active", nil
+			}
+			return secret, "pre_activation", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
@@ -0,0 +1,246 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	smLetsEncryptProduction = "letsencrypt"
+	smLetsEncryptStaging    = "letsencrypt-stage"
+)
+
+func resourceIBMSmPublicCertificateConfigurationCALetsEncrypt() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSmPublicCertificateConfigurationCreate(smPublicCertCAConfigKind),
+		ReadContext:   resourceIBMSmPublicCertificateConfigurationRead(smPublicCertCAConfigKind),
+		UpdateContext: resourceIBMSmPublicCertificateConfigurationUpdate(smPublicCertCAConfigKind),
+		DeleteContext: resourceIBMSmPublicCertificateConfigurationDelete(smPublicCertCAConfigKind),
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: resourceIBMSmPublicCertificateConfigurationSchema(map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      smLetsEncryptProduction,
+				ValidateFunc: validateAllowedStringValue([]string{smLetsEncryptProduction, smLetsEncryptStaging}),
+				Description:  "The Let's Encrypt environment, letsencrypt or letsencrypt-stage.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key of the Let's Encrypt account. The private key is not read back from the instance.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSmPublicCertificateConfigurationDNS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSmPublicCertificateConfigurationCreate(smPublicCertDNSConfigKind),
+		ReadContext:   resourceIBMSmPublicCertificateConfigurationRead(smPublicCertDNSConfigKind),
+		UpdateContext: resourceIBMSmPublicCertificateConfigurationUpdate(smPublicCertDNSConfigKind),
+		DeleteContext: resourceIBMSmPublicCertificateConfigurationDelete(smPublicCertDNSConfigKind),
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: resourceIBMSmPublicCertificateConfigurationSchema(map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"cis", "classic_infrastructure"}),
+				Description:  "The type of the DNS provider, cis or classic_infrastructure.",
+			},
+			"cis_crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The CRN of the Cloud Internet Services instance, for the cis type.",
+			},
+			"cis_apikey": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "An API key to access the Cloud Internet Services instance, for the cis type. If omitted, service to service authorization is used.",
+			},
+			"classic_infrastructure_username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The username of the classic infrastructure account, for the classic_infrastructure type.",
+			},
+			"classic_infrastructure_password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The API key of the classic infrastructure account, for the classic_infrastructure type.",
+			},
+		}),
+	}
+}
+
+// resourceIBMSmPublicCertificateConfigurationSchema adds the arguments that certificate authority and
+// DNS provider configurations share.
+func resourceIBMSmPublicCertificateConfigurationSchema(configSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	configSchema["instance_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The GUID of the Secrets Manager instance.",
+	}
+	configSchema["endpoint_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "public",
+		ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+		Description:  "The endpoint type to communicate with the instance, public or private.",
+	}
+	configSchema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the configuration, public certificates refer to it by name.",
+	}
+	return configSchema
+}
+
+// smPublicCertConfigKeys are the configuration keys of each kind of configuration, the sensitive keys
+// are not read back.
+var smPublicCertConfigKeys = map[string][]string{
+	smPublicCertCAConfigKind:  {"private_key"},
+	smPublicCertDNSConfigKind: {"cis_crn", "cis_apikey", "classic_infrastructure_username", "classic_infrastructure_password"},
+}
+
+var smPublicCertConfigSensitiveKeys = map[string]bool{
+	"private_key":                     true,
+	"cis_apikey":                      true,
+	"classic_infrastructure_password": true,
+}
+
+func expandSmPublicCertConfig(d *schema.ResourceData, kind string) map[string]interface{} {
+	config := map[string]interface{}{}
+	for _, k := range smPublicCertConfigKeys[kind] {
+		if v, ok := d.GetOk(k); ok {
+			config[k] = v.(string)
+		}
+	}
+	return config
+}
+
+func resourceIBMSmPublicCertificateConfigurationCreate(kind string) schema.CreateContextFunc {
+	return func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		instanceID := d.Get("instance_id").(string)
+		client, err := getSecretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		name := d.Get("name").(string)
+		config := &smPublicCertConfig{
+			Name:   ptrToString(name),
+			Type:   ptrToString(d.Get("type").(string)),
+			Config: expandSmPublicCertConfig(d, kind),
+		}
+		response, err := client.CreatePublicCertConfig(context, kind, config)
+		if err != nil {
+			log.Printf("[DEBUG] CreatePublicCertConfig failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("CreatePublicCertConfig failed %s\n%s", err, response))
+		}
+
+		d.SetId(fmt.Sprintf("%s/%s", instanceID, name))
+
+		return resourceIBMSmPublicCertificateConfigurationRead(kind)(context, d, meta)
+	}
+}
+
+func resourceIBMSmPublicCertificateConfigurationRead(kind string) schema.ReadContextFunc {
+	return func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		instanceID, name, err := secretsManagerIDParts(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		client, err := getSecretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		config, response, err := client.GetPublicCertConfig(context, kind, name)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+			log.Printf("[DEBUG] GetPublicCertConfig failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetPublicCertConfig failed %s\n%s", err, response))
+		}
+
+		d.Set("instance_id", instanceID)
+		if d.Get("endpoint_type").(string) == "" {
+			d.Set("endpoint_type", "public")
+		}
+		d.Set("name", name)
+		if config.Type != nil {
+			d.Set("type", config.Type)
+		}
+		for _, k := range smPublicCertConfigKeys[kind] {
+			if v, ok := config.Config[k]; ok && v != nil && !smPublicCertConfigSensitiveKeys[k] {
+				d.Set(k, fmt.Sprint(v))
+			}
+		}
+
+		return nil
+	}
+}
+
+func resourceIBMSmPublicCertificateConfigurationUpdate(kind string) schema.UpdateContextFunc {
+	return func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		instanceID, name, err := secretsManagerIDParts(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		client, err := getSecretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if d.HasChanges(smPublicCertConfigKeys[kind]...) {
+			response, err := client.UpdatePublicCertConfig(context, kind, name, expandSmPublicCertConfig(d, kind))
+			if err != nil {
+				log.Printf("[DEBUG] UpdatePublicCertConfig failed %s\n%s", err, response)
+				return diag.FromErr(fmt.Errorf("UpdatePublicCertConfig failed %s\n%s", err, response))
+			}
+		}
+
+		return resourceIBMSmPublicCertificateConfigurationRead(kind)(context, d, meta)
+	}
+}
+
+func resourceIBMSmPublicCertificateConfigurationDelete(kind string) schema.DeleteContextFunc {
+	return func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		instanceID, name, err := secretsManagerIDParts(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		client, err := getSecretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		response, err := client.DeletePublicCertConfig(context, kind, name)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeletePublicCertConfig failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("DeletePublicCertConfig failed %s\n%s", err, response))
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
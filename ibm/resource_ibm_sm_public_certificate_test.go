@@ -0,0 +1,125 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMSmPublicCertificateBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-public-cert-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckIBMSmSecretDestroy("ibm_sm_public_certificate", smSecretTypePublicCert),
+			testAccCheckIBMSmPublicCertificateConfigurationDestroy,
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSmPublicCertificateConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_public_certificate_configuration_ca_lets_encrypt.ca", "type", "letsencrypt-stage"),
+					resource.TestCheckResourceAttr("ibm_sm_public_certificate_configuration_dns.dns", "type", "cis"),
+					resource.TestCheckResourceAttr("ibm_sm_public_certificate.cert", "common_name", fmt.Sprintf("%s.%s", name, cisDomainStatic)),
+					resource.TestCheckResourceAttr("ibm_sm_public_certificate.cert", "rotation.0.auto_rotate", "false"),
+					resource.TestCheckResourceAttrSet("ibm_sm_public_certificate.cert", "certificate"),
+					resource.TestCheckResourceAttrSet("ibm_sm_public_certificate.cert", "private_key"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSmPublicCertificateConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_public_certificate.cert", "rotation.0.auto_rotate", "true"),
+				),
+			},
+			{
+				ResourceName:            "ibm_sm_public_certificate_configuration_ca_lets_encrypt.ca",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_key"},
+			},
+			{
+				ResourceName:      "ibm_sm_public_certificate.cert",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSmPublicCertificateConfigurationDestroy(s *terraform.State) error {
+	kinds := map[string]string{
+		"ibm_sm_public_certificate_configuration_ca_lets_encrypt": smPublicCertCAConfigKind,
+		"ibm_sm_public_certificate_configuration_dns":             smPublicCertDNSConfigKind,
+	}
+	for _, rs := range s.RootModule().Resources {
+		kind, ok := kinds[rs.Type]
+		if !ok {
+			continue
+		}
+		instanceID, name, err := secretsManagerIDParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, err := getSecretsManagerInstanceClient(testAccProvider.Meta(), instanceID, "public")
+		if err != nil {
+			return err
+		}
+		_, response, err := client.GetPublicCertConfig(context.Background(), kind, name)
+		if err == nil {
+			return fmt.Errorf("Public certificate configuration still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for public certificate configuration (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMSmPublicCertificateConfig(name string, autoRotate bool) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%[1]s"
+	}
+
+	data "ibm_cis" "cis" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+	}
+
+	resource "ibm_sm_public_certificate_configuration_ca_lets_encrypt" "ca" {
+		instance_id = "%[3]s"
+		name        = "%[4]s-ca"
+		type        = "letsencrypt-stage"
+		private_key = <<EOT
+%[5]s
+EOT
+	}
+
+	resource "ibm_sm_public_certificate_configuration_dns" "dns" {
+		instance_id = "%[3]s"
+		name        = "%[4]s-dns"
+		type        = "cis"
+		cis_crn     = data.ibm_cis.cis.id
+	}
+
+	resource "ibm_sm_public_certificate" "cert" {
+		instance_id = "%[3]s"
+		name        = "%[4]s"
+		common_name = "%[4]s.%[6]s"
+		ca          = ibm_sm_public_certificate_configuration_ca_lets_encrypt.ca.name
+		dns         = ibm_sm_public_certificate_configuration_dns.dns.name
+		rotation {
+			auto_rotate = %[7]t
+		}
+	}
+	`, cisResourceGroup, cisInstance, secretsManagerInstanceID, name, secretsManagerAcmeAccountPrivateKey, cisDomainStatic, autoRotate)
+}
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMSmSecretGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSmSecretGroupCreate,
+		ReadContext:   resourceIBMSmSecretGroupRead,
+		UpdateContext: resourceIBMSmSecretGroupUpdate,
+		DeleteContext: resourceIBMSmSecretGroupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The GUID of the Secrets Manager instance.",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "public",
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "The endpoint type to communicate with the instance, public or private.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the secret group.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An extended description of the secret group.",
+			},
+			"secret_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the secret group.",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the secret group was created.",
+			},
+			"last_update_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the secret group was last updated.",
+			},
+		},
+	}
+}
+
+func resourceIBMSmSecretGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	client, err := getSecretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretGroup := secretsmanagerv1.SecretGroupResource{
+		Name: core.StringPtr(d.Get("name").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		secretGroup.Description = core.StringPtr(v.(string))
+	}
+	createSecretGroupOptions := &secretsmanagerv1.CreateSecretGroupOptions{
+		Metadata: &secretsmanagerv1.CollectionMetadata{
+			CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretGroupJSONConst),
+			CollectionTotal: core.Int64Ptr(1),
+		},
+		Resources: []secretsmanagerv1.SecretGroupResource{secretGroup},
+	}
+
+	secretGroupDef, response, err := client.CreateSecretGroupWithContext(context, createSecretGroupOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretGroupWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateSecretGroupWithContext failed %s\n%s", err, response))
+	}
+	if len(secretGroupDef.Resources) == 0 || secretGroupDef.Resources[0].ID == nil {
+		return diag.FromErr(fmt.Errorf("CreateSecretGroupWithContext returned no secret group"))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, *secretGroupDef.Resources[0].ID))
+
+	return resourceIBMSmSecretGroupRead(context, d, meta)
+}
+
+func resourceIBMSmSecretGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretGroupID, err := secretsManagerIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecretGroupOptions := &secretsmanagerv1.GetSecretGroupOptions{
+		ID: core.StringPtr(secretGroupID),
+	}
+	secretGroupDef, response, err := client.GetSecretGroupWithContext(context, getSecretGroupOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretGroupWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretGroupWithContext failed %s\n%s", err, response))
+	}
+	if len(secretGroupDef.Resources) == 0 {
+		d.SetId("")
+		return nil
+	}
+	secretGroup := secretGroupDef.Resources[0]
+
+	d.Set("instance_id", instanceID)
+	if d.Get("endpoint_type").(string) == "" {
+		d.Set("endpoint_type", "public")
+	}
+	d.Set("secret_group_id", secretGroupID)
+	d.Set("name", secretGroup.Name)
+	d.Set("description", secretGroup.Description)
+	if secretGroup.CreationDate != nil {
+		d.Set("creation_date", secretGroup.CreationDate.String())
+	}
+	if secretGroup.LastUpdateDate != nil {
+		d.Set("last_update_date", secretGroup.LastUpdateDate.String())
+	}
+
+	return nil
+}
+
+func resourceIBMSmSecretGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretGroupID, err := secretsManagerIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description") {
+		updateSecretGroupMetadataOptions := &secretsmanagerv1.UpdateSecretGroupMetadataOptions{
+			ID: core.StringPtr(secretGroupID),
+			Metadata: &secretsmanagerv1.CollectionMetadata{
+				CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretGroupJSONConst),
+				CollectionTotal: core.Int64Ptr(1),
+			},
+			Resources: []secretsmanagerv1.SecretGroupMetadataUpdatable{
+				{
+					Name:        core.StringPtr(d.Get("name").(string)),
+					Description: core.StringPtr(d.Get("description").(string)),
+				},
+			},
+		}
+		_, response, err := client.UpdateSecretGroupMetadataWithContext(context, updateSecretGroupMetadataOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSecretGroupMetadataWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateSecretGroupMetadataWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMSmSecretGroupRead(context, d, meta)
+}
+
+func resourceIBMSmSecretGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, secretGroupID, err := secretsManagerIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	client, err := getSecretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteSecretGroupOptions := &secretsmanagerv1.DeleteSecretGroupOptions{
+		ID: core.StringPtr(secretGroupID),
+	}
+	response, err := client.DeleteSecretGroupWithContext(context, deleteSecretGroupOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteSecretGroupWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteSecretGroupWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,86 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMSmSecretGroupBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-secret-group-%d", acctest.RandIntRange(10, 100))
+	description := "Secret group created by terraform"
+	descriptionUpdate := "Secret group updated by terraform"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSmSecretGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSmSecretGroupConfig(name, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_secret_group.group", "name", name),
+					resource.TestCheckResourceAttr("ibm_sm_secret_group.group", "description", description),
+					resource.TestCheckResourceAttrSet("ibm_sm_secret_group.group", "secret_group_id"),
+					resource.TestCheckResourceAttrSet("ibm_sm_secret_group.group", "creation_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSmSecretGroupConfig(name, descriptionUpdate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_secret_group.group", "description", descriptionUpdate),
+				),
+			},
+			{
+				ResourceName:      "ibm_sm_secret_group.group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSmSecretGroupDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_secret_group" {
+			continue
+		}
+		instanceID, secretGroupID, err := secretsManagerIDParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, err := getSecretsManagerInstanceClient(testAccProvider.Meta(), instanceID, "public")
+		if err != nil {
+			return err
+		}
+		getSecretGroupOptions := &secretsmanagerv1.GetSecretGroupOptions{
+			ID: core.StringPtr(secretGroupID),
+		}
+		_, response, err := client.GetSecretGroupWithContext(context.Background(), getSecretGroupOptions)
+		if err == nil {
+			return fmt.Errorf("Secret group still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for secret group (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMSmSecretGroupConfig(name, description string) string {
+	return fmt.Sprintf(`
+	resource "ibm_sm_secret_group" "group" {
+		instance_id = "%s"
+		name        = "%s"
+		description = "%s"
+	}
+	`, secretsManagerInstanceID, name, description)
+}
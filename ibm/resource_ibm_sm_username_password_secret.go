@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceIBMSmUsernamePasswordSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSmUsernamePasswordSecretCreate,
+		ReadContext:   resourceIBMSmUsernamePasswordSecretRead,
+		UpdateContext: resourceIBMSmUsernamePasswordSecretUpdate,
+		DeleteContext: resourceIBMSmSecretDelete(smSecretTypeUsernamePassword),
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMSmExpirationDateDiff,
+
+		Schema: resourceIBMSmSecretSchema(map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username of the secret.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the secret. Changing the password creates a new version of the secret. The password is not read back from the instance.",
+			},
+			"expiration_date": resourceIBMSmExpirationDateSchema(),
+			"rotation": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The rotation policy of the secret. The service generates a new password on each rotation.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interval": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The length of the secret rotation time interval.",
+						},
+						"unit": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"day", "month"}),
+							Description:  "The units for the secret rotation time interval, day or month.",
+						},
+					},
+				},
+			},
+			"next_rotation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the secret is scheduled for automatic rotation.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSmUsernamePasswordSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret := &smSecret{
+		Username: ptrToString(d.Get("username").(string)),
+		Password: ptrToString(d.Get("password").(string)),
+	}
+	if v, ok := d.GetOk("expiration_date"); ok {
+		secret.ExpirationDate = ptrToString(v.(string))
+	}
+
+	client, created, err := resourceIBMSmSecretCreate(context, d, meta, smSecretTypeUsernamePassword, secret)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if _, ok := d.GetOk("rotation"); ok {
+		if err = resourceIBMSmUsernamePasswordSecretPutPolicy(context, d, client, *created.ID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSmUsernamePasswordSecretRead(context, d, meta)
+}
+
+func resourceIBMSmUsernamePasswordSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := resourceIBMSmSecretRead(context, d, meta, smSecretTypeUsernamePassword)
+	if err != nil || secret == nil {
+		return diag.FromErr(err)
+	}
+
+	if secret.Username != nil {
+		d.Set("username", secret.Username)
+	}
+	d.Set("expiration_date", secret.ExpirationDate)
+	d.Set("next_rotation_date", secret.NextRotationDate)
+
+	client, secretID, err := resourceIBMSmSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rotation, response, err := client.GetRotationPolicy(context, smSecretTypeUsernamePassword, secretID)
+	if err != nil {
+		log.Printf("[DEBUG] GetRotationPolicy failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetRotationPolicy failed %s\n%s", err, response))
+	}
+	if err = d.Set("rotation", flattenSmUsernamePasswordRotation(rotation)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rotation %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMSmUsernamePasswordSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client, secretID, err := resourceIBMSmSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = resourceIBMSmSecretUpdateMetadata(context, d, client, smSecretTypeUsernamePassword, secretID, resourceIBMSmExpirationDateMetadata(d)); err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("password") {
+		body := map[string]interface{}{"password": d.Get("password").(string)}
+		if err = resourceIBMSmSecretRotate(context, client, smSecretTypeUsernamePassword, secretID, body); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("rotation") {
+		if err = resourceIBMSmUsernamePasswordSecretPutPolicy(context, d, client, secretID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSmUsernamePasswordSecretRead(context, d, meta)
+}
+
+// resourceIBMSmUsernamePasswordSecretPutPolicy sets the rotation policy, an empty policy turns off
+// automatic rotation.
+func resourceIBMSmUsernamePasswordSecretPutPolicy(context context.Context, d *schema.ResourceData, client *secretsManagerInstanceV1, secretID string) error {
+	rotation := &smRotation{}
+	if v, ok := d.GetOk("rotation"); ok && v.([]interface{})[0] != nil {
+		r := v.([]interface{})[0].(map[string]interface{})
+		interval := int64(r["interval"].(int))
+		rotation.Interval = &interval
+		rotation.Unit = ptrToString(r["unit"].(string))
+	}
+
+	response, err := client.PutRotationPolicy(context, smSecretTypeUsernamePassword, secretID, rotation)
+	if err != nil {
+		log.Printf("[DEBUG] PutRotationPolicy failed %s\n%s", err, response)
+		return fmt.Errorf("PutRotationPolicy failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func flattenSmUsernamePasswordRotation(rotation *smRotation) []interface{} {
+	if rotation == nil || rotation.Interval == nil || rotation.Unit == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"interval": int(*rotation.Interval),
+			"unit":     *rotation.Unit,
+		},
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSmUsernamePasswordSecretBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-username-password-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSmSecretDestroy("ibm_sm_username_password_secret", smSecretTypeUsernamePassword),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSmUsernamePasswordSecretConfig(name, "Passw0rd-initial", 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.secret", "username", "tf-user"),
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.secret", "rotation.#", "1"),
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.secret", "rotation.0.interval", "30"),
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.secret", "rotation.0.unit", "day"),
+					resource.TestCheckResourceAttrSet("ibm_sm_username_password_secret.secret", "next_rotation_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSmUsernamePasswordSecretConfig(name, "Passw0rd-rotated", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.secret", "versions_total", "2"),
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.secret", "rotation.0.interval", "2"),
+				),
+			},
+			{
+				ResourceName:            "ibm_sm_username_password_secret.secret",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSmUsernamePasswordSecretConfig(name, password string, interval int) string {
+	return fmt.Sprintf(`
+	resource "ibm_sm_username_password_secret" "secret" {
+		instance_id = "%s"
+		name        = "%s"
+		username    = "tf-user"
+		password    = "%s"
+		rotation {
+			interval = %d
+			unit     = "day"
+		}
+	}
+	`, secretsManagerInstanceID, name, password, interval)
+}
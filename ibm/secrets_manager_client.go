@@ -0,0 +1,363 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+)
+
+// secretsManagerInstanceV1 is a client of one Secrets Manager instance. The secrets manager SDK the provider
+// is built with only knows the arbitrary, iam_credentials and username_password secret types, so secrets,
+// policies and engine configurations are sent as raw requests.
+type secretsManagerInstanceV1 struct {
+	*secretsmanagerv1.SecretsManagerV1
+}
+
+const (
+	smSecretTypeArbitrary        = "arbitrary"
+	smSecretTypeUsernamePassword = "username_password"
+	smSecretTypeIamCredentials   = "iam_credentials"
+	smSecretTypeImportedCert     = "imported_cert"
+	smSecretTypePublicCert       = "public_cert"
+	smSecretTypeKv               = "kv"
+
+	smSecretCollectionType = secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretJSONConst
+	smPolicyCollectionType = secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretPolicyJSONConst
+
+	smPublicCertCAConfigKind  = "certificate_authorities"
+	smPublicCertDNSConfigKind = "dns_providers"
+
+	smSecretStateActive    = 1
+	smSecretStateDestroyed = 5
+
+	smSecretRotationPolicy = "rotation"
+	smSecretRotateAction   = "rotate"
+)
+
+type smCollectionMetadata struct {
+	CollectionType  string `json:"collection_type"`
+	CollectionTotal int64  `json:"collection_total"`
+}
+
+type smValidity struct {
+	NotBefore *string `json:"not_before,omitempty"`
+	NotAfter  *string `json:"not_after,omitempty"`
+}
+
+type smRotation struct {
+	Interval   *int64  `json:"interval,omitempty"`
+	Unit       *string `json:"unit,omitempty"`
+	AutoRotate *bool   `json:"auto_rotate,omitempty"`
+	RotateKeys *bool   `json:"rotate_keys,omitempty"`
+}
+
+type smIssuanceInfo struct {
+	OrderedOn        *string `json:"ordered_on,omitempty"`
+	ErrorCode        *string `json:"error_code,omitempty"`
+	ErrorMessage     *string `json:"error_message,omitempty"`
+	BundleCerts      *bool   `json:"bundle_certs,omitempty"`
+	State            *int64  `json:"state,omitempty"`
+	StateDescription *string `json:"state_description,omitempty"`
+	AutoRotated      *bool   `json:"auto_rotated,omitempty"`
+}
+
+type smSecretVersion struct {
+	ID           *string `json:"id,omitempty"`
+	CreationDate *string `json:"creation_date,omitempty"`
+	CreatedBy    *string `json:"created_by,omitempty"`
+	AutoRotated  *bool   `json:"auto_rotated,omitempty"`
+}
+
+// smSecret holds the fields of all secret types, only the fields of the secret type are sent and returned.
+type smSecret struct {
+	ID               *string                `json:"id,omitempty"`
+	Name             *string                `json:"name,omitempty"`
+	Description      *string                `json:"description,omitempty"`
+	SecretGroupID    *string                `json:"secret_group_id,omitempty"`
+	Labels           []string               `json:"labels,omitempty"`
+	State            *int64                 `json:"state,omitempty"`
+	StateDescription *string                `json:"state_description,omitempty"`
+	SecretType       *string                `json:"secret_type,omitempty"`
+	CRN              *string                `json:"crn,omitempty"`
+	CreationDate     *string                `json:"creation_date,omitempty"`
+	CreatedBy        *string                `json:"created_by,omitempty"`
+	LastUpdateDate   *string                `json:"last_update_date,omitempty"`
+	VersionsTotal    *int64                 `json:"versions_total,omitempty"`
+	ExpirationDate   *string                `json:"expiration_date,omitempty"`
+	NextRotationDate *string                `json:"next_rotation_date,omitempty"`
+	SecretData       map[string]interface{} `json:"secret_data,omitempty"`
+
+	// arbitrary (string) and kv (object) secrets
+	Payload interface{} `json:"payload,omitempty"`
+
+	// username_password secrets
+	Username *string `json:"username,omitempty"`
+	Password *string `json:"password,omitempty"`
+
+	// iam_credentials secrets
+	TTL          interface{} `json:"ttl,omitempty"`
+	AccessGroups []string    `json:"access_groups,omitempty"`
+	APIKey       *string     `json:"api_key,omitempty"`
+	APIKeyID     *string     `json:"api_key_id,omitempty"`
+	ServiceID    *string     `json:"service_id,omitempty"`
+	ReuseAPIKey  *bool       `json:"reuse_api_key,omitempty"`
+
+	// imported_cert and public_cert secrets
+	Certificate          *string         `json:"certificate,omitempty"`
+	PrivateKey           *string         `json:"private_key,omitempty"`
+	Intermediate         *string         `json:"intermediate,omitempty"`
+	CommonName           *string         `json:"common_name,omitempty"`
+	AltNames             []string        `json:"alt_names,omitempty"`
+	Algorithm            *string         `json:"algorithm,omitempty"`
+	KeyAlgorithm         *string         `json:"key_algorithm,omitempty"`
+	Issuer               *string         `json:"issuer,omitempty"`
+	SerialNumber         *string         `json:"serial_number,omitempty"`
+	Validity             *smValidity     `json:"validity,omitempty"`
+	IntermediateIncluded *bool           `json:"intermediate_included,omitempty"`
+	PrivateKeyIncluded   *bool           `json:"private_key_included,omitempty"`
+	CA                   *string         `json:"ca,omitempty"`
+	DNS                  *string         `json:"dns,omitempty"`
+	BundleCerts          *bool           `json:"bundle_certs,omitempty"`
+	Rotation             *smRotation     `json:"rotation,omitempty"`
+	IssuanceInfo         *smIssuanceInfo `json:"issuance_info,omitempty"`
+}
+
+type smSecretCollection struct {
+	Metadata  smCollectionMetadata `json:"metadata"`
+	Resources []smSecret           `json:"resources"`
+}
+
+type smPolicy struct {
+	Type     string      `json:"type"`
+	Rotation *smRotation `json:"rotation"`
+}
+
+type smPolicyCollection struct {
+	Metadata  smCollectionMetadata `json:"metadata"`
+	Resources []smPolicy           `json:"resources"`
+}
+
+type smSecretVersionCollection struct {
+	Metadata  smCollectionMetadata `json:"metadata"`
+	Resources []smSecretVersion    `json:"resources"`
+}
+
+// smPublicCertConfig is a certificate authority or DNS provider configuration of public certificates.
+type smPublicCertConfig struct {
+	Name   *string                `json:"name,omitempty"`
+	Type   *string                `json:"type,omitempty"`
+	Config map[string]interface{} `json:"config,omitempty"`
+}
+
+type smPublicCertConfigCollection struct {
+	Metadata  smCollectionMetadata `json:"metadata"`
+	Resources []smPublicCertConfig `json:"resources"`
+}
+
+// getSecretsManagerInstanceClient returns a client of the instance, the endpoint of the instance is derived
+// from the region of the provider in the same way as for the secrets manager data sources.
+func getSecretsManagerInstanceClient(meta interface{}, instanceID, endpointType string) (*secretsManagerInstanceV1, error) {
+	bluemixSession, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	region := bluemixSession.Config.Region
+
+	secretsManagerClient, err := meta.(ClientSession).SecretsManagerV1()
+	if err != nil {
+		return nil, err
+	}
+
+	var smEndpointURL string
+	if endpointType == "private" {
+		smEndpointURL = "https://" + instanceID + ".private." + region + ".secrets-manager.appdomain.cloud"
+	} else {
+		smEndpointURL = "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
+	}
+	client := secretsManagerClient.Clone()
+	if err = client.SetServiceURL(envFallBack([]string{"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT"}, smEndpointURL)); err != nil {
+		return nil, err
+	}
+	return &secretsManagerInstanceV1{client}, nil
+}
+
+// secretsManagerIDParts splits the ID of a secrets manager resource into the instance ID and the ID
+// of the secret, secret group or configuration.
+func secretsManagerIDParts(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Incorrect ID %s: ID should be a combination of instanceID/ID", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// request sends a request to the instance, result is decoded from the JSON response when it is not nil
+func (sm *secretsManagerInstanceV1) request(ctx context.Context, method, path string, query url.Values, body, result interface{}) (*core.DetailedResponse, error) {
+	return sendCoreRequest(ctx, sm.Service, coreRequest{Method: method, Path: path, Query: query, Body: body}, result)
+}
+
+func smSecretPath(secretType, id string, elems ...string) string {
+	path := "/api/v1/secrets/" + url.PathEscape(secretType)
+	if id != "" {
+		path += "/" + url.PathEscape(id)
+	}
+	for _, e := range elems {
+		path += "/" + e
+	}
+	return path
+}
+
+// firstSecret returns the only secret of a collection
+func (c *smSecretCollection) firstSecret() (*smSecret, error) {
+	if len(c.Resources) == 0 {
+		return nil, fmt.Errorf("The response does not contain a secret")
+	}
+	return &c.Resources[0], nil
+}
+
+func (sm *secretsManagerInstanceV1) CreateSecret(ctx context.Context, secretType string, secret *smSecret) (*smSecret, *core.DetailedResponse, error) {
+	body := &smSecretCollection{
+		Metadata:  smCollectionMetadata{CollectionType: smSecretCollectionType, CollectionTotal: 1},
+		Resources: []smSecret{*secret},
+	}
+	result := &smSecretCollection{}
+	response, err := sm.request(ctx, core.POST, smSecretPath(secretType, ""), nil, body, result)
+	if err != nil {
+		return nil, response, err
+	}
+	created, err := result.firstSecret()
+	return created, response, err
+}
+
+// GetSecret returns the secret together with its secret data
+func (sm *secretsManagerInstanceV1) GetSecret(ctx context.Context, secretType, id string) (*smSecret, *core.DetailedResponse, error) {
+	result := &smSecretCollection{}
+	response, err := sm.request(ctx, core.GET, smSecretPath(secretType, id), nil, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	secret, err := result.firstSecret()
+	return secret, response, err
+}
+
+// GetSecretMetadata returns the secret without its secret data, it doesn't generate new credentials of
+// iam_credentials secrets.
+func (sm *secretsManagerInstanceV1) GetSecretMetadata(ctx context.Context, secretType, id string) (*smSecret, *core.DetailedResponse, error) {
+	result := &smSecretCollection{}
+	response, err := sm.request(ctx, core.GET, smSecretPath(secretType, id, "metadata"), nil, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	secret, err := result.firstSecret()
+	return secret, response, err
+}
+
+func (sm *secretsManagerInstanceV1) UpdateSecretMetadata(ctx context.Context, secretType, id string, metadata *smSecret) (*core.DetailedResponse, error) {
+	body := &smSecretCollection{
+		Metadata:  smCollectionMetadata{CollectionType: smSecretCollectionType, CollectionTotal: 1},
+		Resources: []smSecret{*metadata},
+	}
+	return sm.request(ctx, core.PUT, smSecretPath(secretType, id, "metadata"), nil, body, nil)
+}
+
+// UpdateSecret invokes an action on the secret, such as rotate, with the body of the action
+func (sm *secretsManagerInstanceV1) UpdateSecret(ctx context.Context, secretType, id, action string, body interface{}) (*core.DetailedResponse, error) {
+	if body == nil {
+		body = map[string]interface{}{}
+	}
+	return sm.request(ctx, core.POST, smSecretPath(secretType, id), url.Values{"action": {action}}, body, nil)
+}
+
+func (sm *secretsManagerInstanceV1) DeleteSecret(ctx context.Context, secretType, id string) (*core.DetailedResponse, error) {
+	return sm.request(ctx, core.DELETE, smSecretPath(secretType, id), nil, nil, nil)
+}
+
+func (sm *secretsManagerInstanceV1) ListSecretVersions(ctx context.Context, secretType, id string) ([]smSecretVersion, *core.DetailedResponse, error) {
+	result := &smSecretVersionCollection{}
+	response, err := sm.request(ctx, core.GET, smSecretPath(secretType, id, "versions"), nil, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	return result.Resources, response, nil
+}
+
+// GetRotationPolicy returns the rotation policy of the secret, nil if the secret has none
+func (sm *secretsManagerInstanceV1) GetRotationPolicy(ctx context.Context, secretType, id string) (*smRotation, *core.DetailedResponse, error) {
+	result := &smPolicyCollection{}
+	response, err := sm.request(ctx, core.GET, smSecretPath(secretType, id, "policies"), url.Values{"policy": {smSecretRotationPolicy}}, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	if len(result.Resources) == 0 {
+		return nil, response, nil
+	}
+	return result.Resources[0].Rotation, response, nil
+}
+
+func (sm *secretsManagerInstanceV1) PutRotationPolicy(ctx context.Context, secretType, id string, rotation *smRotation) (*core.DetailedResponse, error) {
+	body := &smPolicyCollection{
+		Metadata:  smCollectionMetadata{CollectionType: smPolicyCollectionType, CollectionTotal: 1},
+		Resources: []smPolicy{{Type: smPolicyCollectionType, Rotation: rotation}},
+	}
+	return sm.request(ctx, core.PUT, smSecretPath(secretType, id, "policies"), url.Values{"policy": {smSecretRotationPolicy}}, body, nil)
+}
+
+// PutConfig sets the configuration of the secrets engine of the secret type
+func (sm *secretsManagerInstanceV1) PutConfig(ctx context.Context, secretType string, config interface{}) (*core.DetailedResponse, error) {
+	return sm.request(ctx, core.PUT, "/api/v1/config/"+url.PathEscape(secretType), nil, config, nil)
+}
+
+// GetConfig returns the configuration of the secrets engine of the secret type
+func (sm *secretsManagerInstanceV1) GetConfig(ctx context.Context, secretType string) (map[string]interface{}, *core.DetailedResponse, error) {
+	result := struct {
+		Resources []map[string]interface{} `json:"resources"`
+	}{}
+	response, err := sm.request(ctx, core.GET, "/api/v1/config/"+url.PathEscape(secretType), nil, nil, &result)
+	if err != nil {
+		return nil, response, err
+	}
+	if len(result.Resources) == 0 {
+		return map[string]interface{}{}, response, nil
+	}
+	return result.Resources[0], response, nil
+}
+
+func smPublicCertConfigPath(kind, name string) string {
+	path := "/api/v1/config/" + smSecretTypePublicCert + "/" + kind
+	if name != "" {
+		path += "/" + url.PathEscape(name)
+	}
+	return path
+}
+
+// CreatePublicCertConfig adds a certificate authority or a DNS provider configuration, depending on kind
+func (sm *secretsManagerInstanceV1) CreatePublicCertConfig(ctx context.Context, kind string, config *smPublicCertConfig) (*core.DetailedResponse, error) {
+	return sm.request(ctx, core.POST, smPublicCertConfigPath(kind, ""), nil, config, nil)
+}
+
+func (sm *secretsManagerInstanceV1) GetPublicCertConfig(ctx context.Context, kind, name string) (*smPublicCertConfig, *core.DetailedResponse, error) {
+	result := &smPublicCertConfigCollection{}
+	response, err := sm.request(ctx, core.GET, smPublicCertConfigPath(kind, name), nil, nil, result)
+	if err != nil {
+		return nil, response, err
+	}
+	if len(result.Resources) == 0 {
+		return nil, response, fmt.Errorf("The response does not contain a configuration")
+	}
+	return &result.Resources[0], response, nil
+}
+
+func (sm *secretsManagerInstanceV1) UpdatePublicCertConfig(ctx context.Context, kind, name string, config map[string]interface{}) (*core.DetailedResponse, error) {
+	return sm.request(ctx, core.PUT, smPublicCertConfigPath(kind, name), nil, config, nil)
+}
+
+func (sm *secretsManagerInstanceV1) DeletePublicCertConfig(ctx context.Context, kind, name string) (*core.DetailedResponse, error) {
+	return sm.request(ctx, core.DELETE, smPublicCertConfigPath(kind, name), nil, nil, nil)
+}
@@ -0,0 +1,390 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceIBMSmSecretSchema adds the arguments and attributes that all secret types share to the schema
+// of the secret type.
+func resourceIBMSmSecretSchema(secretSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	common := map[string]*schema.Schema{
+		"instance_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The GUID of the Secrets Manager instance.",
+		},
+		"endpoint_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "public",
+			ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+			Description:  "The endpoint type to communicate with the instance, public or private.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "A human-readable alias to assign to the secret.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "An extended description of the secret.",
+		},
+		"secret_group_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "The ID of the secret group of the secret. If omitted, the secret is assigned to the default secret group.",
+		},
+		"labels": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Labels that you can use to filter for secrets in the instance.",
+		},
+		"secret_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the secret.",
+		},
+		"crn": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The CRN of the secret.",
+		},
+		"state": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The secret state based on NIST SP 800-57: Pre-activation = 0, Active = 1, Suspended = 2, Deactivated = 3, and Destroyed = 5.",
+		},
+		"state_description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "A text representation of the secret state.",
+		},
+		"creation_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date the secret was created.",
+		},
+		"created_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier for the entity that created the secret.",
+		},
+		"last_update_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date the secret was last updated.",
+		},
+		"versions_total": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of versions of the secret.",
+		},
+		"versions": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The metadata of the versions of the secret.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the secret version.",
+					},
+					"creation_date": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The date that the version of the secret was created.",
+					},
+					"created_by": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The unique identifier for the entity that created the version.",
+					},
+					"auto_rotated": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Indicates whether the version of the secret was created by automatic rotation.",
+					},
+				},
+			},
+		},
+	}
+	for k, v := range common {
+		secretSchema[k] = v
+	}
+	return secretSchema
+}
+
+// resourceIBMSmExpirationDateSchema is the expiration date of the secret types that can expire.
+func resourceIBMSmExpirationDateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.IsRFC3339Time,
+		Description:  "The date the secret material expires, in RFC 3339 format. If omitted, the secret does not expire. Removing the date replaces the secret.",
+	}
+}
+
+// resourceIBMSmExpirationDateDiff replaces the secret when its expiration date is removed, the metadata update
+// can change the date but not remove it.
+var resourceIBMSmExpirationDateDiff = customdiff.ForceNewIfChange("expiration_date", func(context context.Context, old, new, meta interface{}) bool {
+	return new.(string) == ""
+})
+
+// resourceIBMSmExpirationDateMetadata returns the metadata update of a changed expiration date, or nil.
+func resourceIBMSmExpirationDateMetadata(d *schema.ResourceData) *smSecret {
+	if !d.HasChange("expiration_date") {
+		return nil
+	}
+	return &smSecret{ExpirationDate: ptrToString(d.Get("expiration_date").(string))}
+}
+
+// resourceIBMSmSecretCreate creates the secret of the secret type, the shared arguments are added to the
+// secret.
+func resourceIBMSmSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}, secretType string, secret *smSecret) (*secretsManagerInstanceV1, *smSecret, error) {
+	instanceID := d.Get("instance_id").(string)
+	client, err := getSecretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secret.Name = ptrToString(d.Get("name").(string))
+	secret.Labels = expandStringList(d.Get("labels").([]interface{}))
+	if v, ok := d.GetOk("description"); ok {
+		secret.Description = ptrToString(v.(string))
+	}
+	if v, ok := d.GetOk("secret_group_id"); ok {
+		secret.SecretGroupID = ptrToString(v.(string))
+	}
+
+	created, response, err := client.CreateSecret(context, secretType, secret)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecret failed %s\n%s", err, response)
+		return nil, nil, fmt.Errorf("CreateSecret failed %s\n%s", err, response)
+	}
+	if created.ID == nil {
+		return nil, nil, fmt.Errorf("CreateSecret returned no secret ID")
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, *created.ID))
+	return client, created, nil
+}
+
+// resourceIBMSmSecretClient returns the instance client and the secret ID of the resource.
+func resourceIBMSmSecretClient(d *schema.ResourceData, meta interface{}) (*secretsManagerInstanceV1, string, error) {
+	instanceID, secretID, err := secretsManagerIDParts(d.Id())
+	if err != nil {
+		return nil, "", err
+	}
+	client, err := getSecretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return nil, "", err
+	}
+	return client, secretID, nil
+}
+
+// resourceIBMSmSecretRead reads the metadata of the secret into the shared attributes, and returns it to
+// read the attributes of the secret type. It returns a nil secret when the secret is gone.
+func resourceIBMSmSecretRead(context context.Context, d *schema.ResourceData, meta interface{}, secretType string) (*smSecret, error) {
+	instanceID, secretID, err := secretsManagerIDParts(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	client, err := getSecretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	secret, response, err := client.GetSecretMetadata(context, secretType, secretID)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil, nil
+		}
+		log.Printf("[DEBUG] GetSecretMetadata failed %s\n%s", err, response)
+		return nil, fmt.Errorf("GetSecretMetadata failed %s\n%s", err, response)
+	}
+	// Destroyed secrets can still be read for some time
+	if secret.State != nil && *secret.State == smSecretStateDestroyed {
+		d.SetId("")
+		return nil, nil
+	}
+
+	versions, response, err := client.ListSecretVersions(context, secretType, secretID)
+	if err != nil {
+		log.Printf("[DEBUG] ListSecretVersions failed %s\n%s", err, response)
+		return nil, fmt.Errorf("ListSecretVersions failed %s\n%s", err, response)
+	}
+
+	d.Set("instance_id", instanceID)
+	if d.Get("endpoint_type").(string) == "" {
+		d.Set("endpoint_type", "public")
+	}
+	d.Set("secret_id", secretID)
+	d.Set("name", secret.Name)
+	d.Set("description", secret.Description)
+	d.Set("secret_group_id", secret.SecretGroupID)
+	d.Set("labels", secret.Labels)
+	d.Set("crn", secret.CRN)
+	d.Set("state", secret.State)
+	d.Set("state_description", secret.StateDescription)
+	d.Set("creation_date", secret.CreationDate)
+	d.Set("created_by", secret.CreatedBy)
+	d.Set("last_update_date", secret.LastUpdateDate)
+	if secret.VersionsTotal != nil {
+		d.Set("versions_total", secret.VersionsTotal)
+	} else {
+		d.Set("versions_total", len(versions))
+	}
+	if err = d.Set("versions", flattenSmSecretVersions(versions)); err != nil {
+		return nil, fmt.Errorf("Error setting versions %s", err)
+	}
+
+	return secret, nil
+}
+
+// resourceIBMSmSecretUpdateMetadata updates the name, description and labels of the secret. The metadata
+// of the secret type, if any, is updated together with them.
+func resourceIBMSmSecretUpdateMetadata(context context.Context, d *schema.ResourceData, client *secretsManagerInstanceV1, secretType, secretID string, metadata *smSecret) error {
+	if metadata == nil {
+		if !d.HasChanges("name", "description", "labels") {
+			return nil
+		}
+		metadata = &smSecret{}
+	}
+	metadata.Name = ptrToString(d.Get("name").(string))
+	metadata.Description = ptrToString(d.Get("description").(string))
+	metadata.Labels = expandStringList(d.Get("labels").([]interface{}))
+	response, err := client.UpdateSecretMetadata(context, secretType, secretID, metadata)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateSecretMetadata failed %s\n%s", err, response)
+		return fmt.Errorf("UpdateSecretMetadata failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+// resourceIBMSmSecretRotate creates a new version of the secret with the new secret data.
+func resourceIBMSmSecretRotate(context context.Context, client *secretsManagerInstanceV1, secretType, secretID string, body interface{}) error {
+	response, err := client.UpdateSecret(context, secretType, secretID, smSecretRotateAction, body)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateSecret failed %s\n%s", err, response)
+		return fmt.Errorf("Error rotating secret %s: %s\n%s", secretID, err, response)
+	}
+	return nil
+}
+
+// resourceIBMSmSecretDelete returns the delete function of the secret type.
+func resourceIBMSmSecretDelete(secretType string) schema.DeleteContextFunc {
+	return func(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client, secretID, err := resourceIBMSmSecretClient(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		response, err := client.DeleteSecret(context, secretType, secretID)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeleteSecret failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("DeleteSecret failed %s\n%s", err, response))
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+func flattenSmSecretVersions(versions []smSecretVersion) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(versions))
+	for _, version := range versions {
+		v := map[string]interface{}{}
+		if version.ID != nil {
+			v["id"] = *version.ID
+		}
+		if version.CreationDate != nil {
+			v["creation_date"] = *version.CreationDate
+		}
+		if version.CreatedBy != nil {
+			v["created_by"] = *version.CreatedBy
+		}
+		if version.AutoRotated != nil {
+			v["auto_rotated"] = *version.AutoRotated
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// resourceIBMSmCertificateSchema adds the attributes that certificate secrets share to the schema of
+// the secret type.
+func resourceIBMSmCertificateSchema(secretSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	computed := map[string]string{
+		"algorithm":       "The identifier for the cryptographic algorithm used by the certificate authority to sign the certificate.",
+		"issuer":          "The distinguished name that identifies the entity that signed and issued the certificate.",
+		"serial_number":   "The unique serial number that was assigned to the certificate by the issuing certificate authority.",
+		"expiration_date": "The date the certificate expires.",
+	}
+	for k, description := range computed {
+		secretSchema[k] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: description,
+		}
+	}
+	secretSchema["validity"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "The date range that the certificate is valid.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"not_before": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The date the certificate validity period begins.",
+				},
+				"not_after": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The date the certificate validity period ends.",
+				},
+			},
+		},
+	}
+	return resourceIBMSmSecretSchema(secretSchema)
+}
+
+func setSmCertificateAttributes(d *schema.ResourceData, secret *smSecret) error {
+	d.Set("algorithm", secret.Algorithm)
+	d.Set("issuer", secret.Issuer)
+	d.Set("serial_number", secret.SerialNumber)
+	d.Set("expiration_date", secret.ExpirationDate)
+	validity := []interface{}{}
+	if secret.Validity != nil {
+		v := map[string]interface{}{}
+		if secret.Validity.NotBefore != nil {
+			v["not_before"] = *secret.Validity.NotBefore
+		}
+		if secret.Validity.NotAfter != nil {
+			v["not_after"] = *secret.Validity.NotAfter
+		}
+		validity = append(validity, v)
+	}
+	if err := d.Set("validity", validity); err != nil {
+		return fmt.Errorf("Error setting validity %s", err)
+	}
+	return nil
+}
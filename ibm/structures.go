@@ -20,6 +20,7 @@ import (
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	kp "github.com/IBM/keyprotect-go-client"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
@@ -679,8 +680,8 @@ func flattenMetricsMonitor(in *resourceconfigurationv1.MetricsMonitoring) []inte
 func archiveRuleGet(in []*s3.LifecycleRule) []interface{} {
 	rules := make([]interface{}, 0, len(in))
 	for _, r := range in {
-		// Checking this is not an expire_rule.  LifeCycle rules are either archive or expire
-		if r.Expiration == nil {
+		// Checking this is an archive rule, not an expire rule or a noncurrent version or multipart upload rule
+		if r.Expiration == nil && len(r.Transitions) > 0 {
 			rule := make(map[string]interface{})
 
 			if r.Status != nil {
@@ -742,6 +743,87 @@ func expireRuleGet(in []*s3.LifecycleRule) []interface{} {
 	return rules
 }
 
+func noncurrentVersionExpirationRuleGet(in []*cosLifecycleRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		if r.NoncurrentVersionExpiration != nil && r.NoncurrentVersionExpiration.NoncurrentDays != nil {
+			rule := make(map[string]interface{})
+			rule["enable"] = r.Status != nil && *r.Status == "Enabled"
+			if r.ID != nil {
+				rule["rule_id"] = *r.ID
+			}
+			rule["noncurrent_days"] = int(*r.NoncurrentVersionExpiration.NoncurrentDays)
+			if r.Filter != nil && r.Filter.Prefix != nil {
+				rule["prefix"] = *r.Filter.Prefix
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func abortIncompleteMultipartUploadRuleGet(in []*cosLifecycleRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		if r.AbortIncompleteMultipartUpload != nil && r.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
+			rule := make(map[string]interface{})
+			rule["enable"] = r.Status != nil && *r.Status == "Enabled"
+			if r.ID != nil {
+				rule["rule_id"] = *r.ID
+			}
+			rule["days_after_initiation"] = int(*r.AbortIncompleteMultipartUpload.DaysAfterInitiation)
+			if r.Filter != nil && r.Filter.Prefix != nil {
+				rule["prefix"] = *r.Filter.Prefix
+			}
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
+
+func flattenCosObjectLockConfiguration(in *cosObjectLockConfiguration) []interface{} {
+	config := make([]interface{}, 0, 1)
+	if in != nil && in.ObjectLockEnabled != nil {
+		att := map[string]interface{}{
+			"object_lock_enabled": *in.ObjectLockEnabled,
+		}
+		if in.Rule != nil && in.Rule.DefaultRetention != nil {
+			retention := make(map[string]interface{})
+			if in.Rule.DefaultRetention.Mode != nil {
+				retention["mode"] = *in.Rule.DefaultRetention.Mode
+			}
+			if in.Rule.DefaultRetention.Days != nil {
+				retention["days"] = int(*in.Rule.DefaultRetention.Days)
+			}
+			if in.Rule.DefaultRetention.Years != nil {
+				retention["years"] = int(*in.Rule.DefaultRetention.Years)
+			}
+			att["object_lock_rule"] = []interface{}{
+				map[string]interface{}{"default_retention": []interface{}{retention}},
+			}
+		}
+		config = append(config, att)
+	}
+	return config
+}
+
+func flattenCosCORSRules(in []*s3.CORSRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		rule := map[string]interface{}{
+			"allowed_headers": aws.StringValueSlice(r.AllowedHeaders),
+			"allowed_methods": aws.StringValueSlice(r.AllowedMethods),
+			"allowed_origins": aws.StringValueSlice(r.AllowedOrigins),
+			"expose_headers":  aws.StringValueSlice(r.ExposeHeaders),
+		}
+		if r.MaxAgeSeconds != nil {
+			rule["max_age_seconds"] = int(*r.MaxAgeSeconds)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
 func retentionRuleGet(in *s3.ProtectionConfiguration) []interface{} {
 	rules := make([]interface{}, 0, 1)
 	if in != nil && in.Status != nil && *in.Status == "COMPLIANCE" {
@@ -1863,13 +1945,22 @@ func UpdateGlobalTagsUsingCRN(oldList, newList interface{}, meta interface{}, re
 		if err != nil {
 			return fmt.Errorf("Error detaching database tags %v: %s\n%s", remove, err, resp)
 		}
-		for _, v := range remove {
-			delTagOptions := &globaltaggingv1.DeleteTagOptions{
-				TagName: ptrToString(v),
-			}
-			_, resp, err := gtClient.DeleteTag(delTagOptions)
-			if err != nil {
-				return fmt.Errorf("Error deleting database tag %v: %s\n%s", v, err, resp)
+		// Access tags are referenced by IAM policies, keep them in the account
+		if tagType != "access" {
+			for _, v := range remove {
+				delTagOptions := &globaltaggingv1.DeleteTagOptions{
+					TagName: ptrToString(v),
+				}
+				if len(tagType) > 0 {
+					delTagOptions.TagType = ptrToString(tagType)
+					if tagType == service {
+						delTagOptions.AccountID = ptrToString(acctID)
+					}
+				}
+				_, resp, err := gtClient.DeleteTag(delTagOptions)
+				if err != nil {
+					return fmt.Errorf("Error deleting database tag %v: %s\n%s", v, err, resp)
+				}
 			}
 		}
 	}
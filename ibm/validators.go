@@ -518,6 +518,25 @@ func validateJSONString() schema.SchemaValidateFunc {
 	}
 }
 
+// validateJSONObjectString validates a JSON document whose top level value is an object, unlike validateJSONString
+// the object isn't expected to be a list of key/value pairs
+func validateJSONObjectString(v interface{}, k string) (ws []string, errors []error) {
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &object); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", k, err))
+	}
+	return
+}
+
+// validateCosSyncPrefix validates that a non-empty object key prefix ends with /, a prefix such as site would also
+// match the keys of sitemap.xml
+func validateCosSyncPrefix(v interface{}, k string) (ws []string, errors []error) {
+	if prefix := v.(string); prefix != "" && !strings.HasSuffix(prefix, "/") {
+		errors = append(errors, fmt.Errorf("%q (%q) must end with /", k, prefix))
+	}
+	return
+}
+
 func validateRegexp(regex string) schema.SchemaValidateFunc {
 	return func(v interface{}, k string) (ws []string, errors []error) {
 		value := v.(string)